@@ -0,0 +1,127 @@
+package country
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/services"
+)
+
+type CountryController struct {
+	service services.CountryService
+}
+
+func NewCountry(s services.CountryService) *CountryController {
+	return &CountryController{
+		service: s,
+	}
+}
+
+type countryRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+func (c *CountryController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		countries, err := c.service.GetAll()
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": countries})
+	}
+}
+
+func (c *CountryController) GetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		country, err := c.service.GetById(id)
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": country})
+	}
+}
+
+func (c *CountryController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req countryRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		country, err := c.service.Create(req.Name)
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, gin.H{"data": country})
+	}
+}
+
+func (c *CountryController) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		var req countryRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		country, err := c.service.Update(id, req.Name)
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": country})
+	}
+}
+
+func (c *CountryController) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		if err := c.service.Delete(id); err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrCountryNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrCountryNameInUse), errors.Is(err, services.ErrCountryHasProvinces):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidName):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee"
@@ -15,6 +16,8 @@ type employeeRequest struct {
 	First_name     string `json:"first_name" binding:"required"`
 	Last_name      string `json:"last_name" binding:"required"`
 	Warehouse_id   int    `json:"warehouse_id"`
+	Role           string `json:"role"`
+	Supervisor_id  int    `json:"supervisor_id"`
 }
 
 func (er *employeeRequest) Validate() error {
@@ -37,6 +40,33 @@ func (er *employeeRequest) Validate() error {
 		return errors.New("wareHouse_id must be greater than 0")
 	}
 
+	if er.Role != "" && !employee.IsValidRole(er.Role) {
+		return errors.New("role must be one of " + strings.Join(employee.Roles, ", "))
+	}
+
+	if er.Supervisor_id < 0 {
+		return errors.New("supervisor_id can't be smaller than 0")
+	}
+
+	return nil
+}
+
+type transferRequest struct {
+	Warehouse_id int    `json:"warehouse_id" binding:"required"`
+	Date         string `json:"date"`
+}
+
+func (tr *transferRequest) Validate() error {
+	if tr.Warehouse_id <= 0 {
+		return errors.New("wareHouse_id must be greater than 0")
+	}
+
+	if tr.Date != "" {
+		if _, err := time.Parse("2006-01-02", tr.Date); err != nil {
+			return errors.New("date must be in the format yyyy-mm-dd")
+		}
+	}
+
 	return nil
 }
 
@@ -66,7 +96,7 @@ func (ec *EmployeeController) CreateEmployee(ctx *gin.Context) {
 		return
 	}
 
-	e, err := ec.service.Create(req.Card_number_id, req.First_name, req.Last_name, req.Warehouse_id)
+	e, err := ec.service.Create(req.Card_number_id, req.First_name, req.Last_name, req.Warehouse_id, req.Role, req.Supervisor_id)
 	if err != nil {
 		if CustomError(err) {
 			ctx.JSON(http.StatusBadRequest, gin.H{
@@ -160,8 +190,14 @@ func (ec *EmployeeController) UpdateByIdEmployee(ctx *gin.Context) {
 		return
 	}
 
-	e, err := ec.service.UpdateById(id, req.Card_number_id, req.First_name, req.Last_name, req.Warehouse_id)
+	e, err := ec.service.UpdateById(id, req.Card_number_id, req.First_name, req.Last_name, req.Warehouse_id, req.Role, req.Supervisor_id)
 	if err != nil {
+		if errors.Is(err, employee.ErrTransferRequired) {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		if CustomError(err) {
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"error": err.Error(),
@@ -191,6 +227,13 @@ func (ec *EmployeeController) DeleteByIdEmployee(ctx *gin.Context) {
 
 	err = ec.service.DeleteById(id)
 	if err != nil {
+		var be *employee.BusinessRuleError
+		if errors.As(err, &be) {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		if CustomError(err) {
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"error": err.Error(),
@@ -206,6 +249,86 @@ func (ec *EmployeeController) DeleteByIdEmployee(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, gin.H{})
 }
 
+func (ec *EmployeeController) TransferEmployee(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req transferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	e, err := ec.service.Transfer(id, req.Warehouse_id, req.Date)
+	if err != nil {
+		var fe *employee.NoElementInFileError
+		if errors.As(err, &fe) {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if CustomError(err) {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": e,
+	})
+}
+
+func (ec *EmployeeController) GetAssignmentsEmployee(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	as, err := ec.service.GetAssignments(id)
+	if err != nil {
+		if CustomError(err) {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": as,
+	})
+}
+
 func CustomError(e error) bool {
 	var be *employee.BusinessRuleError
 	var fe *employee.NoElementInFileError
@@ -21,7 +21,8 @@ func makeValidCreateBody() *bytes.Buffer {
     "card_number_id": 568,
     "first_name": "Valid_Name",
     "last_name": "Valid_Last_Name",
-    "warehouse_id": 1
+    "warehouse_id": 1,
+    "role": "supervisor"
 	}
 `))
 }
@@ -33,6 +34,7 @@ func makeDBEmployee() employee.Employee {
 		First_name:     "Valid_Name",
 		Last_name:      "Valid_Last_Name",
 		Warehouse_id:   1,
+		Role:           "supervisor",
 	}
 }
 
@@ -95,6 +97,18 @@ func makeInvalidCreateAndUpdateBodiesTestCases() []TestCase {
 	`,
 			ExpectedResponseBody: "{\"error\":\"wareHouse_id must be greater than 0\"}",
 		},
+		{
+			RequestBody: `
+		{
+			"card_number_id": 1,
+			"first_name": "Marcos",
+			"last_name": "Mantovani",
+			"warehouse_id": 1,
+			"role": "driver"
+		}
+	`,
+			ExpectedResponseBody: "{\"error\":\"role must be one of picker, receiver, supervisor, manager\"}",
+		},
 	}
 }
 
@@ -126,14 +140,14 @@ func TestCreateEmployee(t *testing.T) {
 	})
 
 	t.Run("Should Create from Employee Service with correct Values", func(t *testing.T) {
-		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, errors.New("any_message")).Once()
+		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/employees", makeValidCreateBody())
 		response.ServeHTTP(rr, req)
 	})
 
 	t.Run("Should return an error and 400 status if Create from Employee Service returns an Business Rule Error", func(t *testing.T) {
-		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, &employee.BusinessRuleError{Err: errors.New("any_message")}).Once()
+		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, &employee.BusinessRuleError{Err: errors.New("any_message")}).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/employees", makeValidCreateBody())
 		response.ServeHTTP(rr, req)
@@ -143,7 +157,7 @@ func TestCreateEmployee(t *testing.T) {
 	})
 
 	t.Run("Should return an error and 500 status if Create from employee Service did not returns an custom error", func(t *testing.T) {
-		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, errors.New("any_message")).Once()
+		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/employees", makeValidCreateBody())
 		response.ServeHTTP(rr, req)
@@ -153,13 +167,30 @@ func TestCreateEmployee(t *testing.T) {
 	})
 
 	t.Run("Should return 201 status and data on sucess at creating employee", func(t *testing.T) {
-		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(makeDBEmployee(), nil).Once()
+		mockEmployeeService.On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(makeDBEmployee(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/employees", makeValidCreateBody())
 		response.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusCreated, rr.Code)
-		assert.Equal(t, "{\"data\":{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1}}", rr.Body.String())
+		assert.Equal(t, "{\"data\":{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1,\"role\":\"supervisor\"}}", rr.Body.String())
+	})
+}
+
+func TestCreateEmployeeWithoutRole(t *testing.T) {
+	mockEmployeeService := mocks.NewEmployeeServiceInterface(t)
+	sut := employeeController.CreateEmployeeController(mockEmployeeService)
+	response := gin.Default()
+
+	response.POST("/employees", sut.CreateEmployee)
+
+	t.Run("Should accept a body without role and leave the default to the service", func(t *testing.T) {
+		mockEmployeeService.On("Create", 568, "Valid_Name", "Valid_Last_Name", 1, "", 0).Return(makeDBEmployee(), nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/employees", bytes.NewBuffer([]byte(`{"card_number_id": 568, "first_name": "Valid_Name", "last_name": "Valid_Last_Name", "warehouse_id": 1}`)))
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
 	})
 }
 
@@ -199,17 +230,17 @@ func TestUpdateEmployee(t *testing.T) {
 	})
 
 	t.Run("Should call UpdateById from employee Service with correct values", func(t *testing.T) {
-		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(makeDBEmployee(), nil).Once()
+		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(makeDBEmployee(), nil).Once()
 		mockEmployeeService.On("GetById", mock.AnythingOfType("int")).Return(makeDBEmployee(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/employees/1", makeValidCreateBody())
 		response.ServeHTTP(rr, req)
 
-		mockEmployeeService.AssertCalled(t, "UpdateById", 1, 568, "Valid_Name", "Valid_Last_Name", 1)
+		mockEmployeeService.AssertCalled(t, "UpdateById", 1, 568, "Valid_Name", "Valid_Last_Name", 1, "supervisor", 0)
 	})
 
 	t.Run("Should return an error and 404 status if UpdateById from employee Service returns an Business Rule error", func(t *testing.T) {
-		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, &employee.BusinessRuleError{Err: errors.New("any_message")}).Once()
+		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, &employee.BusinessRuleError{Err: errors.New("any_message")}).Once()
 		mockEmployeeService.On("GetById", mock.AnythingOfType("int")).Return(employee.Employee{}, nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/employees/1", makeValidCreateBody())
@@ -219,8 +250,19 @@ func TestUpdateEmployee(t *testing.T) {
 		assert.Equal(t, "{\"error\":\"any_message\"}", rr.Body.String())
 	})
 
+	t.Run("Should return an error and 409 status if the update changes the warehouse", func(t *testing.T) {
+		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, employee.ErrTransferRequired).Once()
+		mockEmployeeService.On("GetById", mock.AnythingOfType("int")).Return(employee.Employee{}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/employees/1", makeValidCreateBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, "{\"error\":\"warehouse_id can only be changed through a transfer\"}", rr.Body.String())
+	})
+
 	t.Run("Should return an error and 500 status if UpdateById from Employee Service did not returns an custom error", func(t *testing.T) {
-		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, errors.New("any_message")).Once()
+		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(employee.Employee{}, errors.New("any_message")).Once()
 		mockEmployeeService.On("GetById", mock.AnythingOfType("int")).Return(employee.Employee{}, nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/employees/1", makeValidCreateBody())
@@ -231,14 +273,14 @@ func TestUpdateEmployee(t *testing.T) {
 	})
 
 	t.Run("Should 200 status and data on success", func(t *testing.T) {
-		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(makeDBEmployee(), nil).Once()
+		mockEmployeeService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(makeDBEmployee(), nil).Once()
 		mockEmployeeService.On("GetById", mock.AnythingOfType("int")).Return(employee.Employee{}, nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/employees/1", makeValidCreateBody())
 		response.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, "{\"data\":{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1}}", rr.Body.String())
+		assert.Equal(t, "{\"data\":{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1,\"role\":\"supervisor\"}}", rr.Body.String())
 	})
 }
 
@@ -263,7 +305,7 @@ func TestGetAllEmployees(t *testing.T) {
 		response.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, "{\"data\":[{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1}]}", rr.Body.String())
+		assert.Equal(t, "{\"data\":[{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1,\"role\":\"supervisor\"}]}", rr.Body.String())
 	})
 }
 
@@ -321,7 +363,7 @@ func TestGetByIdEmployee(t *testing.T) {
 		response.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, "{\"data\":{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1}}", rr.Body.String())
+		assert.Equal(t, "{\"data\":{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1,\"role\":\"supervisor\"}}", rr.Body.String())
 	})
 }
 
@@ -362,6 +404,15 @@ func TestDeleteByIdWarehouse(t *testing.T) {
 		assert.Equal(t, "{\"error\":\"any_message\"}", rr.Body.String())
 	})
 
+	t.Run("Should return an error and 409 status if the employee supervises others", func(t *testing.T) {
+		mockEmployeeService.On("DeleteById", mock.AnythingOfType("int")).Return(&employee.BusinessRuleError{Err: errors.New("employee supervises other employees")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/employees/1", nil)
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
 	t.Run("Should return an error and 500 status if DeleteById from Employee Service returns an error", func(t *testing.T) {
 		mockEmployeeService.On("DeleteById", mock.AnythingOfType("int")).Return(errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
@@ -382,3 +433,101 @@ func TestDeleteByIdWarehouse(t *testing.T) {
 		assert.Empty(t, rr.Body.String())
 	})
 }
+
+func TestTransferEmployee(t *testing.T) {
+	mockEmployeeService := mocks.NewEmployeeServiceInterface(t)
+	sut := employeeController.CreateEmployeeController(mockEmployeeService)
+	response := gin.Default()
+	response.POST("/employees/:id/transfers", sut.TransferEmployee)
+
+	makeTransferBody := func() *bytes.Buffer {
+		return bytes.NewBuffer([]byte(`{"warehouse_id": 2, "date": "2022-05-01"}`))
+	}
+
+	t.Run("Should return an error and 400 status if a invalid id is provided", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/employees/invalid_id/transfers", makeTransferBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"invalid id\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 422 status if warehouse_id is missing", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/employees/1/transfers", bytes.NewBuffer([]byte(`{"date": "2022-05-01"}`)))
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Should return an error and 400 status if date is invalid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/employees/1/transfers", bytes.NewBuffer([]byte(`{"warehouse_id": 2, "date": "01/05/2022"}`)))
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"date must be in the format yyyy-mm-dd\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 404 status if the employee does not exist", func(t *testing.T) {
+		mockEmployeeService.On("Transfer", 1, 2, "2022-05-01").Return(employee.Employee{}, &employee.NoElementInFileError{Err: errors.New("can't find element with this id")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/employees/1/transfers", makeTransferBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return an error and 400 status if Transfer returns a Business Rule error", func(t *testing.T) {
+		mockEmployeeService.On("Transfer", 1, 2, "2022-05-01").Return(employee.Employee{}, &employee.BusinessRuleError{Err: errors.New("warehouse not found")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/employees/1/transfers", makeTransferBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"warehouse not found\"}", rr.Body.String())
+	})
+
+	t.Run("Should 200 status and data on success", func(t *testing.T) {
+		transferred := makeDBEmployee()
+		transferred.Warehouse_id = 2
+		mockEmployeeService.On("Transfer", 1, 2, "2022-05-01").Return(transferred, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/employees/1/transfers", makeTransferBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":2,\"role\":\"supervisor\"}}", rr.Body.String())
+	})
+}
+
+func TestGetAssignmentsEmployee(t *testing.T) {
+	mockEmployeeService := mocks.NewEmployeeServiceInterface(t)
+	sut := employeeController.CreateEmployeeController(mockEmployeeService)
+	response := gin.Default()
+	response.GET("/employees/:id/assignments", sut.GetAssignmentsEmployee)
+
+	t.Run("Should return an error and 404 status if the employee does not exist", func(t *testing.T) {
+		mockEmployeeService.On("GetAssignments", 1).Return([]employee.Assignment{}, &employee.NoElementInFileError{Err: errors.New("can't find element with this id")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/employees/1/assignments", nil)
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should 200 status and data on success", func(t *testing.T) {
+		end := "2022-05-01"
+		mockEmployeeService.On("GetAssignments", 1).Return([]employee.Assignment{
+			{Id: 1, Employee_id: 1, Warehouse_id: 1, Start_date: "2022-01-01", End_date: &end},
+			{Id: 2, Employee_id: 1, Warehouse_id: 2, Start_date: "2022-05-01"},
+		}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/employees/1/assignments", nil)
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":[{\"id\":1,\"employee_id\":1,\"warehouse_id\":1,\"start_date\":\"2022-01-01\",\"end_date\":\"2022-05-01\"},{\"id\":2,\"employee_id\":1,\"warehouse_id\":2,\"start_date\":\"2022-05-01\"}]}", rr.Body.String())
+	})
+}
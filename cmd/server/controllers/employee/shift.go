@@ -0,0 +1,221 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee"
+)
+
+type shiftRequest struct {
+	Name          string `json:"name" binding:"required"`
+	Start_time    string `json:"start_time" binding:"required"`
+	End_time      string `json:"end_time" binding:"required"`
+	Minimum_staff int    `json:"minimum_staff"`
+}
+
+func (sr *shiftRequest) Validate() error {
+	if strings.TrimSpace(sr.Name) == "" {
+		return errors.New("name can't be empty")
+	}
+
+	if _, err := time.Parse("15:04", sr.Start_time); err != nil {
+		return errors.New("start_time must be in the format hh:mm")
+	}
+
+	if _, err := time.Parse("15:04", sr.End_time); err != nil {
+		return errors.New("end_time must be in the format hh:mm")
+	}
+
+	if sr.Minimum_staff < 0 {
+		return errors.New("minimum_staff can't be smaller than 0")
+	}
+
+	return nil
+}
+
+type shiftAssignmentRequest struct {
+	Employee_id int    `json:"employee_id" binding:"required"`
+	Date        string `json:"date" binding:"required"`
+}
+
+func (sar *shiftAssignmentRequest) Validate() error {
+	if sar.Employee_id <= 0 {
+		return errors.New("employee_id must be greater than 0")
+	}
+
+	if _, err := time.Parse("2006-01-02", sar.Date); err != nil {
+		return errors.New("date must be in the format yyyy-mm-dd")
+	}
+
+	return nil
+}
+
+type ShiftController struct {
+	service employee.ShiftServiceInterface
+}
+
+func CreateShiftController(ss employee.ShiftServiceInterface) *ShiftController {
+	return &ShiftController{
+		service: ss,
+	}
+}
+
+func (sc *ShiftController) CreateShift(ctx *gin.Context) {
+	wareHouseId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req shiftRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	s, err := sc.service.Create(wareHouseId, req.Name, req.Start_time, req.End_time, req.Minimum_staff)
+	if err != nil {
+		shiftError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"data": s,
+	})
+}
+
+func (sc *ShiftController) GetShifts(ctx *gin.Context) {
+	wareHouseId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	ss, err := sc.service.GetByWarehouseId(wareHouseId)
+	if err != nil {
+		shiftError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": ss,
+	})
+}
+
+func (sc *ShiftController) AssignShift(ctx *gin.Context) {
+	shiftId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req shiftAssignmentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	a, err := sc.service.Assign(shiftId, req.Employee_id, req.Date)
+	if err != nil {
+		shiftError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"data": a,
+	})
+}
+
+func (sc *ShiftController) GetRoster(ctx *gin.Context) {
+	wareHouseId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	date := ctx.Query("date")
+	if date == "" {
+		date = time.Now().Format("2006-01-02")
+	}
+
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "date must be in the format yyyy-mm-dd",
+		})
+		return
+	}
+
+	roster, err := sc.service.Roster(wareHouseId, date)
+	if err != nil {
+		shiftError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": roster,
+	})
+}
+
+func shiftError(ctx *gin.Context, err error) {
+	var fe *employee.NoElementInFileError
+
+	if errors.As(err, &fe) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if errors.Is(err, employee.ErrShiftOverlap) {
+		ctx.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if CustomError(err) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": err.Error(),
+	})
+}
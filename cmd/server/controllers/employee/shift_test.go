@@ -0,0 +1,158 @@
+package controllers_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	employeeController "github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/employee"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeDBShift() employee.Shift {
+	return employee.Shift{
+		Id:            1,
+		Warehouse_id:  1,
+		Name:          "night",
+		Start_time:    "22:00",
+		End_time:      "06:00",
+		Minimum_staff: 2,
+	}
+}
+
+func TestCreateShift(t *testing.T) {
+	mockShiftService := mocks.NewShiftServiceInterface(t)
+	sut := employeeController.CreateShiftController(mockShiftService)
+	response := gin.Default()
+	response.POST("/warehouses/:id/shifts", sut.CreateShift)
+
+	makeShiftBody := func() *bytes.Buffer {
+		return bytes.NewBuffer([]byte(`{"name": "night", "start_time": "22:00", "end_time": "06:00", "minimum_staff": 2}`))
+	}
+
+	t.Run("Should return an error and 400 status if a invalid id is provided", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses/invalid_id/shifts", makeShiftBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"invalid id\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 422 status if name is missing", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses/1/shifts", bytes.NewBuffer([]byte(`{"start_time": "22:00", "end_time": "06:00"}`)))
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Should return an error and 400 status if start_time is invalid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses/1/shifts", bytes.NewBuffer([]byte(`{"name": "night", "start_time": "10pm", "end_time": "06:00"}`)))
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"start_time must be in the format hh:mm\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 404 status if the warehouse does not exist", func(t *testing.T) {
+		mockShiftService.On("Create", 1, "night", "22:00", "06:00", 2).Return(employee.Shift{}, &employee.NoElementInFileError{Err: errors.New("can't find element with this id")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses/1/shifts", makeShiftBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should 201 status and data on success", func(t *testing.T) {
+		mockShiftService.On("Create", 1, "night", "22:00", "06:00", 2).Return(makeDBShift(), nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses/1/shifts", makeShiftBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"warehouse_id\":1,\"name\":\"night\",\"start_time\":\"22:00\",\"end_time\":\"06:00\",\"minimum_staff\":2}}", rr.Body.String())
+	})
+}
+
+func TestAssignShift(t *testing.T) {
+	mockShiftService := mocks.NewShiftServiceInterface(t)
+	sut := employeeController.CreateShiftController(mockShiftService)
+	response := gin.Default()
+	response.POST("/shifts/:id/assignments", sut.AssignShift)
+
+	makeAssignmentBody := func() *bytes.Buffer {
+		return bytes.NewBuffer([]byte(`{"employee_id": 1, "date": "2022-05-01"}`))
+	}
+
+	t.Run("Should return an error and 400 status if date is invalid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/shifts/1/assignments", bytes.NewBuffer([]byte(`{"employee_id": 1, "date": "01/05/2022"}`)))
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"date must be in the format yyyy-mm-dd\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 409 status if the shift overlaps another one", func(t *testing.T) {
+		mockShiftService.On("Assign", 1, 1, "2022-05-01").Return(employee.ShiftAssignment{}, employee.ErrShiftOverlap).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/shifts/1/assignments", makeAssignmentBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, "{\"error\":\"employee is already assigned to an overlapping shift\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 400 status if Assign returns a Business Rule error", func(t *testing.T) {
+		mockShiftService.On("Assign", 1, 1, "2022-05-01").Return(employee.ShiftAssignment{}, &employee.BusinessRuleError{Err: errors.New("employee does not work at the shift's warehouse")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/shifts/1/assignments", makeAssignmentBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should 201 status and data on success", func(t *testing.T) {
+		mockShiftService.On("Assign", 1, 1, "2022-05-01").Return(employee.ShiftAssignment{Id: 1, Shift_id: 1, Employee_id: 1, Date: "2022-05-01"}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/shifts/1/assignments", makeAssignmentBody())
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"shift_id\":1,\"employee_id\":1,\"date\":\"2022-05-01\"}}", rr.Body.String())
+	})
+}
+
+func TestGetRoster(t *testing.T) {
+	mockShiftService := mocks.NewShiftServiceInterface(t)
+	sut := employeeController.CreateShiftController(mockShiftService)
+	response := gin.Default()
+	response.GET("/warehouses/:id/roster", sut.GetRoster)
+
+	t.Run("Should return an error and 400 status if date is invalid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/warehouses/1/roster?date=tomorrow", nil)
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should 200 status and data on success", func(t *testing.T) {
+		mockShiftService.On("Roster", 1, "2022-05-01").Return([]employee.RosterShift{
+			{Shift: makeDBShift(), Employees: []employee.Employee{makeDBEmployee()}, Understaffed: true},
+		}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/warehouses/1/roster?date=2022-05-01", nil)
+		response.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":[{\"id\":1,\"warehouse_id\":1,\"name\":\"night\",\"start_time\":\"22:00\",\"end_time\":\"06:00\",\"minimum_staff\":2,\"employees\":[{\"id\":1,\"card_number_id\":568,\"first_name\":\"Valid_Name\",\"Last_name\":\"Valid_Last_Name\",\"warehouse_id\":1,\"role\":\"supervisor\"}],\"understaffed\":true}]}", rr.Body.String())
+	})
+}
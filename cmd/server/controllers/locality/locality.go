@@ -1,8 +1,10 @@
 package locality
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
@@ -33,7 +35,14 @@ func (l *LocalityController) Create() gin.HandlerFunc {
 
 		s, err := l.service.Create( req.Name, req.Province_id, req.Country_id)
 		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			switch {
+			case errors.Is(err, services.ErrProvinceNotInCountry):
+				ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			case errors.Is(err, repository.ErrProvinceNotFound), errors.Is(err, repository.ErrCountryNotFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			}
 			return
 		}
 
@@ -74,3 +83,97 @@ func (l *LocalityController) ReportById() gin.HandlerFunc{
 	}
 }
 
+type coordinatesRequest struct {
+	Latitude  *float64 `json:"latitude" binding:"required"`
+	Longitude *float64 `json:"longitude" binding:"required"`
+}
+
+func (l *LocalityController) UpdateCoordinates() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		var req coordinatesRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		coordinates, err := l.service.UpdateCoordinates(id, *req.Latitude, *req.Longitude)
+		if err != nil {
+			switch {
+			case errors.Is(err, services.ErrInvalidCoordinates):
+				ctx.JSON(http.StatusUnprocessableEntity, web.NewResponse(http.StatusUnprocessableEntity, err.Error()))
+			case errors.Is(err, repository.ErrLocalityNotFound):
+				ctx.JSON(http.StatusNotFound, web.NewResponse(http.StatusNotFound, err.Error()))
+			default:
+				ctx.JSON(http.StatusInternalServerError, web.NewResponse(http.StatusInternalServerError, "internal server error"))
+			}
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, coordinates))
+	}
+}
+
+func (l *LocalityController) GetByProvinceId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		localities, err := l.service.GetByProvinceId(id)
+		if err != nil {
+			if errors.Is(err, repository.ErrProvinceNotFound) {
+				ctx.JSON(http.StatusNotFound, web.NewResponse(http.StatusNotFound, err.Error()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, web.NewResponse(http.StatusInternalServerError, "internal server error"))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, localities))
+	}
+}
+
+func (l *LocalityController) Search() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		localities, err := l.service.Search(ctx.Query("q"))
+		if err != nil {
+			if errors.Is(err, services.ErrInvalidSearch) {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, err.Error()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, web.NewResponse(http.StatusInternalServerError, "internal server error"))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, localities))
+	}
+}
+
+func (l *LocalityController) Report() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var include []string
+		if q := ctx.Query("include"); q != "" {
+			include = strings.Split(q, ",")
+		}
+
+		report, err := l.service.Report(include, ctx.Query("by"))
+		if err != nil {
+			if errors.Is(err, services.ErrInvalidReportInclude) || errors.Is(err, services.ErrInvalidReportLevel) {
+				ctx.JSON(http.StatusBadRequest, web.NewResponse(http.StatusBadRequest, err.Error()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, web.NewResponse(http.StatusInternalServerError, "internal server error"))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, web.NewResponse(http.StatusOK, report))
+	}
+}
@@ -1,6 +1,7 @@
 package product_batch
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/product_batch"
 	"net/http"
@@ -20,6 +21,10 @@ type ProductBatchRequest struct {
 	SectionID          int    `json:"section_id" binding:"required"`
 }
 
+type HoldRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
 type ProductBatchController struct {
 	service product_batch.Service
 }
@@ -74,3 +79,115 @@ func (c *ProductBatchController) Add() gin.HandlerFunc {
 		ctx.JSON(http.StatusCreated, s)
 	}
 }
+
+func (c *ProductBatchController) Hold() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+
+		var hr HoldRequest
+
+		if err := ctx.ShouldBindJSON(&hr); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		hold, err := c.service.Hold(ctx, id, hr.Reason)
+		if err != nil {
+			respondHoldError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, hold)
+	}
+}
+
+func (c *ProductBatchController) Release() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+
+		var hr HoldRequest
+
+		if err := ctx.ShouldBindJSON(&hr); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		hold, err := c.service.Release(ctx, id, hr.Reason)
+		if err != nil {
+			respondHoldError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, hold)
+	}
+}
+
+func (c *ProductBatchController) GetHolds() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+
+		holds, err := c.service.GetHolds(ctx, id)
+		if err != nil {
+			respondHoldError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": holds})
+	}
+}
+
+func (c *ProductBatchController) Allocate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		productID, err := strconv.Atoi(ctx.Query("product_id"))
+		if err != nil || productID <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "product_id must be a positive integer"})
+			return
+		}
+
+		quantity, err := strconv.Atoi(ctx.Query("quantity"))
+		if err != nil || quantity <= 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "quantity must be a positive integer"})
+			return
+		}
+
+		warehouseID := 0
+		if w := ctx.Query("warehouse_id"); w != "" {
+			warehouseID, err = strconv.Atoi(w)
+			if err != nil || warehouseID <= 0 {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "warehouse_id must be a positive integer"})
+				return
+			}
+		}
+
+		allocation, err := c.service.Allocate(ctx, productID, warehouseID, quantity)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": allocation})
+	}
+}
+
+func respondHoldError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, product_batch.ErrBatchNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, product_batch.ErrAlreadyOnHold), errors.Is(err, product_batch.ErrNotOnHold):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
@@ -0,0 +1,152 @@
+package province
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/services"
+)
+
+type ProvinceController struct {
+	service services.ProvinceService
+}
+
+func NewProvince(s services.ProvinceService) *ProvinceController {
+	return &ProvinceController{
+		service: s,
+	}
+}
+
+type provinceRequest struct {
+	Name      string `json:"name" binding:"required"`
+	CountryId int    `json:"country_id" binding:"required"`
+}
+
+func (c *ProvinceController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		provinces, err := c.service.GetAll()
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": provinces})
+	}
+}
+
+func (c *ProvinceController) GetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		province, err := c.service.GetById(id)
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": province})
+	}
+}
+
+func (c *ProvinceController) GetByCountryId() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		provinces, err := c.service.GetByCountryId(id)
+		if err != nil {
+			if errors.Is(err, repository.ErrCountryNotFound) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": provinces})
+	}
+}
+
+func (c *ProvinceController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req provinceRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		province, err := c.service.Create(req.Name, req.CountryId)
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, gin.H{"data": province})
+	}
+}
+
+func (c *ProvinceController) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		var req provinceRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		province, err := c.service.Update(id, req.Name, req.CountryId)
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": province})
+	}
+}
+
+func (c *ProvinceController) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		if err := c.service.Delete(id); err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrProvinceNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, repository.ErrCountryNotFound):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrProvinceNameInUse), errors.Is(err, services.ErrProvinceHasLocalities):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidName):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
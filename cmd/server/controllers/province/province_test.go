@@ -0,0 +1,81 @@
+package province_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/province"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/services/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetByCountryId(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := mocks.NewProvinceService(t)
+	r := gin.Default()
+	r.GET("/countries/:id/provinces", province.NewProvince(mockService).GetByCountryId())
+
+	t.Run("Should return 400 status if the id is invalid", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/countries/abc/provinces", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Should return 404 status if the country doesn't exist", func(t *testing.T) {
+		mockService.On("GetByCountryId", 9).Return(nil, repository.ErrCountryNotFound).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/countries/9/provinces", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.JSONEq(t, `{"error": "country not found"}`, res.Body.String())
+	})
+
+	t.Run("Should return 500 status if the service fails", func(t *testing.T) {
+		mockService.On("GetByCountryId", 1).Return(nil, errors.New("any_error")).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/countries/1/provinces", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("Should return 200 status with the country provinces", func(t *testing.T) {
+		mockService.On("GetByCountryId", 1).Return(domain.Provinces{{Id: 1, Name: "Sergipe", Country_id: 1}}, nil).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/countries/1/provinces", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := mocks.NewProvinceService(t)
+	r := gin.Default()
+	r.POST("/provinces", province.NewProvince(mockService).Create())
+
+	t.Run("Should return 422 status if the body country doesn't exist", func(t *testing.T) {
+		mockService.On("Create", "Sergipe", 9).Return(domain.Provincy{}, repository.ErrCountryNotFound).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/provinces", bytes.NewBufferString(`{"name": "Sergipe", "country_id": 9}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
+}
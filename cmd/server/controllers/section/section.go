@@ -1,6 +1,7 @@
 package section
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -19,6 +20,10 @@ type SectionRequest struct {
 	ProductTypeID      int     `json:"product_type_id" binding:"required"`
 }
 
+type QuarantineRequest struct {
+	Quarantine *bool `json:"quarantine" binding:"required"`
+}
+
 type SectionController struct {
 	service sections.Service
 }
@@ -133,3 +138,32 @@ func (c *SectionController) Delete() gin.HandlerFunc {
 		ctx.JSON(http.StatusNoContent, nil)
 	}
 }
+
+func (c *SectionController) SetQuarantine() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		var obj QuarantineRequest
+
+		if err := ctx.ShouldBindJSON(&obj); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		s, err := c.service.SetQuarantine(ctx, id, *obj.Quarantine)
+		if errors.Is(err, sections.ErrSectionNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, s)
+	}
+}
@@ -44,7 +44,7 @@ func createInvalidBody() *bytes.Buffer {
 }
 
 func createValidJSONWithParams(id int, section int, currentTemperature float32, minimumTemperature float32, currentCapacity int, minimumCapacity int, maximumCapacity int, warehouseID int, productTypeID int) string {
-	return fmt.Sprintf("{\"id\":%d,\"section_number\":%d,\"current_temperature\":%0.f,\"minimum_temperature\":%0.f,\"current_capacity\":%d,\"minimum_capacity\":%d,\"maximum_capacity\":%d,\"warehouse_id\":%d,\"product_type_id\":%d}", id, section, currentTemperature, minimumTemperature, currentCapacity, minimumCapacity, maximumCapacity, warehouseID, productTypeID)
+	return fmt.Sprintf("{\"id\":%d,\"section_number\":%d,\"current_temperature\":%0.f,\"minimum_temperature\":%0.f,\"current_capacity\":%d,\"minimum_capacity\":%d,\"maximum_capacity\":%d,\"warehouse_id\":%d,\"product_type_id\":%d,\"quarantine\":false}", id, section, currentTemperature, minimumTemperature, currentCapacity, minimumCapacity, maximumCapacity, warehouseID, productTypeID)
 }
 
 func createValidSectionWithParams(id int, section int, currentTemperature float32, minimumTemperature float32, currentCapacity int, minimumCapacity int, maximumCapacity int, warehouseID int, productTypeID int) sections.Section {
@@ -246,3 +246,71 @@ func TestDelete(t *testing.T) {
 		assert.Equal(t, "", rr.Body.String())
 	})
 }
+
+func TestSetQuarantine(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := mocks.NewService(t)
+	sc := controller.NewSection(mockService)
+
+	r := gin.Default()
+	r.PATCH("/section/:id/quarantine", sc.SetQuarantine())
+
+	t.Run("set_quarantine_ok", func(t *testing.T) {
+		mockService.
+			On("SetQuarantine", mock.Anything, 1, false).
+			Return(createValidSectionWithParams(1, 1, 1, 1, 2, 1, 4, 1, 1), nil).
+			Once()
+
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/section/1/quarantine", bytes.NewBuffer([]byte(`{"quarantine": false}`)))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, createValidJSONWithParams(1, 1, 1, 1, 2, 1, 4, 1, 1), rr.Body.String())
+	})
+
+	t.Run("set_quarantine_invalid_body", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/section/1/quarantine", bytes.NewBuffer([]byte(`{}`)))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("set_quarantine_non_existent", func(t *testing.T) {
+		mockService.
+			On("SetQuarantine", mock.Anything, 9, true).
+			Return(sections.Section{}, sections.ErrSectionNotFound).
+			Once()
+
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/section/9/quarantine", bytes.NewBuffer([]byte(`{"quarantine": true}`)))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.JSONEq(t, `{"error":"inexistent section"}`, rr.Body.String())
+	})
+
+	t.Run("set_quarantine_invalid_id", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/section/abc/quarantine", bytes.NewBuffer([]byte(`{"quarantine": true}`)))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("set_quarantine_fail", func(t *testing.T) {
+		mockService.
+			On("SetQuarantine", mock.Anything, 1, true).
+			Return(sections.Section{}, errors.New("db error")).
+			Once()
+
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/section/1/quarantine", bytes.NewBuffer([]byte(`{"quarantine": true}`)))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.JSONEq(t, `{"error":"internal server error"}`, rr.Body.String())
+	})
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/seller"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/domain/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/services"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -22,7 +24,7 @@ func UpdateBody() *bytes.Buffer{
 	return bytes.NewBuffer(([]byte(`
 	{
 		
-		"cid": 1,
+		"cid": "1",
 		"company_name": "None",
 		"address": "rua sem nome",
 		"telephone": "000000",
@@ -35,7 +37,7 @@ func validSeller() *bytes.Buffer{
 	return bytes.NewBuffer(([]byte(`
 	{
 		
-		"cid": 1,
+		"cid": "1",
 		"company_name": "None",
 		"address": "rua sem nome",
 		"telephone": "000000",
@@ -59,14 +61,14 @@ func InvalidSeller() *bytes.Buffer{
 func dbSeller()domain.Seller{
 	return domain.Seller{
 		Id:    1,
-			Cid:  1,
+			Cid:  "1",
 			CompanyName:  "None",
 			Address: "none",
 			Telephone: "00000",
 	}
 }
 
-func ValidSellerWithParams(Id, Cid int, CompanyName, Address, Telephone string) domain.Seller{
+func ValidSellerWithParams(Id int, Cid string, CompanyName, Address, Telephone string) domain.Seller{
 	return domain.Seller{
 		Id : Id,
 		Cid : Cid,
@@ -130,7 +132,7 @@ func TestGetAllController(t *testing.T){
 	t.Run("return all sellers", func(t *testing.T){
 		s := domain.Seller{
 			Id:    1,
-			Cid:  1,
+			Cid:  "1",
 			CompanyName:  "None",
 			Address: "none",
 			Telephone: "00000",
@@ -139,7 +141,7 @@ func TestGetAllController(t *testing.T){
 		sList := make([]domain.Seller, 0)
 		sList = append(sList, s)
 		
-		service.On("GetAll").Return(sList, nil ).Once()
+		service.On("GetAll", false).Return(sList, nil ).Once()
 		response := httptest.NewRecorder()
 		request, _ := http.NewRequest(http.MethodGet, "/sellers", nil)
 
@@ -149,7 +151,7 @@ func TestGetAllController(t *testing.T){
 		
 	})
 	t.Run("return an error if GetAll returns an error", func(t *testing.T) {
-		service.On("GetAll").Return([]domain.Seller{}, errors.New("any_message")).Once()
+		service.On("GetAll", false).Return([]domain.Seller{}, errors.New("any_message")).Once()
 		response := httptest.NewRecorder()
 		request, _ := http.NewRequest(http.MethodGet, "/sellers", nil)
 		r.ServeHTTP(response, request)
@@ -158,10 +160,22 @@ func TestGetAllController(t *testing.T){
 		assert.Equal(t, "{\"error\":\"internal server error\"}", response.Body.String())
 	})
 
+	t.Run("include deleted sellers when requested", func(t *testing.T) {
+		service.On("GetAll", true).Return([]domain.Seller{}, nil).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers?include_deleted=true", nil)
+		r.ServeHTTP(response, request)
 
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
 
+	t.Run("return 400 if include_deleted is not a boolean", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers?include_deleted=maybe", nil)
+		r.ServeHTTP(response, request)
 
-
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
 }
 
 
@@ -176,11 +190,11 @@ func TestDelete(t*testing.T){
 	r.DELETE("/sellers/:id", service.Delete())
 
 	t.Run("delete the seller with the specified id", func(t *testing.T){
-		mockService.On("Delete", mock.AnythingOfType("int")).Return(nil).Once()
+		mockService.On("Delete", mock.AnythingOfType("int"), false).Return(nil).Once()
 		response := httptest.NewRecorder()
 		request, _ := http.NewRequest(http.MethodDelete, "/sellers/1", nil)
 		r.ServeHTTP(response, request)
-		mockService.AssertCalled(t, "Delete", 1)
+		mockService.AssertCalled(t, "Delete", 1, false)
 	})
 
 	t.Run("return an error if the specified id not exists", func(t *testing.T){
@@ -192,12 +206,46 @@ func TestDelete(t*testing.T){
 	})
 
 	t.Run("return an error if the specified id not exists", func(t *testing.T){
-		mockService.On("Delete", int(999)).Return( fmt.Errorf("seller not found")).Once()
+		mockService.On("Delete", int(999), false).Return(services.ErrSellerNotFound).Once()
 		response := httptest.NewRecorder()
 		request, _ := http.NewRequest(http.MethodDelete, "/sellers/999", nil)
 		r.ServeHTTP(response, request)
 		assert.Equal(t, http.StatusNotFound, response.Code)
 	})
+
+	t.Run("return 409 on a hard delete of a seller with products", func(t *testing.T){
+		mockService.On("Delete", 1, true).Return(services.ErrSellerHasProducts).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodDelete, "/sellers/1?hard=true", nil)
+		r.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
+}
+
+func TestRestoreController(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := mocks.NewService(t)
+	r := gin.Default()
+	r.POST("/sellers/:id/restore", controllers.NewSeller(mockService).Restore())
+
+	t.Run("return the restored seller", func(t *testing.T) {
+		mockService.On("Restore", 1).Return(domain.Seller{Id: 1, CompanyName: "None"}, nil).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPost, "/sellers/1/restore", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+
+	t.Run("return 404 if there is no deleted seller with the id", func(t *testing.T) {
+		mockService.On("Restore", 2).Return(domain.Seller{}, services.ErrSellerNotFound).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPost, "/sellers/2/restore", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
 }
 
 func TestStoreController( t *testing.T){
@@ -210,13 +258,13 @@ func TestStoreController( t *testing.T){
 	r.POST("/sellers", sellerController.Store())
 	expectSeller := domain.Seller{
 		Id:    1,
-		Cid:  1,
+		Cid:  "1",
 		CompanyName:  "None",
 		Address: "none",
 		Telephone: "00000",
 	}
 	t.Run("Create a new seller", func(t*testing.T){
-		mockService.On("Store", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(expectSeller, nil).Once()
+		mockService.On("Store", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(expectSeller, nil).Once()
 		request := httptest.NewRecorder()
 		response, _ := http.NewRequest(http.MethodPost, "/sellers",validSeller())
 		r.ServeHTTP(request, response)
@@ -230,6 +278,35 @@ func TestStoreController( t *testing.T){
 		assert.Equal(t, http.StatusBadRequest, request.Code)
 	})
 
+	t.Run("return 409 if the cid is in use", func(t *testing.T) {
+		mockService.On("Store", "1", "None", "rua sem nome", "000000", 1).Return(domain.Seller{}, services.ErrCidInUse).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPost, "/sellers", validSeller())
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.Equal(t, "{\"error\":\"this cid is in use\"}", response.Body.String())
+	})
+
+	t.Run("return 422 if the locality doesn't exist", func(t *testing.T) {
+		mockService.On("Store", "1", "None", "rua sem nome", "000000", 1).Return(domain.Seller{}, services.ErrInvalidLocalityId).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPost, "/sellers", validSeller())
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.Equal(t, "{\"error\":\"this locality_id is invalid\"}", response.Body.String())
+	})
+
+	t.Run("return 500 if Store returns an unexpected error", func(t *testing.T) {
+		mockService.On("Store", "1", "None", "rua sem nome", "000000", 1).Return(domain.Seller{}, errors.New("any_error")).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPost, "/sellers", validSeller())
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+	})
+
 
 
 	
@@ -244,14 +321,14 @@ func TestUpdate(t *testing.T){
 	r := gin.Default()
 	expectSeller := domain.Seller{
 		Id:    1,
-		Cid:  1,
+		Cid:  "1",
 		CompanyName:  "None",
 		Address: "none",
 		Telephone: "00000",
 	}
 	r.PATCH("/sellers/:id", service.Update())
 	t.Run("return the seller with the updated data", func(t *testing.T){
-		mockService.On("Update", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(expectSeller, nil).Once()
+		mockService.On("Update", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(expectSeller, nil).Once()
 		response := httptest.NewRecorder()
 		request, _ := http.NewRequest(http.MethodPatch, "/sellers/1", UpdateBody())
 		r.ServeHTTP(response, request)
@@ -267,5 +344,162 @@ func TestUpdate(t *testing.T){
 		assert.Equal(t, http.StatusNotFound, request.Code)
 	})
 
+	t.Run("return 404 if the seller doesn't exist", func(t *testing.T) {
+		mockService.On("Update", 999, "1", "None", "rua sem nome", "000000", 1).Return(domain.Seller{}, services.ErrSellerNotFound).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPatch, "/sellers/999", UpdateBody())
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("return 409 if the cid belongs to another seller", func(t *testing.T) {
+		mockService.On("Update", 1, "1", "None", "rua sem nome", "000000", 1).Return(domain.Seller{}, services.ErrCidInUse).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodPatch, "/sellers/1", UpdateBody())
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
+
+
+}
+
+func TestGetProductsController(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := mocks.NewService(t)
+	sellerController := controllers.NewSeller(service)
+
+	r := gin.Default()
+	r.GET("/sellers/:id/products", sellerController.GetProducts())
 
+	t.Run("return an error if the id is invalid", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/invalid_id/products", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Equal(t, "{\"error\":\"invalid id\"}", response.Body.String())
+	})
+
+	t.Run("return 404 if the seller is not found", func(t *testing.T) {
+		service.On("GetProducts", 999).Return(nil, services.ErrSellerNotFound).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/999/products", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.Equal(t, "{\"error\":\"seller not found\"}", response.Body.String())
+	})
+
+	t.Run("return 500 if GetProducts returns an error", func(t *testing.T) {
+		service.On("GetProducts", 1).Return(nil, errors.New("any_message")).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/1/products", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.Equal(t, "{\"error\":\"internal server error\"}", response.Body.String())
+	})
+
+	t.Run("return the seller products", func(t *testing.T) {
+		price := money.FromCents(1050)
+		products := []domain.SellerProduct{
+			{Id: 1, ProductCode: "P01", Description: "Cafe", ProductTypeId: 1, CurrentSalePrice: &price},
+			{Id: 2, ProductCode: "P02", Description: "Leite", ProductTypeId: 1},
+		}
+		service.On("GetProducts", 1).Return(products, nil).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/1/products", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "{\"data\":[{\"id\":1,\"product_code\":\"P01\",\"description\":\"Cafe\",\"product_type_id\":1,\"current_sale_price\":10.50},{\"id\":2,\"product_code\":\"P02\",\"description\":\"Leite\",\"product_type_id\":1,\"current_sale_price\":null}]}", response.Body.String())
+	})
+}
+
+func TestGetStockController(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := mocks.NewService(t)
+	sellerController := controllers.NewSeller(service)
+
+	r := gin.Default()
+	r.GET("/sellers/:id/stock", sellerController.GetStock())
+
+	t.Run("return 404 if the seller is not found", func(t *testing.T) {
+		service.On("GetStock", 999).Return(nil, services.ErrSellerNotFound).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/999/stock", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("return the units on hand per product", func(t *testing.T) {
+		stock := []domain.ProductStock{
+			{ProductId: 1, ProductCode: "P01", Description: "Cafe", Quantity: 10, Sections: []domain.SectionStock{{SectionId: 1, WarehouseId: 1, Quantity: 10}}},
+		}
+		service.On("GetStock", 1).Return(stock, nil).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/1/stock", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "{\"data\":[{\"product_id\":1,\"product_code\":\"P01\",\"description\":\"Cafe\",\"quantity\":10,\"sections\":[{\"section_id\":1,\"warehouse_id\":1,\"quantity\":10}]}]}", response.Body.String())
+	})
+}
+
+func TestGetSalesReportController(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	service := mocks.NewService(t)
+	sellerController := controllers.NewSeller(service)
+
+	r := gin.Default()
+	r.GET("/sellers/:id/reportSales", sellerController.GetSalesReport())
+
+	t.Run("return 400 if the dates are invalid", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/1/reportSales?from=01/07/2022", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("return 400 if from is after to", func(t *testing.T) {
+		service.On("GetSalesReport", 1, "2022-08-01", "2022-07-01").Return(domain.SalesReport{}, services.ErrInvalidDateRange).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/1/reportSales?from=2022-08-01&to=2022-07-01", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("return 404 if the seller is not found", func(t *testing.T) {
+		service.On("GetSalesReport", 999, "", "").Return(domain.SalesReport{}, services.ErrSellerNotFound).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/999/reportSales", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("return the sales report", func(t *testing.T) {
+		report := domain.SalesReport{
+			SellerId:    1,
+			UnitsSold:   2,
+			OrdersCount: 1,
+			Revenue:     money.FromCents(2100),
+			Cost:        money.FromCents(1000),
+			GrossProfit: money.FromCents(1100),
+			TopProducts: []domain.ProductSales{{ProductId: 1, Description: "Cafe", UnitsSold: 2, Revenue: money.FromCents(2100)}},
+			Months:      []domain.MonthSales{{Month: "2022-07", OrdersCount: 1, UnitsSold: 2, Revenue: money.FromCents(2100)}},
+		}
+		service.On("GetSalesReport", 1, "2022-07-01", "").Return(report, nil).Once()
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodGet, "/sellers/1/reportSales?from=2022-07-01", nil)
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "{\"data\":{\"seller_id\":1,\"units_sold\":2,\"orders_count\":1,\"revenue\":21.00,\"cost\":10.00,\"gross_profit\":11.00,\"top_products\":[{\"product_id\":1,\"description\":\"Cafe\",\"units_sold\":2,\"revenue\":21.00}],\"months\":[{\"month\":\"2022-07\",\"orders_count\":1,\"units_sold\":2,\"revenue\":21.00}]}}", response.Body.String())
+	})
 }
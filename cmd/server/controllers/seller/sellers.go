@@ -1,10 +1,12 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"strconv"
+	"time"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/services"
 
 	"github.com/gin-gonic/gin"
@@ -23,9 +25,13 @@ func NewSeller(s services.Service) *SellerController {
 
 func (c *SellerController) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		
+		includeDeleted, err := web.QueryBool(ctx, "include_deleted")
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "include_deleted must be true or false"})
+			return
+		}
 
-		s, err := c.service.GetAll()
+		s, err := c.service.GetAll(includeDeleted)
 		if err != nil {
 			ctx.JSON(http.StatusInternalServerError, gin.H{
 				"error": "internal server error",
@@ -49,7 +55,7 @@ func (c *SellerController) Store() gin.HandlerFunc {
 
 		s, err := c.service.Store( req.Cid, req.CompanyName, req.Address, req.Telephone, req.LocalityId)
 		if err != nil {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			respondError(ctx, err)
 			return
 		}
 
@@ -112,14 +118,14 @@ func (c *SellerController) Update() gin.HandlerFunc {
 			ctx.JSON(400, gin.H{"error": "O telefone é obrigatório"})
 			return
 		}
-		if req.Cid== 0 {
+		if req.Cid== "" {
 			ctx.JSON(400, gin.H{"error": "O CID é obrigatório"})
 			return
 		}
 
 		s, err := c.service.Update(int(id), req.Cid, req.CompanyName, req.Address, req.Telephone, req.LocalityId)
 		if err != nil {
-			ctx.JSON(404, gin.H{"error": err.Error()})
+			respondError(ctx, err)
 			return
 		}
 		ctx.JSON(200, s)
@@ -136,9 +142,15 @@ func (c *SellerController) Delete() gin.HandlerFunc {
 			return
 		}
 
-		err = c.service.Delete(int(id))
+		hard, err := web.QueryBool(ctx, "hard")
 		if err != nil {
-			ctx.JSON(404, gin.H{"error": err.Error()})
+			ctx.JSON(400, gin.H{"error": "hard must be true or false"})
+			return
+		}
+
+		err = c.service.Delete(int(id), hard)
+		if err != nil {
+			respondError(ctx, err)
 			return
 		}
 
@@ -146,8 +158,114 @@ func (c *SellerController) Delete() gin.HandlerFunc {
 	}
 }
 
+func (c *SellerController) Restore() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		s, err := c.service.Restore(id)
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": s})
+	}
+}
+
+func (c *SellerController) GetProducts() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		p, err := c.service.GetProducts(id)
+		if err != nil {
+			if errors.Is(err, services.ErrSellerNotFound) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": p})
+	}
+}
+
+func (c *SellerController) GetStock() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		st, err := c.service.GetStock(id)
+		if err != nil {
+			if errors.Is(err, services.ErrSellerNotFound) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": st})
+	}
+}
+
+func (c *SellerController) GetSalesReport() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		from, to := ctx.Query("from"), ctx.Query("to")
+
+		for _, date := range []string{from, to} {
+			if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "dates must be in the format yyyy-mm-dd"})
+				return
+			}
+		}
+
+		report, err := c.service.GetSalesReport(id, from, to)
+		if err != nil {
+			if errors.Is(err, services.ErrInvalidDateRange) {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{"data": report})
+	}
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrSellerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrCidInUse), errors.Is(err, services.ErrSellerHasProducts), errors.Is(err, services.ErrSellerHasSettlements):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, services.ErrInvalidCid), errors.Is(err, services.ErrInvalidLocalityId):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
+
 type request struct {
-	Cid int `json:"cid" binding:"required"`
+	Cid string `json:"cid" binding:"required"`
 	CompanyName string `json:"company_name" binding:"required"`
 	Address string `json:"address" binding:"required"`
 	Telephone string `json:"telephone" binding:"required"`
@@ -1,9 +1,6 @@
 package routes
 
 import (
-	"log"
-	"path/filepath"
-
 	EmployeeControllers "github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/employee"
 	product_batch2 "github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/product_batch"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/newLController"
@@ -18,14 +15,20 @@ import (
 	purchase_adapter "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/adapters"
 	purchase_usecases "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	tracking_factories "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/factories"
+	price_list_factories "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/factories"
 	carrier_factories "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/carriers/factories"
+	route_plan_factories "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/factories"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/product_factories"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_factories"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/report_factories"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/inbound_order_factories"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/rma_factories"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/settlement_factories"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sections"
 	sm "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sections/repository/mysql"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/warehouses/factories"
-	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/store"
 )
 
 func ConfigRoutes(r *gin.Engine) *gin.Engine {
@@ -34,18 +37,37 @@ func ConfigRoutes(r *gin.Engine) *gin.Engine {
 	br := adapters.CreateBuyerMySQLRepository(db.GetInstance())
 	bs := usecases.CreateBuyerService(br)
 	bc := adapters.CreateBuyerController(bs)
+	bar := adapters.CreateAddressMySQLRepository(db.GetInstance())
+	blr := adapters.CreateLocalityMySQLRepository(db.GetInstance())
+	bas := usecases.CreateAddressService(bar, br, blr)
+	bac := adapters.CreateAddressController(bas)
 
 	por := purchase_adapter.CreatePurchaseOrderMySQLRepository(db.GetInstance())
-	pos := purchase_usecases.CreatePurchaseOrderService(por)
+	pdr := purchase_adapter.CreateDeliveryAddressMySQLRepository(db.GetInstance())
+	ppr := purchase_adapter.CreatePriceMySQLRepository(db.GetInstance())
+	pdsr := purchase_adapter.CreateDispatchMySQLRepository(db.GetInstance())
+	pos := purchase_usecases.CreatePurchaseOrderService(por, pdr, ppr, pdsr)
 	poc := purchase_adapter.CreatePurchaseOrderController(pos)
+	pkr := purchase_adapter.CreatePickingMySQLRepository(db.GetInstance())
+	pks := purchase_usecases.CreatePickingService(pkr)
+	pkc := purchase_adapter.CreatePickingController(pks)
+
+	priceListController := price_list_factories.MakePriceListController()
+	trackingController := tracking_factories.MakeTrackingController()
 
 	productsController := product_factories.MakeProductController()
 	recordsController := record_factories.MakeRecordsController()
+	marginsController := report_factories.MakeMarginsController()
+	productivityController := report_factories.MakeProductivityController()
 
 	warehouseController := factories.MakeWarehouseController()
 	carrierController := carrier_factories.MakeCarrierController()
+	routePlanController := route_plan_factories.MakeRoutePlanController()
 
 	sellerCont := newController.NewSellerController()
+	settlementController := settlement_factories.MakeSettlementController()
+	rmaController := rma_factories.MakeRmaController()
+	inboundOrderController := inbound_order_factories.MakeInboundOrderController()
 
 	// Common
 	mdb := db.GetInstance()
@@ -59,21 +81,18 @@ func ConfigRoutes(r *gin.Engine) *gin.Engine {
 	pbc := product_batch2.NewSection(pbs)
 
 	localityController := newLController.NewLocalityController()
+	countryController := newLController.NewCountryController()
+	provinceController := newLController.NewProvinceController()
 
-	employeeFilePath, err := filepath.Abs("" + filepath.Join("data", "employee.json"))
-	if err != nil {
-		log.Fatal("can't load employee data file")
-	}
-	employeeFile := store.New(store.FileType, employeeFilePath)
-	er := employee.CreateRepository(employeeFile)
-	warehouseFilePath, err := filepath.Abs("" + filepath.Join("data", "warehouses.json"))
-	if err != nil {
-		log.Fatal("can't load warehouse data file")
-	}
-	warehouseFile := store.New(store.FileType, warehouseFilePath)
-	wr := employee.CreateWarehouseRepository(warehouseFile)
-	es := employee.CreateService(er, wr)
+	er := employee.CreateRepository(mdb)
+	wr := employee.CreateWarehouseRepository(mdb)
+	ar := employee.CreateAssignmentRepository(mdb)
+	es := employee.CreateService(er, wr, ar)
 	ec := EmployeeControllers.CreateEmployeeController(es)
+	shr := employee.CreateShiftRepository(mdb)
+	shar := employee.CreateShiftAssignmentRepository(mdb)
+	shs := employee.CreateShiftService(shr, shar, er, wr)
+	shc := EmployeeControllers.CreateShiftController(shs)
 
 	mux := r.Group("api/v1")
 	{
@@ -83,7 +102,16 @@ func ConfigRoutes(r *gin.Engine) *gin.Engine {
 			warehouse.GET("/:id", warehouseController.GetByIdWarehouse)
 			warehouse.PATCH("/:id", warehouseController.UpdateByIdWarehouse)
 			warehouse.DELETE("/:id", warehouseController.DeleteByIdWarehouse)
+			warehouse.POST("/:id/restore", warehouseController.RestoreByIdWarehouse)
 			warehouse.POST("/", warehouseController.CreateWarehouse)
+			warehouse.GET("/:id/shifts", shc.GetShifts)
+			warehouse.POST("/:id/shifts", shc.CreateShift)
+			warehouse.GET("/:id/roster", shc.GetRoster)
+		}
+
+		shift := mux.Group("shifts")
+		{
+			shift.POST("/:id/assignments", shc.AssignShift)
 		}
 
 		buyer := mux.Group("buyers")
@@ -92,7 +120,22 @@ func ConfigRoutes(r *gin.Engine) *gin.Engine {
 			buyer.GET("/:id", bc.GetBuyerById)
 			buyer.PATCH("/:id", bc.UpdateBuyerById)
 			buyer.DELETE("/:id", bc.DeleteBuyerById)
+			buyer.POST("/:id/restore", bc.RestoreBuyerById)
 			buyer.POST("/", bc.CreateBuyer)
+			buyer.GET("/:id/addresses", bac.GetAllAddresses)
+			buyer.POST("/:id/addresses", bac.CreateAddress)
+			buyer.PATCH("/:id/addresses/:address_id", bac.UpdateAddress)
+			buyer.DELETE("/:id/addresses/:address_id", bac.DeleteAddress)
+			buyer.PATCH("/:id/priceList", priceListController.AssignPriceListToBuyer)
+		}
+
+		priceLists := mux.Group("priceLists")
+		{
+			priceLists.GET("/", priceListController.GetAllPriceLists)
+			priceLists.GET("/:id", priceListController.GetPriceListById)
+			priceLists.POST("/", priceListController.CreatePriceList)
+			priceLists.POST("/:id/items", priceListController.CreatePriceListItem)
+			priceLists.DELETE("/:id/items/:item_id", priceListController.DeletePriceListItem)
 		}
 
 		seller := mux.Group("seller")
@@ -101,7 +144,15 @@ func ConfigRoutes(r *gin.Engine) *gin.Engine {
 			seller.GET("/:id", sellerCont.GetByIdSeller())
 			seller.POST("/", sellerCont.Store())
 			seller.DELETE("/:id", sellerCont.Delete())
+			seller.POST("/:id/restore", sellerCont.Restore())
 			seller.PATCH("/:id", sellerCont.Update())
+			seller.GET("/:id/products", sellerCont.GetProducts())
+			seller.GET("/:id/stock", sellerCont.GetStock())
+			seller.GET("/:id/reportSales", sellerCont.GetSalesReport())
+			seller.GET("/:id/settlements", settlementController.GetAll())
+			seller.POST("/:id/settlements", settlementController.Create())
+			seller.GET("/:id/settlements/:settlement_id", settlementController.GetById())
+			seller.PATCH("/:id/settlements/:settlement_id", settlementController.UpdateStatus())
 
 		}
 
@@ -112,33 +163,91 @@ func ConfigRoutes(r *gin.Engine) *gin.Engine {
 			sec.GET("/:id", sc.GetById())
 			sec.PATCH("/:id", sc.UpdateById())
 			sec.DELETE("/:id", sc.Delete())
+			sec.PATCH("/:id/quarantine", sc.SetQuarantine())
 			sec.GET("/reportProducts", pbc.GetById())
 		}
 
 		pb := mux.Group("productBatches")
 		{
 			pb.POST("/", pbc.Add())
+			pb.GET("/allocation", pbc.Allocate())
+			pb.POST("/:id/hold", pbc.Hold())
+			pb.POST("/:id/release", pbc.Release())
+			pb.GET("/:id/holds", pbc.GetHolds())
 		}
 
 		products := mux.Group("products")
 		{
+			products.GET("/reportRecords", recordsController.GetReportRecords())
 			products.GET("/", productsController.GetAllProduct())
 			products.GET("/:id", productsController.GetByIdProduct())
 			products.POST("/", productsController.CreateProduct())
 			products.PATCH("/:id", productsController.UpdateProduct())
 			products.DELETE("/:id", productsController.DeleteProduct())
+			products.GET("/:id/prices", recordsController.GetPriceHistory())
+			products.GET("/:id/prices/current", recordsController.GetCurrentPrice())
+		}
+
+		reports := mux.Group("reports")
+		{
+			reports.GET("/margins", marginsController.GetMargins())
+			reports.GET("/employeeProductivity", productivityController.GetEmployeeProductivity())
 		}
 
 		po := mux.Group("purchaseOrders")
 		{
 			po.POST("/", poc.CreatePurchaseOrder)
+			po.POST("/:id/pick", pkc.PickPurchaseOrder)
+		}
+
+		inboundOrders := mux.Group("inboundOrders")
+		{
+			inboundOrders.POST("/", inboundOrderController.Create())
+		}
+
+		rmas := mux.Group("rmas")
+		{
+			rmas.GET("/", rmaController.GetAll())
+			rmas.GET("/:id", rmaController.GetById())
+			rmas.POST("/", rmaController.Create())
+			rmas.PATCH("/:id/status", rmaController.UpdateStatus())
+		}
+
+		tracking := mux.Group("tracking")
+		{
+			tracking.GET("/:code", trackingController.GetTimeline)
+			tracking.POST("/:code/events", trackingController.CreateEvent)
+		}
+		countries := mux.Group("countries")
+		{
+			countries.GET("/", countryController.GetAll())
+			countries.GET("/:id", countryController.GetById())
+			countries.GET("/:id/provinces", provinceController.GetByCountryId())
+			countries.POST("/", countryController.Create())
+			countries.PATCH("/:id", countryController.Update())
+			countries.DELETE("/:id", countryController.Delete())
 		}
+
+		provinces := mux.Group("provinces")
+		{
+			provinces.GET("/", provinceController.GetAll())
+			provinces.GET("/:id", provinceController.GetById())
+			provinces.GET("/:id/localities", localityController.GetByProvinceId())
+			provinces.POST("/", provinceController.Create())
+			provinces.PATCH("/:id", provinceController.Update())
+			provinces.DELETE("/:id", provinceController.Delete())
+		}
+
 		locality := mux.Group("localities")
 		{
 
 			locality.GET("/", localityController.ReportAll())
+			locality.GET("/search", localityController.Search())
+			locality.GET("/report", localityController.Report())
 			locality.GET("/:id", localityController.ReportById())
 			locality.POST("/", localityController.Create())
+			locality.PATCH("/:id/coordinates", localityController.UpdateCoordinates())
+			locality.GET("/:id/warehouses", warehouseController.GetByLocalityIdWarehouses)
 			locality.GET("/reportCarriers", carrierController.GetNumberOfCarriersPerLocality)
 		}
 		employee := mux.Group("employees")
@@ -148,11 +257,20 @@ func ConfigRoutes(r *gin.Engine) *gin.Engine {
 			employee.PATCH("/:id", ec.UpdateByIdEmployee)
 			employee.DELETE("/:id", ec.DeleteByIdEmployee)
 			employee.POST("/", ec.CreateEmployee)
+			employee.POST("/:id/transfers", ec.TransferEmployee)
+			employee.GET("/:id/assignments", ec.GetAssignmentsEmployee)
 		}
 
 		carriers := mux.Group("carriers")
 		{
+			carriers.GET("/", carrierController.GetAllCarriers)
+			carriers.GET("/:id", carrierController.GetCarrierById)
 			carriers.POST("/", carrierController.CreateCarrier)
+			carriers.PATCH("/:id", carrierController.UpdateCarrier)
+			carriers.DELETE("/:id", carrierController.DeleteCarrier)
+			carriers.POST("/:id/restore", carrierController.RestoreCarrier)
+			carriers.POST("/:id/routes", routePlanController.CreateRoute)
+			carriers.GET("/:id/routes/:route_id", routePlanController.GetRoute)
 		}
 
 		records := mux.Group("records")
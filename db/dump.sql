@@ -24,7 +24,8 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`country` (
   `id` INT NOT NULL AUTO_INCREMENT,
   `name` VARCHAR(255) NOT NULL,
   PRIMARY KEY (`id`),
-  UNIQUE INDEX `id_UNIQUE` (`id` ASC))
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  UNIQUE INDEX `name_UNIQUE` (`name` ASC))
 ENGINE = InnoDB;
 
 
@@ -38,6 +39,7 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`province` (
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
   PRIMARY KEY (`id`),
   INDEX `fk_Provinces_Countries1_idx` (`country_id` ASC),
+  UNIQUE INDEX `country_id_name_UNIQUE` (`country_id` ASC, `name` ASC),
   CONSTRAINT `fk_Provinces_Countries1`
     FOREIGN KEY (`country_id`)
     REFERENCES `fresh_market`.`country` (`id`)
@@ -53,6 +55,8 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`locality` (
   `id` INT NOT NULL AUTO_INCREMENT,
   `name` VARCHAR(255) NOT NULL,
   `province_id` INT NOT NULL,
+  `latitude` DECIMAL(9,6) NULL,
+  `longitude` DECIMAL(9,6) NULL,
   PRIMARY KEY (`id`),
   INDEX `fk_Locaities_Provinces1_idx` (`province_id` ASC),
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
@@ -74,6 +78,7 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`seller` (
   `address` VARCHAR(255) NOT NULL,
   `telephone` VARCHAR(255) NOT NULL,
   `locality_id` INT NOT NULL,
+  `deleted_at` DATETIME NULL,
   PRIMARY KEY (`id`),
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
   INDEX `fk_Seller_Locaities1_idx` (`locality_id` ASC),
@@ -141,9 +146,17 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`warehouse` (
   `warehouse_code` VARCHAR(50) NOT NULL,
   `minimum_capacity` INT NOT NULL,
   `minimum_temperature` DECIMAL(5,2) NOT NULL,
+  `locality_id` INT NOT NULL,
+  `deleted_at` DATETIME NULL,
   PRIMARY KEY (`id`),
   UNIQUE INDEX `warehouse_code_UNIQUE` (`warehouse_code` ASC),
-  UNIQUE INDEX `id_UNIQUE` (`id` ASC))
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Warehouse_Locality1_idx` (`locality_id` ASC),
+  CONSTRAINT `fk_Warehouse_Locality1`
+    FOREIGN KEY (`locality_id`)
+    REFERENCES `fresh_market`.`locality` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
 ENGINE = InnoDB;
 
 
@@ -160,6 +173,7 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`section` (
   `minimum_temperature` DECIMAL(19,2) NOT NULL,
   `product_type_id` INT NOT NULL,
   `warehouse_id` INT NOT NULL,
+  `quarantine` TINYINT(1) NOT NULL DEFAULT 0,
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
   PRIMARY KEY (`id`),
   INDEX `fk_Section_Products_Types1_idx` (`product_type_id` ASC),
@@ -186,13 +200,130 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`employee` (
   `first_name` VARCHAR(255) NOT NULL,
   `last_name` VARCHAR(255) NOT NULL,
   `warehouse_id` INT NOT NULL,
+  `role` VARCHAR(20) NOT NULL DEFAULT 'picker',
+  `supervisor_id` INT NULL,
   PRIMARY KEY (`id`),
   INDEX `fk_Employees_Warehouse1_idx` (`warehouse_id` ASC),
+  INDEX `fk_Employees_Supervisor1_idx` (`supervisor_id` ASC),
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
   CONSTRAINT `fk_Employees_Warehouse1`
     FOREIGN KEY (`warehouse_id`)
     REFERENCES `fresh_market`.`warehouse` (`id`)
     ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Employees_Supervisor1`
+    FOREIGN KEY (`supervisor_id`)
+    REFERENCES `fresh_market`.`employee` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`employee_assignment`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`employee_assignment` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `employee_id` INT NOT NULL,
+  `warehouse_id` INT NOT NULL,
+  `start_date` DATE NOT NULL,
+  `end_date` DATE NULL,
+  PRIMARY KEY (`id`),
+  INDEX `fk_Employee_Assignment_Employee1_idx` (`employee_id` ASC),
+  INDEX `fk_Employee_Assignment_Warehouse1_idx` (`warehouse_id` ASC),
+  CONSTRAINT `fk_Employee_Assignment_Employee1`
+    FOREIGN KEY (`employee_id`)
+    REFERENCES `fresh_market`.`employee` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Employee_Assignment_Warehouse1`
+    FOREIGN KEY (`warehouse_id`)
+    REFERENCES `fresh_market`.`warehouse` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`shift`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`shift` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `warehouse_id` INT NOT NULL,
+  `name` VARCHAR(255) NOT NULL,
+  `start_time` TIME NOT NULL,
+  `end_time` TIME NOT NULL,
+  `minimum_staff` INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  INDEX `fk_Shift_Warehouse1_idx` (`warehouse_id` ASC),
+  CONSTRAINT `fk_Shift_Warehouse1`
+    FOREIGN KEY (`warehouse_id`)
+    REFERENCES `fresh_market`.`warehouse` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`shift_assignment`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`shift_assignment` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `shift_id` INT NOT NULL,
+  `employee_id` INT NOT NULL,
+  `date` DATE NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `fk_Shift_Assignment_Shift1_idx` (`shift_id` ASC),
+  INDEX `fk_Shift_Assignment_Employee1_idx` (`employee_id` ASC),
+  CONSTRAINT `fk_Shift_Assignment_Shift1`
+    FOREIGN KEY (`shift_id`)
+    REFERENCES `fresh_market`.`shift` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Shift_Assignment_Employee1`
+    FOREIGN KEY (`employee_id`)
+    REFERENCES `fresh_market`.`employee` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`price_list`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`price_list` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `name` VARCHAR(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  UNIQUE INDEX `name_UNIQUE` (`name` ASC))
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`price_list_item`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`price_list_item` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `price_list_id` INT NOT NULL,
+  `product_id` INT NOT NULL,
+  `price` DECIMAL(19,2) NULL,
+  `discount_percent` DECIMAL(5,2) NULL,
+  `valid_from` DATE NULL,
+  `valid_to` DATE NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Price_List_Item_Price_List1_idx` (`price_list_id` ASC),
+  INDEX `fk_Price_List_Item_Product1_idx` (`product_id` ASC),
+  CONSTRAINT `fk_Price_List_Item_Price_List1`
+    FOREIGN KEY (`price_list_id`)
+    REFERENCES `fresh_market`.`price_list` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Price_List_Item_Product1`
+    FOREIGN KEY (`product_id`)
+    REFERENCES `fresh_market`.`product` (`id`)
+    ON DELETE NO ACTION
     ON UPDATE NO ACTION)
 ENGINE = InnoDB;
 
@@ -206,8 +337,42 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`buyer` (
   `first_name` VARCHAR(255) NOT NULL,
   `last_name` VARCHAR(255) NOT NULL,
   `address` VARCHAR(255) NOT NULL,
+  `price_list_id` INT NULL,
+  `deleted_at` DATETIME NULL,
   PRIMARY KEY (`id`),
-  UNIQUE INDEX `id_UNIQUE` (`id` ASC))
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Buyer_Price_List1_idx` (`price_list_id` ASC),
+  CONSTRAINT `fk_Buyer_Price_List1`
+    FOREIGN KEY (`price_list_id`)
+    REFERENCES `fresh_market`.`price_list` (`id`)
+    ON DELETE SET NULL
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`buyer_address`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`buyer_address` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `buyer_id` INT NOT NULL,
+  `address` VARCHAR(255) NOT NULL,
+  `locality_id` INT NOT NULL,
+  `is_default` TINYINT(1) NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Buyer_Address_Buyer1_idx` (`buyer_id` ASC),
+  INDEX `fk_Buyer_Address_Locality1_idx` (`locality_id` ASC),
+  CONSTRAINT `fk_Buyer_Address_Buyer1`
+    FOREIGN KEY (`buyer_id`)
+    REFERENCES `fresh_market`.`buyer` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Buyer_Address_Locality1`
+    FOREIGN KEY (`locality_id`)
+    REFERENCES `fresh_market`.`locality` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
 ENGINE = InnoDB;
 
 
@@ -232,6 +397,7 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`carrier` (
   `address` VARCHAR(255) NOT NULL,
   `telephone` VARCHAR(15) NOT NULL,
   `locality_id` INT NOT NULL,
+  `deleted_at` DATETIME NULL,
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
   PRIMARY KEY (`id`),
   INDEX `fk_Carrier_Locaities1_idx` (`locality_id` ASC),
@@ -254,13 +420,16 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`purchase_order` (
   `warehouse_id` INT NOT NULL,
   `carrier_id` INT NOT NULL,
   `buyer_id` INT NOT NULL,
+  `buyer_address_id` INT NULL,
   `order_status_id` INT NOT NULL,
   PRIMARY KEY (`id`),
   INDEX `fk_Purchase_Orders_Warehouse1_idx` (`warehouse_id` ASC),
   INDEX `fk_Purchase_Orders_Carrier1_idx` (`carrier_id` ASC),
   INDEX `fk_Purchase_Orders_Buyer1_idx` (`buyer_id` ASC),
+  INDEX `fk_Purchase_Orders_Buyer_Address1_idx` (`buyer_address_id` ASC),
   INDEX `fk_Purchase_Orders_Ordes_Status1_idx` (`order_status_id` ASC),
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  UNIQUE INDEX `tracking_code_UNIQUE` (`tracking_code` ASC),
   CONSTRAINT `fk_Purchase_Orders_Warehouse1`
     FOREIGN KEY (`warehouse_id`)
     REFERENCES `fresh_market`.`warehouse` (`id`)
@@ -276,6 +445,11 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`purchase_order` (
     REFERENCES `fresh_market`.`buyer` (`id`)
     ON DELETE NO ACTION
     ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Purchase_Orders_Buyer_Address1`
+    FOREIGN KEY (`buyer_address_id`)
+    REFERENCES `fresh_market`.`buyer_address` (`id`)
+    ON DELETE SET NULL
+    ON UPDATE NO ACTION,
   CONSTRAINT `fk_Purchase_Orders_Ordes_Status1`
     FOREIGN KEY (`order_status_id`)
     REFERENCES `fresh_market`.`order_status` (`id`)
@@ -284,6 +458,104 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`purchase_order` (
 ENGINE = InnoDB;
 
 
+-- -----------------------------------------------------
+-- Table `fresh_market`.`tracking_event`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`tracking_event` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `purchase_order_id` INT NOT NULL,
+  `event_type` VARCHAR(50) NOT NULL,
+  `location` VARCHAR(255) NULL,
+  `description` VARCHAR(255) NULL,
+  `occurred_at` DATETIME NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Tracking_Event_Purchase_Order1_idx` (`purchase_order_id` ASC),
+  CONSTRAINT `fk_Tracking_Event_Purchase_Order1`
+    FOREIGN KEY (`purchase_order_id`)
+    REFERENCES `fresh_market`.`purchase_order` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`route_manifest`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`route_manifest` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `carrier_id` INT NOT NULL,
+  `route_date` DATE NOT NULL,
+  `total_distance_km` DECIMAL(10,2) NOT NULL DEFAULT 0,
+  `created_at` DATETIME NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Route_Manifest_Carrier1_idx` (`carrier_id` ASC),
+  CONSTRAINT `fk_Route_Manifest_Carrier1`
+    FOREIGN KEY (`carrier_id`)
+    REFERENCES `fresh_market`.`carrier` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`route_manifest_stop`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`route_manifest_stop` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `manifest_id` INT NOT NULL,
+  `sequence` INT NOT NULL,
+  `locality_id` INT NULL,
+  `distance_km` DECIMAL(10,2) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Route_Manifest_Stop_Route_Manifest1_idx` (`manifest_id` ASC),
+  INDEX `fk_Route_Manifest_Stop_Locality1_idx` (`locality_id` ASC),
+  CONSTRAINT `fk_Route_Manifest_Stop_Route_Manifest1`
+    FOREIGN KEY (`manifest_id`)
+    REFERENCES `fresh_market`.`route_manifest` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Route_Manifest_Stop_Locality1`
+    FOREIGN KEY (`locality_id`)
+    REFERENCES `fresh_market`.`locality` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`route_manifest_order`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`route_manifest_order` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `manifest_id` INT NOT NULL,
+  `stop_id` INT NOT NULL,
+  `purchase_order_id` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Route_Manifest_Order_Route_Manifest_Stop1_idx` (`stop_id` ASC),
+  INDEX `fk_Route_Manifest_Order_Purchase_Order1_idx` (`purchase_order_id` ASC),
+  UNIQUE INDEX `manifest_purchase_order_UNIQUE` (`manifest_id` ASC, `purchase_order_id` ASC),
+  CONSTRAINT `fk_Route_Manifest_Order_Route_Manifest1`
+    FOREIGN KEY (`manifest_id`)
+    REFERENCES `fresh_market`.`route_manifest` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Route_Manifest_Order_Route_Manifest_Stop1`
+    FOREIGN KEY (`stop_id`)
+    REFERENCES `fresh_market`.`route_manifest_stop` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Route_Manifest_Order_Purchase_Order1`
+    FOREIGN KEY (`purchase_order_id`)
+    REFERENCES `fresh_market`.`purchase_order` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
 -- -----------------------------------------------------
 -- Table `fresh_market`.`product_batch`
 -- -----------------------------------------------------
@@ -299,6 +571,7 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`product_batch` (
   `minimum_temperature` DECIMAL(19,2) NOT NULL,
   `product_id` INT NOT NULL,
   `section_id` INT NOT NULL,
+  `status` VARCHAR(20) NOT NULL DEFAULT 'available',
   PRIMARY KEY (`id`),
   INDEX `fk_Product_Batches_Product1_idx` (`product_id` ASC),
   INDEX `fk_Product_Batches_Section1_idx` (`section_id` ASC),
@@ -326,6 +599,7 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`inbound_order` (
   `product_batch_id` INT NOT NULL,
   `warehouse_id` INT NOT NULL,
   `employee_id` INT NOT NULL,
+  `received_at` DATETIME(6) NULL,
   PRIMARY KEY (`id`),
   INDEX `fk_Inbound_Orders_Product_Batches1_idx` (`product_batch_id` ASC),
   INDEX `fk_Inbound_Orders_Warehouse1_idx` (`warehouse_id` ASC),
@@ -374,14 +648,18 @@ ENGINE = InnoDB;
 -- -----------------------------------------------------
 CREATE TABLE IF NOT EXISTS `fresh_market`.`order_details` (
   `id` INT NOT NULL AUTO_INCREMENT,
-  `clean_lines_status` VARCHAR(255) NOT NULL,
+  `clean_lines_status` VARCHAR(255) NULL,
   `quantity` INT NOT NULL,
-  `temperature` DECIMAL(19,2) NOT NULL,
+  `temperature` DECIMAL(19,2) NULL,
+  `unit_price` DECIMAL(19,2) NULL,
   `product_record_id` INT NOT NULL,
   `purchase_order_id` INT NOT NULL,
+  `picked_by` INT NULL,
+  `picked_at` DATETIME(6) NULL,
   PRIMARY KEY (`id`),
   INDEX `fk_Order_Details_Product_Records1_idx` (`product_record_id` ASC),
   INDEX `fk_Order_Details_Purchase_Orders1_idx` (`purchase_order_id` ASC),
+  INDEX `fk_Order_Details_Employee1_idx` (`picked_by` ASC),
   UNIQUE INDEX `id_UNIQUE` (`id` ASC),
   CONSTRAINT `fk_Order_Details_Product_Records1`
     FOREIGN KEY (`product_record_id`)
@@ -392,6 +670,11 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`order_details` (
     FOREIGN KEY (`purchase_order_id`)
     REFERENCES `fresh_market`.`purchase_order` (`id`)
     ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Order_Details_Employee1`
+    FOREIGN KEY (`picked_by`)
+    REFERENCES `fresh_market`.`employee` (`id`)
+    ON DELETE NO ACTION
     ON UPDATE NO ACTION)
 ENGINE = InnoDB;
 
@@ -427,6 +710,170 @@ CREATE TABLE IF NOT EXISTS `fresh_market`.`user` (
 ENGINE = InnoDB;
 
 
+-- -----------------------------------------------------
+-- Table `fresh_market`.`settlement`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`settlement` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `seller_id` INT NOT NULL,
+  `period_start` DATE NOT NULL,
+  `period_end` DATE NOT NULL,
+  `status` VARCHAR(20) NOT NULL,
+  `total_amount` DECIMAL(19,2) NOT NULL,
+  `created_at` DATETIME NOT NULL,
+  `approved_at` DATETIME NULL,
+  `paid_at` DATETIME NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Settlement_Seller1_idx` (`seller_id` ASC),
+  CONSTRAINT `fk_Settlement_Seller1`
+    FOREIGN KEY (`seller_id`)
+    REFERENCES `fresh_market`.`seller` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`settlement_line`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`settlement_line` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `settlement_id` INT NOT NULL,
+  `order_details_id` INT NOT NULL,
+  `quantity` INT NOT NULL,
+  `unit_price` DECIMAL(19,2) NOT NULL,
+  `amount` DECIMAL(19,2) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  UNIQUE INDEX `order_details_id_UNIQUE` (`order_details_id` ASC),
+  INDEX `fk_Settlement_Line_Settlement1_idx` (`settlement_id` ASC),
+  CONSTRAINT `fk_Settlement_Line_Settlement1`
+    FOREIGN KEY (`settlement_id`)
+    REFERENCES `fresh_market`.`settlement` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Settlement_Line_Order_Details1`
+    FOREIGN KEY (`order_details_id`)
+    REFERENCES `fresh_market`.`order_details` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`rma`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`rma` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `purchase_order_id` INT NOT NULL,
+  `reason` VARCHAR(20) NOT NULL,
+  `notes` VARCHAR(255) NOT NULL DEFAULT '',
+  `status` VARCHAR(20) NOT NULL,
+  `disposition` VARCHAR(20) NULL,
+  `section_id` INT NULL,
+  `created_at` DATETIME NOT NULL,
+  `inspected_at` DATETIME NULL,
+  `closed_at` DATETIME NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Rma_Purchase_Order1_idx` (`purchase_order_id` ASC),
+  INDEX `fk_Rma_Section1_idx` (`section_id` ASC),
+  CONSTRAINT `fk_Rma_Purchase_Order1`
+    FOREIGN KEY (`purchase_order_id`)
+    REFERENCES `fresh_market`.`purchase_order` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Rma_Section1`
+    FOREIGN KEY (`section_id`)
+    REFERENCES `fresh_market`.`section` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`rma_line`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`rma_line` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `rma_id` INT NOT NULL,
+  `order_details_id` INT NOT NULL,
+  `product_id` INT NOT NULL,
+  `quantity` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Rma_Line_Rma1_idx` (`rma_id` ASC),
+  INDEX `fk_Rma_Line_Order_Details1_idx` (`order_details_id` ASC),
+  CONSTRAINT `fk_Rma_Line_Rma1`
+    FOREIGN KEY (`rma_id`)
+    REFERENCES `fresh_market`.`rma` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Rma_Line_Order_Details1`
+    FOREIGN KEY (`order_details_id`)
+    REFERENCES `fresh_market`.`order_details` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`inventory_adjustment`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`inventory_adjustment` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `rma_id` INT NULL,
+  `product_id` INT NOT NULL,
+  `section_id` INT NULL,
+  `type` VARCHAR(20) NOT NULL,
+  `quantity` INT NOT NULL,
+  `reason` VARCHAR(20) NOT NULL,
+  `created_at` DATETIME NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Inventory_Adjustment_Rma1_idx` (`rma_id` ASC),
+  INDEX `fk_Inventory_Adjustment_Product1_idx` (`product_id` ASC),
+  INDEX `fk_Inventory_Adjustment_Section1_idx` (`section_id` ASC),
+  CONSTRAINT `fk_Inventory_Adjustment_Rma1`
+    FOREIGN KEY (`rma_id`)
+    REFERENCES `fresh_market`.`rma` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Inventory_Adjustment_Product1`
+    FOREIGN KEY (`product_id`)
+    REFERENCES `fresh_market`.`product` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_Inventory_Adjustment_Section1`
+    FOREIGN KEY (`section_id`)
+    REFERENCES `fresh_market`.`section` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `fresh_market`.`batch_hold`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `fresh_market`.`batch_hold` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `product_batch_id` INT NOT NULL,
+  `reason` VARCHAR(255) NOT NULL,
+  `placed_at` DATETIME NOT NULL,
+  `release_reason` VARCHAR(255) NULL,
+  `released_at` DATETIME NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `id_UNIQUE` (`id` ASC),
+  INDEX `fk_Batch_Hold_Product_Batch1_idx` (`product_batch_id` ASC),
+  CONSTRAINT `fk_Batch_Hold_Product_Batch1`
+    FOREIGN KEY (`product_batch_id`)
+    REFERENCES `fresh_market`.`product_batch` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
 SET SQL_MODE=@OLD_SQL_MODE;
 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS;
 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS;
@@ -445,8 +892,34 @@ INSERT INTO `fresh_market`.`locality` (`id`, `name`, `province_id`) VALUES (5, "
 
 INSERT INTO `fresh_market`.`product_type` (`id`, `description`) VALUES (1, "processados");
 
+INSERT INTO `fresh_market`.`order_status` (`id`, `description`) VALUES (1, "pending");
+INSERT INTO `fresh_market`.`order_status` (`id`, `description`) VALUES (2, "shipped");
+INSERT INTO `fresh_market`.`order_status` (`id`, `description`) VALUES (3, "delivered");
+
 INSERT INTO `fresh_market`.`seller` (`id`, `cid`, `company_name`, `address`, `telephone`, `locality_id`) VALUES (1, "teste", "teste", "teste", "teste", 1);
 
 INSERT INTO `fresh_market`.`product` (`id`, `description`, `expiration_rate`, `freezing_rate`, `height`, `length`, `net_weight`, `product_code`, `recommended_freezing_temperature`, `width`, `seller_id`, `product_type_id`) VALUES (1, "Cafe", 1, 2, 6.4 , 4.5, 3.4, "PROD01", 1.3, 1.2, 1, 1);
 
-INSERT INTO `fresh_market`.`product_record` (`id`, `last_update_date`, `purchase_code`, `sale_price`, `product_id`) VALUES (1, curdate(), 5, 10, 1);
+INSERT INTO `fresh_market`.`product_record` (`id`, `last_update_date`, `purchase_price`, `sale_price`, `product_id`) VALUES (1, curdate(), 5, 10, 1);
+
+INSERT INTO `fresh_market`.`warehouse` (`id`, `address`, `telephone`, `warehouse_code`, `minimum_capacity`, `minimum_temperature`, `locality_id`) VALUES (1, "rua teste, 100", "1199999999", "WH01", 10, 2, 1);
+
+INSERT INTO `fresh_market`.`section` (`id`, `section_number`, `current_capacity`, `current_temperature`, `maximum_capacity`, `minimum_capacity`, `minimum_temperature`, `product_type_id`, `warehouse_id`) VALUES (1, "1", 100, 5, 500, 10, 2, 1, 1);
+
+INSERT INTO `fresh_market`.`product_batch` (`id`, `batch_number`, `current_quantity`, `current_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `minimum_temperature`, `product_id`, `section_id`) VALUES (1, "B01", 100, 5, "2030-01-01", 100, "2022-06-30", 8, 2, 1, 1);
+
+INSERT INTO `fresh_market`.`employee` (`id`, `id_card_number`, `first_name`, `last_name`, `warehouse_id`, `role`) VALUES (1, "6", "Marcos", "Mantovani", 1, "receiver");
+INSERT INTO `fresh_market`.`employee` (`id`, `id_card_number`, `first_name`, `last_name`, `warehouse_id`, `role`) VALUES (2, "7", "Ana", "Silva", 1, "picker");
+
+INSERT INTO `fresh_market`.`employee_assignment` (`id`, `employee_id`, `warehouse_id`, `start_date`) VALUES (1, 1, 1, "2022-01-01");
+INSERT INTO `fresh_market`.`employee_assignment` (`id`, `employee_id`, `warehouse_id`, `start_date`) VALUES (2, 2, 1, "2022-01-01");
+
+INSERT INTO `fresh_market`.`inbound_order` (`id`, `order_date`, `order_number`, `product_batch_id`, `warehouse_id`, `employee_id`, `received_at`) VALUES (1, "2022-07-01 08:00:00", "IN-1", 1, 1, 1, "2022-07-01 09:30:00");
+
+INSERT INTO `fresh_market`.`buyer` (`id`, `document_number`, `first_name`, `last_name`, `address`) VALUES (1, "529.982.247-25", "Joao", "Souza", "rua teste, 200");
+
+INSERT INTO `fresh_market`.`carrier` (`id`, `cid`, `company_name`, `address`, `telephone`, `locality_id`) VALUES (1, "11.222.333/0001-81", "transportes teste", "rua teste, 300", "(11) 8888-8888", 1);
+
+INSERT INTO `fresh_market`.`purchase_order` (`id`, `order_number`, `order_date`, `tracking_code`, `warehouse_id`, `carrier_id`, `buyer_id`, `order_status_id`) VALUES (1, "PO-1", "2022-07-02 10:00:00", "MF000000014BR", 1, 1, 1, 1);
+
+INSERT INTO `fresh_market`.`order_details` (`id`, `quantity`, `unit_price`, `product_record_id`, `purchase_order_id`, `picked_by`, `picked_at`) VALUES (1, 2, 10, 1, 1, 2, "2022-07-02 10:45:00");
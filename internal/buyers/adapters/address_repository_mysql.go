@@ -0,0 +1,180 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases"
+)
+
+type addressMySQLRepository struct {
+	db *sql.DB
+}
+
+func CreateAddressMySQLRepository(db *sql.DB) usecases.AddressRepository {
+	return &addressMySQLRepository{
+		db: db,
+	}
+}
+
+func (r *addressMySQLRepository) Create(buyerId int, address string, localityId int, isDefault bool) (domain.Address, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	if isDefault {
+		if _, err := tx.Exec(`UPDATE buyer_address SET is_default=0 WHERE buyer_id=?`, buyerId); err != nil {
+			_ = tx.Rollback()
+			return domain.Address{}, err
+		}
+	}
+
+	const query = `INSERT INTO buyer_address (buyer_id, address, locality_id, is_default) VALUES (?, ?, ?, ?)`
+
+	res, err := tx.Exec(query, buyerId, address, localityId, isDefault)
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Address{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Address{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Address{}, err
+	}
+
+	return domain.Address{
+		ID:         int(id),
+		BuyerId:    buyerId,
+		Address:    address,
+		LocalityId: localityId,
+		IsDefault:  isDefault,
+	}, nil
+}
+
+func (r *addressMySQLRepository) GetAllByBuyer(buyerId int) (domain.Addresses, error) {
+	const query = `SELECT id, buyer_id, address, locality_id, is_default FROM buyer_address WHERE buyer_id=? ORDER BY id`
+
+	rows, err := r.db.Query(query, buyerId)
+
+	if err != nil {
+		return domain.Addresses{}, err
+	}
+
+	defer rows.Close()
+
+	as := domain.Addresses{}
+
+	for rows.Next() {
+		a := domain.Address{}
+
+		if err := rows.Scan(&a.ID, &a.BuyerId, &a.Address, &a.LocalityId, &a.IsDefault); err != nil {
+			return domain.Addresses{}, err
+		}
+
+		as = append(as, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Addresses{}, err
+	}
+
+	return as, nil
+}
+
+func (r *addressMySQLRepository) GetById(buyerId int, id int) (domain.Address, error) {
+	const query = `SELECT id, buyer_id, address, locality_id, is_default FROM buyer_address WHERE buyer_id=? AND id=?`
+
+	a := domain.Address{}
+	err := r.db.QueryRow(query, buyerId, id).Scan(&a.ID, &a.BuyerId, &a.Address, &a.LocalityId, &a.IsDefault)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Address{}, &usecases.ErrNoElementFound{Err: errors.New("endereço não encontrado")}
+	}
+
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	return a, nil
+}
+
+func (r *addressMySQLRepository) Update(buyerId int, id int, address string, localityId int, isDefault bool) (domain.Address, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	if isDefault {
+		if _, err := tx.Exec(`UPDATE buyer_address SET is_default=0 WHERE buyer_id=? AND id<>?`, buyerId, id); err != nil {
+			_ = tx.Rollback()
+			return domain.Address{}, err
+		}
+	}
+
+	const query = `UPDATE buyer_address SET address=?, locality_id=?, is_default=? WHERE buyer_id=? AND id=?`
+
+	if _, err := tx.Exec(query, address, localityId, isDefault, buyerId, id); err != nil {
+		_ = tx.Rollback()
+		return domain.Address{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Address{}, err
+	}
+
+	return domain.Address{
+		ID:         id,
+		BuyerId:    buyerId,
+		Address:    address,
+		LocalityId: localityId,
+		IsDefault:  isDefault,
+	}, nil
+}
+
+func (r *addressMySQLRepository) Delete(buyerId int, id int) error {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(`DELETE FROM buyer_address WHERE buyer_id=? AND id=?`, buyerId, id)
+
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if rows == 0 {
+		_ = tx.Rollback()
+		return &usecases.ErrNoElementFound{Err: errors.New("endereço para deletar não encontrado")}
+	}
+
+	// promotes the oldest address when the default one was deleted
+	const query = `UPDATE buyer_address SET is_default=1 WHERE buyer_id=? AND NOT EXISTS (SELECT 1 FROM (SELECT id FROM buyer_address WHERE buyer_id=? AND is_default=1) d) ORDER BY id LIMIT 1`
+
+	if _, err := tx.Exec(query, buyerId, buyerId); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,186 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases"
+)
+
+type AddressController struct {
+	service usecases.AddressService
+}
+
+func CreateAddressController(as usecases.AddressService) *AddressController {
+	return &AddressController{
+		service: as,
+	}
+}
+
+func (ac *AddressController) CreateAddress(ctx *gin.Context) {
+	buyerId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req addressRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	a, err := ac.service.Create(buyerId, req.Address, req.LocalityId, req.IsDefault)
+	if err != nil {
+		addressError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"data": a,
+	})
+}
+
+func (ac *AddressController) GetAllAddresses(ctx *gin.Context) {
+	buyerId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	as, err := ac.service.GetAllByBuyer(buyerId)
+	if err != nil {
+		addressError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": as,
+	})
+}
+
+func (ac *AddressController) UpdateAddress(ctx *gin.Context) {
+	buyerId, id, ok := addressIds(ctx)
+
+	if !ok {
+		return
+	}
+
+	var req addressRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	a, err := ac.service.Update(buyerId, id, req.Address, req.LocalityId, req.IsDefault)
+	if err != nil {
+		addressError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": a,
+	})
+}
+
+func (ac *AddressController) DeleteAddress(ctx *gin.Context) {
+	buyerId, id, ok := addressIds(ctx)
+
+	if !ok {
+		return
+	}
+
+	if err := ac.service.Delete(buyerId, id); err != nil {
+		addressError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, gin.H{})
+}
+
+type addressRequest struct {
+	Address    string `json:"address" binding:"required"`
+	LocalityId int    `json:"locality_id" binding:"required"`
+	IsDefault  bool   `json:"is_default"`
+}
+
+func (ar *addressRequest) Validate() error {
+	if strings.TrimSpace(ar.Address) == "" {
+		return errors.New("address can't be empty")
+	}
+
+	if ar.LocalityId < 1 {
+		return errors.New("locality id can't be smaller than 1")
+	}
+
+	return nil
+}
+
+func addressIds(ctx *gin.Context) (int, int, bool) {
+	buyerId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return 0, 0, false
+	}
+
+	id, err := strconv.Atoi(ctx.Param("address_id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid address id",
+		})
+		return 0, 0, false
+	}
+
+	return buyerId, id, true
+}
+
+func addressError(ctx *gin.Context, err error) {
+	if CustomError(err) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrInvalidLocalityId) {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": "internal server error",
+	})
+}
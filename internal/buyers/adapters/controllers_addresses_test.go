@@ -0,0 +1,147 @@
+package adapters_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeDBAddress() domain.Address {
+	return domain.Address{
+		ID:         1,
+		BuyerId:    1,
+		Address:    "address",
+		LocalityId: 1,
+		IsDefault:  true,
+	}
+}
+
+func makeAddressRouter(t *testing.T) (*gin.Engine, *mocks.AddressService) {
+	gin.SetMode(gin.TestMode)
+
+	mockAddressService := mocks.NewAddressService(t)
+	sut := adapters.CreateAddressController(mockAddressService)
+
+	r := gin.Default()
+	r.GET("/buyers/:id/addresses", sut.GetAllAddresses)
+	r.POST("/buyers/:id/addresses", sut.CreateAddress)
+	r.PATCH("/buyers/:id/addresses/:address_id", sut.UpdateAddress)
+	r.DELETE("/buyers/:id/addresses/:address_id", sut.DeleteAddress)
+
+	return r, mockAddressService
+}
+
+func TestCreateAddress(t *testing.T) {
+	r, mockAddressService := makeAddressRouter(t)
+
+	t.Run("Should return an error and 400 status if body request contains invalid data", func(t *testing.T) {
+		for _, body := range []string{`{"address": " ", "locality_id": 1}`, `{"address": "address", "locality_id": -1}`} {
+			rr := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/buyers/1/addresses", bytes.NewBufferString(body))
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code, body)
+		}
+	})
+
+	t.Run("Should return an error and 404 status if the buyer does not exist", func(t *testing.T) {
+		mockAddressService.On("Create", 1, "address", 1, false).Return(domain.Address{}, &usecases.ErrNoElementFound{Err: errors.New("Id não encontrado")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/buyers/1/addresses", bytes.NewBufferString(`{"address": "address", "locality_id": 1}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return an error and 422 status if the locality does not exist", func(t *testing.T) {
+		mockAddressService.On("Create", 1, "address", 9, false).Return(domain.Address{}, usecases.ErrInvalidLocalityId).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/buyers/1/addresses", bytes.NewBufferString(`{"address": "address", "locality_id": 9}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "{\"error\":\"this locality_id is invalid\"}", rr.Body.String())
+	})
+
+	t.Run("Should 201 status and data on success", func(t *testing.T) {
+		mockAddressService.On("Create", 1, "address", 1, true).Return(makeDBAddress(), nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/buyers/1/addresses", bytes.NewBufferString(`{"address": "address", "locality_id": 1, "is_default": true}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"buyer_id\":1,\"address\":\"address\",\"locality_id\":1,\"is_default\":true}}", rr.Body.String())
+	})
+}
+
+func TestGetAllAddresses(t *testing.T) {
+	r, mockAddressService := makeAddressRouter(t)
+
+	t.Run("Should return an error and 500 status if GetAllByBuyer did not returns an custom error", func(t *testing.T) {
+		mockAddressService.On("GetAllByBuyer", 1).Return(domain.Addresses{}, errors.New("any_message")).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/buyers/1/addresses", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("Should 200 status and data on success", func(t *testing.T) {
+		mockAddressService.On("GetAllByBuyer", 1).Return(domain.Addresses{makeDBAddress()}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/buyers/1/addresses", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":[{\"id\":1,\"buyer_id\":1,\"address\":\"address\",\"locality_id\":1,\"is_default\":true}]}", rr.Body.String())
+	})
+}
+
+func TestUpdateAndDeleteAddress(t *testing.T) {
+	r, mockAddressService := makeAddressRouter(t)
+
+	t.Run("Should return an error and 400 status if address id is invalid", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1/addresses/abc", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"invalid address id\"}", rr.Body.String())
+	})
+
+	t.Run("Should 200 status and data on update success", func(t *testing.T) {
+		mockAddressService.On("Update", 1, 1, "address", 1, false).Return(makeDBAddress(), nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/buyers/1/addresses/1", bytes.NewBufferString(`{"address": "address", "locality_id": 1}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Should return an error and 404 status if the address does not exist", func(t *testing.T) {
+		mockAddressService.On("Delete", 1, 2).Return(&usecases.ErrNoElementFound{Err: errors.New("endereço para deletar não encontrado")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1/addresses/2", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should 204 status on delete success", func(t *testing.T) {
+		mockAddressService.On("Delete", 1, 1).Return(nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1/addresses/1", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/web"
 )
 
 type BuyerController struct {
@@ -40,6 +41,9 @@ func (bc *BuyerController) CreateBuyer(ctx *gin.Context) {
 
 	b, err := bc.service.Create(req.FirstName, req.LastName, req.Address, req.DocumentNumber)
 	if err != nil {
+		if documentError(ctx, err) {
+			return
+		}
 		if CustomError(err) {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
@@ -58,7 +62,16 @@ func (bc *BuyerController) CreateBuyer(ctx *gin.Context) {
 }
 
 func (bc *BuyerController) GetAllBuyers(ctx *gin.Context) {
-	b, err := bc.service.GetAll()
+	includeDeleted, err := web.QueryBool(ctx, "include_deleted")
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "include_deleted must be true or false",
+		})
+		return
+	}
+
+	b, err := bc.service.GetAll(includeDeleted)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "internal server error",
@@ -127,6 +140,9 @@ func (bc *BuyerController) UpdateBuyerById(ctx *gin.Context) {
 
 	b, err := bc.service.UpdateBuyerById(id, req.FirstName, req.LastName, req.Address, req.DocumentNumber)
 	if err != nil {
+		if documentError(ctx, err) {
+			return
+		}
 		if CustomError(err) {
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"error": err.Error(),
@@ -153,8 +169,23 @@ func (bc *BuyerController) DeleteBuyerById(ctx *gin.Context) {
 		return
 	}
 
-	err = bc.service.DeleteBuyerById(id)
+	hard, err := web.QueryBool(ctx, "hard")
+
 	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "hard must be true or false",
+		})
+		return
+	}
+
+	err = bc.service.DeleteBuyerById(id, hard)
+	if err != nil {
+		if errors.Is(err, usecases.ErrBuyerHasOrders) {
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		if CustomError(err) {
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"error": err.Error(),
@@ -169,6 +200,35 @@ func (bc *BuyerController) DeleteBuyerById(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, gin.H{})
 }
 
+func (bc *BuyerController) RestoreBuyerById(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	b, err := bc.service.RestoreBuyerById(id)
+	if err != nil {
+		if CustomError(err) {
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": b,
+	})
+}
+
 type buyerRequest struct {
 	ID             int    `json:"id"`
 	FirstName      string `json:"first_name" binding:"required"`
@@ -197,6 +257,23 @@ func (br *buyerRequest) Validate() error {
 	return nil
 }
 
+func documentError(ctx *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidDocument):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+	case errors.Is(err, usecases.ErrDocumentInUse):
+		ctx.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+	default:
+		return false
+	}
+
+	return true
+}
+
 func CustomError(e error) bool {
 	var fe *usecases.ErrNoElementFound
 
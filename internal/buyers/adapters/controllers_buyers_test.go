@@ -170,6 +170,26 @@ func TestCreateBuyer(t *testing.T) {
 		assert.Equal(t, "{\"error\":\"internal server error\"}", rr.Body.String())
 	})
 
+	t.Run("Should return an error and 422 status if Create from Buyers Service returns ErrInvalidDocument", func(t *testing.T) {
+		mockBuyerService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.Buyer{}, usecases.ErrInvalidDocument).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/buyers", makeValidCreateBody())
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "{\"error\":\"document must be a valid CPF or CNPJ\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 409 status if Create from Buyers Service returns ErrDocumentInUse", func(t *testing.T) {
+		mockBuyerService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(domain.Buyer{}, usecases.ErrDocumentInUse).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/buyers", makeValidCreateBody())
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, "{\"error\":\"this document is in use\"}", rr.Body.String())
+	})
+
 	t.Run("Should 201 status and data on success", func(t *testing.T) {
 		mockBuyerService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(makeDBBuyer(), nil).Once()
 		rr := httptest.NewRecorder()
@@ -191,7 +211,7 @@ func TestGetAllBuyer(t *testing.T) {
 	r.GET("/buyers", sut.GetAllBuyers)
 
 	t.Run("Should call GetAll from Buyers Service", func(t *testing.T) {
-		mockBuyerService.On("GetAll").Return(domain.Buyers{makeDBBuyer()}, nil).Once()
+		mockBuyerService.On("GetAll", false).Return(domain.Buyers{makeDBBuyer()}, nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/buyers", nil)
 		r.ServeHTTP(rr, req)
@@ -200,7 +220,7 @@ func TestGetAllBuyer(t *testing.T) {
 	})
 
 	t.Run("Should return an error and 500 status if GetAll from Buyers Service returns an error", func(t *testing.T) {
-		mockBuyerService.On("GetAll").Return(domain.Buyers{}, errors.New("any_message")).Once()
+		mockBuyerService.On("GetAll", false).Return(domain.Buyers{}, errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/buyers", nil)
 		r.ServeHTTP(rr, req)
@@ -210,7 +230,7 @@ func TestGetAllBuyer(t *testing.T) {
 	})
 
 	t.Run("Should 200 status and data on success", func(t *testing.T) {
-		mockBuyerService.On("GetAll").Return(domain.Buyers{makeDBBuyer()}, nil).Once()
+		mockBuyerService.On("GetAll", false).Return(domain.Buyers{makeDBBuyer()}, nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/buyers", nil)
 		r.ServeHTTP(rr, req)
@@ -375,16 +395,16 @@ func TestDeleteBuyerByIdBuyer(t *testing.T) {
 	})
 
 	t.Run("Should call DeleteBuyerById from Buyer Service with correct id", func(t *testing.T) {
-		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int")).Return(nil).Once()
+		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int"), false).Return(nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1", nil)
 		r.ServeHTTP(rr, req)
 
-		mockBuyerService.AssertCalled(t, "DeleteBuyerById", 1)
+		mockBuyerService.AssertCalled(t, "DeleteBuyerById", 1, false)
 	})
 
 	t.Run("Should return an error and 404 status if DeleteBuyerById from Buyer Service returns not find the correspondent element", func(t *testing.T) {
-		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int")).Return(&usecases.ErrNoElementFound{Err: errors.New("any_message")}).Once()
+		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int"), false).Return(&usecases.ErrNoElementFound{Err: errors.New("any_message")}).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/buyers/404", nil)
 		r.ServeHTTP(rr, req)
@@ -394,7 +414,7 @@ func TestDeleteBuyerByIdBuyer(t *testing.T) {
 	})
 
 	t.Run("Should return an error and 500 status if DeleteBuyerById from Buyer Service returns an error", func(t *testing.T) {
-		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int")).Return(errors.New("any_message")).Once()
+		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int"), false).Return(errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1", nil)
 		r.ServeHTTP(rr, req)
@@ -404,7 +424,7 @@ func TestDeleteBuyerByIdBuyer(t *testing.T) {
 	})
 
 	t.Run("Should 200 status and data on success", func(t *testing.T) {
-		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int")).Return(nil).Once()
+		mockBuyerService.On("DeleteBuyerById", mock.AnythingOfType("int"), false).Return(nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1", nil)
 		r.ServeHTTP(rr, req)
@@ -412,4 +432,50 @@ func TestDeleteBuyerByIdBuyer(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, rr.Code)
 		assert.Empty(t, rr.Body.String())
 	})
+	t.Run("Should call DeleteBuyerById with hard if informed and return 409 status if the buyer has orders", func(t *testing.T) {
+		mockBuyerService.On("DeleteBuyerById", 1, true).Return(usecases.ErrBuyerHasOrders).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1?hard=true", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, "{\"error\":\"buyer has purchase orders and can't be permanently deleted\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 400 status if hard is not a boolean", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/buyers/1?hard=yes", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestRestoreBuyerById(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockBuyerService := mocks.NewService(t)
+	sut := adapters.CreateBuyerController(mockBuyerService)
+
+	r := gin.Default()
+	r.POST("/buyers/:id/restore", sut.RestoreBuyerById)
+
+	t.Run("Should return an error and 404 status if there is no deleted buyer with the id", func(t *testing.T) {
+		mockBuyerService.On("RestoreBuyerById", 1).Return(domain.Buyer{}, &usecases.ErrNoElementFound{Err: errors.New("any_message")}).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/buyers/1/restore", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should 200 status and data on success", func(t *testing.T) {
+		mockBuyerService.On("RestoreBuyerById", 1).Return(makeDBBuyer(), nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/buyers/1/restore", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"first_name\":\"first name\",\"last_name\":\"last name\",\"address\":\"address\",\"document_number\":\"doc number\"}}", rr.Body.String())
+	})
 }
@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"database/sql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases"
+)
+
+type localityMySQLRepository struct {
+	db *sql.DB
+}
+
+func CreateLocalityMySQLRepository(db *sql.DB) usecases.LocalityRepository {
+	return &localityMySQLRepository{
+		db: db,
+	}
+}
+
+func (r *localityMySQLRepository) Exists(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM locality WHERE id=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
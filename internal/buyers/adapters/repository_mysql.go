@@ -6,6 +6,7 @@ import (
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases"
+	doc "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/document"
 
 )
 
@@ -54,8 +55,12 @@ func (r *buyerMySQLRepository) Create(firstName string, lastName string, address
 	}, nil
 }
 
-func (r *buyerMySQLRepository) GetAll() (domain.Buyers, error) {
-	const query = `SELECT id, first_name, last_name, address, document_number FROM buyer`
+func (r *buyerMySQLRepository) GetAll(includeDeleted bool) (domain.Buyers, error) {
+	query := `SELECT id, first_name, last_name, address, document_number, deleted_at FROM buyer`
+
+	if !includeDeleted {
+		query += ` WHERE deleted_at IS NULL`
+	}
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -68,7 +73,7 @@ func (r *buyerMySQLRepository) GetAll() (domain.Buyers, error) {
 
 	for rows.Next() {
 		b := domain.Buyer{}
-		rows.Scan(&b.ID, &b.FirstName, &b.LastName, &b.Address, &b.DocumentNumber)
+		rows.Scan(&b.ID, &b.FirstName, &b.LastName, &b.Address, &b.DocumentNumber, &b.DeletedAt)
 		bs = append(bs, b)
 	}
 
@@ -80,7 +85,7 @@ func (r *buyerMySQLRepository) GetAll() (domain.Buyers, error) {
 }
 
 func (r *buyerMySQLRepository) GetBuyerById(id int) (domain.Buyer, error) {
-	const query = `SELECT id, first_name, last_name, address, document_number FROM buyer WHERE id=?`
+	const query = `SELECT id, first_name, last_name, address, document_number FROM buyer WHERE id=? AND deleted_at IS NULL`
 
 	b := domain.Buyer{}
 	err := r.db.QueryRow(query, id).Scan(&b.ID, &b.FirstName, &b.LastName, &b.Address, &b.DocumentNumber)
@@ -97,8 +102,25 @@ func (r *buyerMySQLRepository) GetBuyerById(id int) (domain.Buyer, error) {
 
 }
 
+func (r *buyerMySQLRepository) GetBuyerByDocument(document string) (domain.Buyer, error) {
+	query := `SELECT id, first_name, last_name, address, document_number FROM buyer WHERE ` + doc.KeySQL("document_number") + `=?`
+
+	b := domain.Buyer{}
+	err := r.db.QueryRow(query, doc.Key(document)).Scan(&b.ID, &b.FirstName, &b.LastName, &b.Address, &b.DocumentNumber)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Buyer{}, &usecases.ErrNoElementFound{Err: errors.New("documento não encontrado")}
+	}
+
+	if err != nil {
+		return domain.Buyer{}, err
+	}
+
+	return b, nil
+}
+
 func (r *buyerMySQLRepository) UpdateBuyerById(id int, firstName string, lastName string, address string, document string) (domain.Buyer, error) {
-	const query = `UPDATE buyer SET first_name=?, last_name=?, address=?, document_number=? WHERE id=?`
+	const query = `UPDATE buyer SET first_name=?, last_name=?, address=?, document_number=? WHERE id=? AND deleted_at IS NULL`
 
 	res, err := r.db.Exec(query, firstName, lastName, address, document, id)
 
@@ -149,3 +171,59 @@ func (r *buyerMySQLRepository) DeleteBuyerById(id int) error {
 	return nil
 
 }
+
+func (r *buyerMySQLRepository) SoftDeleteBuyerById(id int) error {
+	const query = `UPDATE buyer SET deleted_at=NOW() WHERE id=? AND deleted_at IS NULL`
+
+	res, err := r.db.Exec(query, id)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return &usecases.ErrNoElementFound{Err: errors.New("elemento para deletar não encontrado")}
+	}
+
+	return nil
+}
+
+func (r *buyerMySQLRepository) RestoreBuyerById(id int) (domain.Buyer, error) {
+	const query = `UPDATE buyer SET deleted_at=NULL WHERE id=? AND deleted_at IS NOT NULL`
+
+	res, err := r.db.Exec(query, id)
+
+	if err != nil {
+		return domain.Buyer{}, err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return domain.Buyer{}, err
+	}
+
+	if rows == 0 {
+		return domain.Buyer{}, &usecases.ErrNoElementFound{Err: errors.New("elemento deletado para restaurar não encontrado")}
+	}
+
+	return r.GetBuyerById(id)
+}
+
+func (r *buyerMySQLRepository) HasPurchaseOrders(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM purchase_order WHERE buyer_id=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
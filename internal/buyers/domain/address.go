@@ -0,0 +1,11 @@
+package domain
+
+type Address struct {
+	ID         int    `json:"id"`
+	BuyerId    int    `json:"buyer_id"`
+	Address    string `json:"address"`
+	LocalityId int    `json:"locality_id"`
+	IsDefault  bool   `json:"is_default"`
+}
+
+type Addresses []Address
@@ -6,6 +6,7 @@ type Buyer struct {
 	LastName string `json:"last_name"`
 	Address string `json:"address"`
 	DocumentNumber string `json:"document_number"`
+	DeletedAt *string `json:"deleted_at,omitempty"`
 }
 
 type Buyers []Buyer
\ No newline at end of file
@@ -0,0 +1,18 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
+
+// AddressRepository keeps a single default address per buyer: saving an
+// address as default clears the flag on the others, and deleting the default
+// promotes the oldest remaining address.
+type AddressRepository interface {
+	Create(buyerId int, address string, localityId int, isDefault bool) (domain.Address, error)
+	GetAllByBuyer(buyerId int) (domain.Addresses, error)
+	GetById(buyerId int, id int) (domain.Address, error)
+	Update(buyerId int, id int, address string, localityId int, isDefault bool) (domain.Address, error)
+	Delete(buyerId int, id int) error
+}
+
+type LocalityRepository interface {
+	Exists(id int) (bool, error)
+}
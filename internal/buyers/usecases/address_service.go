@@ -0,0 +1,88 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
+
+type AddressService interface {
+	Create(buyerId int, address string, localityId int, isDefault bool) (domain.Address, error)
+	GetAllByBuyer(buyerId int) (domain.Addresses, error)
+	Update(buyerId int, id int, address string, localityId int, isDefault bool) (domain.Address, error)
+	Delete(buyerId int, id int) error
+}
+
+type addressService struct {
+	addressRepository  AddressRepository
+	buyerRepository    BuyerRepository
+	localityRepository LocalityRepository
+}
+
+func CreateAddressService(ar AddressRepository, br BuyerRepository, lr LocalityRepository) AddressService {
+	return &addressService{
+		addressRepository:  ar,
+		buyerRepository:    br,
+		localityRepository: lr,
+	}
+}
+
+func (s *addressService) checkLocality(localityId int) error {
+	exists, err := s.localityRepository.Exists(localityId)
+
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrInvalidLocalityId
+	}
+
+	return nil
+}
+
+func (s *addressService) Create(buyerId int, address string, localityId int, isDefault bool) (domain.Address, error) {
+	if _, err := s.buyerRepository.GetBuyerById(buyerId); err != nil {
+		return domain.Address{}, err
+	}
+
+	if err := s.checkLocality(localityId); err != nil {
+		return domain.Address{}, err
+	}
+
+	addresses, err := s.addressRepository.GetAllByBuyer(buyerId)
+
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	// the first address of a buyer is always the default one
+	if len(addresses) == 0 {
+		isDefault = true
+	}
+
+	return s.addressRepository.Create(buyerId, address, localityId, isDefault)
+}
+
+func (s *addressService) GetAllByBuyer(buyerId int) (domain.Addresses, error) {
+	if _, err := s.buyerRepository.GetBuyerById(buyerId); err != nil {
+		return domain.Addresses{}, err
+	}
+
+	return s.addressRepository.GetAllByBuyer(buyerId)
+}
+
+func (s *addressService) Update(buyerId int, id int, address string, localityId int, isDefault bool) (domain.Address, error) {
+	current, err := s.addressRepository.GetById(buyerId, id)
+
+	if err != nil {
+		return domain.Address{}, err
+	}
+
+	if err := s.checkLocality(localityId); err != nil {
+		return domain.Address{}, err
+	}
+
+	// the default only moves by choosing another address as default
+	return s.addressRepository.Update(buyerId, id, address, localityId, isDefault || current.IsDefault)
+}
+
+func (s *addressService) Delete(buyerId int, id int) error {
+	return s.addressRepository.Delete(buyerId, id)
+}
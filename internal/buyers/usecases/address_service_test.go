@@ -0,0 +1,111 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeAddress() domain.Address {
+	return domain.Address{
+		ID:         1,
+		BuyerId:    1,
+		Address:    "valid_address",
+		LocalityId: 1,
+		IsDefault:  true,
+	}
+}
+
+func makeAddressSut(t *testing.T) (usecases.AddressService, *mocks.AddressRepository, *mocks.BuyerRepository, *mocks.LocalityRepository) {
+	mockAddressRepository := mocks.NewAddressRepository(t)
+	mockBuyerRepository := mocks.NewBuyerRepository(t)
+	mockLocalityRepository := mocks.NewLocalityRepository(t)
+	service := usecases.CreateAddressService(mockAddressRepository, mockBuyerRepository, mockLocalityRepository)
+
+	return service, mockAddressRepository, mockBuyerRepository, mockLocalityRepository
+}
+
+func TestCreateAddress(t *testing.T) {
+	t.Run("create_buyer_non_existent", func(t *testing.T) {
+		service, _, mockBuyerRepository, _ := makeAddressSut(t)
+		mockBuyerRepository.On("GetBuyerById", 1).Return(domain.Buyer{}, &usecases.ErrNoElementFound{Err: errors.New("Error")}).Once()
+
+		_, err := service.Create(1, "valid_address", 1, false)
+
+		var ne *usecases.ErrNoElementFound
+		assert.ErrorAs(t, err, &ne)
+	})
+
+	t.Run("create_locality_non_existent", func(t *testing.T) {
+		service, _, mockBuyerRepository, mockLocalityRepository := makeAddressSut(t)
+		mockBuyerRepository.On("GetBuyerById", 1).Return(makeBuyer(), nil).Once()
+		mockLocalityRepository.On("Exists", 9).Return(false, nil).Once()
+
+		_, err := service.Create(1, "valid_address", 9, false)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidLocalityId)
+	})
+
+	t.Run("create_first_address_as_default", func(t *testing.T) {
+		service, mockAddressRepository, mockBuyerRepository, mockLocalityRepository := makeAddressSut(t)
+		mockBuyerRepository.On("GetBuyerById", 1).Return(makeBuyer(), nil).Once()
+		mockLocalityRepository.On("Exists", 1).Return(true, nil).Once()
+		mockAddressRepository.On("GetAllByBuyer", 1).Return(domain.Addresses{}, nil).Once()
+		mockAddressRepository.On("Create", 1, "valid_address", 1, true).Return(makeAddress(), nil).Once()
+
+		a, err := service.Create(1, "valid_address", 1, false)
+
+		assert.Equal(t, makeAddress(), a)
+		assert.Nil(t, err)
+	})
+
+	t.Run("create_other_address_keeps_informed_default", func(t *testing.T) {
+		service, mockAddressRepository, mockBuyerRepository, mockLocalityRepository := makeAddressSut(t)
+		mockBuyerRepository.On("GetBuyerById", 1).Return(makeBuyer(), nil).Once()
+		mockLocalityRepository.On("Exists", 1).Return(true, nil).Once()
+		mockAddressRepository.On("GetAllByBuyer", 1).Return(domain.Addresses{makeAddress()}, nil).Once()
+		mockAddressRepository.On("Create", 1, "other_address", 1, false).Return(domain.Address{ID: 2}, nil).Once()
+
+		_, err := service.Create(1, "other_address", 1, false)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestUpdateAddress(t *testing.T) {
+	t.Run("update_non_existent", func(t *testing.T) {
+		service, mockAddressRepository, _, _ := makeAddressSut(t)
+		mockAddressRepository.On("GetById", 1, 2).Return(domain.Address{}, &usecases.ErrNoElementFound{Err: errors.New("Error")}).Once()
+
+		_, err := service.Update(1, 2, "valid_address", 1, false)
+
+		var ne *usecases.ErrNoElementFound
+		assert.ErrorAs(t, err, &ne)
+	})
+
+	t.Run("update_keeps_default_address_as_default", func(t *testing.T) {
+		service, mockAddressRepository, _, mockLocalityRepository := makeAddressSut(t)
+		mockAddressRepository.On("GetById", 1, 1).Return(makeAddress(), nil).Once()
+		mockLocalityRepository.On("Exists", 2).Return(true, nil).Once()
+		mockAddressRepository.On("Update", 1, 1, "updated_address", 2, true).Return(makeAddress(), nil).Once()
+
+		_, err := service.Update(1, 1, "updated_address", 2, false)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestDeleteAddress(t *testing.T) {
+	t.Run("delete_ok", func(t *testing.T) {
+		service, mockAddressRepository, _, _ := makeAddressSut(t)
+		mockAddressRepository.On("Delete", 1, 1).Return(nil).Once()
+
+		err := service.Delete(1, 1)
+
+		assert.Nil(t, err)
+	})
+}
@@ -1,5 +1,6 @@
 package usecases
 
+import "errors"
 
 type ErrNoElementFound struct {
 	Err error
@@ -7,4 +8,12 @@ type ErrNoElementFound struct {
 
 func (b *ErrNoElementFound) Error() string {
 	return b.Err.Error()
-}
\ No newline at end of file
+}
+
+var ErrInvalidDocument = errors.New("document must be a valid CPF or CNPJ")
+
+var ErrDocumentInUse = errors.New("this document is in use")
+
+var ErrInvalidLocalityId = errors.New("this locality_id is invalid")
+
+var ErrBuyerHasOrders = errors.New("buyer has purchase orders and can't be permanently deleted")
@@ -33,13 +33,13 @@ func (_m *Service) Create(firstName string, lastName string, address string, doc
 	return r0, r1
 }
 
-// DeleteBuyerById provides a mock function with given fields: id
-func (_m *Service) DeleteBuyerById(id int) error {
-	ret := _m.Called(id)
+// DeleteBuyerById provides a mock function with given fields: id, hard
+func (_m *Service) DeleteBuyerById(id int, hard bool) error {
+	ret := _m.Called(id, hard)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(int, bool) error); ok {
+		r0 = rf(id, hard)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,13 +47,13 @@ func (_m *Service) DeleteBuyerById(id int) error {
 	return r0
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *Service) GetAll() (domain.Buyers, error) {
-	ret := _m.Called()
+// GetAll provides a mock function with given fields: includeDeleted
+func (_m *Service) GetAll(includeDeleted bool) (domain.Buyers, error) {
+	ret := _m.Called(includeDeleted)
 
 	var r0 domain.Buyers
-	if rf, ok := ret.Get(0).(func() domain.Buyers); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(bool) domain.Buyers); ok {
+		r0 = rf(includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(domain.Buyers)
@@ -61,8 +61,8 @@ func (_m *Service) GetAll() (domain.Buyers, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -91,6 +91,27 @@ func (_m *Service) GetBuyerById(id int) (domain.Buyer, error) {
 	return r0, r1
 }
 
+// RestoreBuyerById provides a mock function with given fields: id
+func (_m *Service) RestoreBuyerById(id int) (domain.Buyer, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Buyer
+	if rf, ok := ret.Get(0).(func(int) domain.Buyer); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Buyer)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateBuyerById provides a mock function with given fields: id, firstName, lastName, address, document
 func (_m *Service) UpdateBuyerById(id int, firstName string, lastName string, address string, document string) (domain.Buyer, error) {
 	ret := _m.Called(id, firstName, lastName, address, document)
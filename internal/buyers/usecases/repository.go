@@ -6,8 +6,12 @@ import (
 
 type BuyerRepository interface {
 	Create(firstName string, lastName string, address string, document string) (domain.Buyer, error)
-	GetAll() (domain.Buyers, error)
+	GetAll(includeDeleted bool) (domain.Buyers, error)
 	GetBuyerById(id int) (domain.Buyer, error)
+	GetBuyerByDocument(document string) (domain.Buyer, error)
 	UpdateBuyerById(id int, firstName string, lastName string, address string, document string) (domain.Buyer, error)
 	DeleteBuyerById(id int) error
+	SoftDeleteBuyerById(id int) error
+	RestoreBuyerById(id int) (domain.Buyer, error)
+	HasPurchaseOrders(id int) (bool, error)
 }
\ No newline at end of file
@@ -1,13 +1,19 @@
 package usecases
 
-import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
+import (
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/buyers/domain"
+	doc "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/document"
+)
 
 type Service interface {
 	Create(firstName string, lastName string, address string, document string) (domain.Buyer, error)
-	GetAll() (domain.Buyers, error)
+	GetAll(includeDeleted bool) (domain.Buyers, error)
 	GetBuyerById(id int) (domain.Buyer, error)
 	UpdateBuyerById(id int, firstName string, lastName string, address string, document string) (domain.Buyer, error)
-	DeleteBuyerById(id int) error
+	DeleteBuyerById(id int, hard bool) error
+	RestoreBuyerById(id int) (domain.Buyer, error)
 }
 
 type service struct {
@@ -20,7 +26,35 @@ func CreateBuyerService(r BuyerRepository) Service {
 	}
 }
 
+func (s *service) validateDocument(id int, document string) (string, error) {
+	normalized, _, err := doc.Normalize(document)
+
+	if err != nil {
+		return "", ErrInvalidDocument
+	}
+
+	b, err := s.repository.GetBuyerByDocument(normalized)
+
+	var notFound *ErrNoElementFound
+
+	if err != nil && !errors.As(err, &notFound) {
+		return "", err
+	}
+
+	if err == nil && b.ID != id {
+		return "", ErrDocumentInUse
+	}
+
+	return normalized, nil
+}
+
 func (s *service) Create(firstName string, lastName string, address string, document string) (domain.Buyer, error) {
+	document, err := s.validateDocument(0, document)
+
+	if err != nil {
+		return domain.Buyer{}, err
+	}
+
 	buyer, err := s.repository.Create(firstName, lastName, address, document)
 
 	if err != nil {
@@ -30,8 +64,8 @@ func (s *service) Create(firstName string, lastName string, address string, docu
 	return buyer, nil
 }
 
-func (s *service) GetAll() (domain.Buyers, error) {
-	ws, err := s.repository.GetAll()
+func (s *service) GetAll(includeDeleted bool) (domain.Buyers, error) {
+	ws, err := s.repository.GetAll(includeDeleted)
 
 	if err != nil {
 		return domain.Buyers{}, err
@@ -51,6 +85,12 @@ func (s *service) GetBuyerById(id int) (domain.Buyer, error) {
 }
 
 func (s *service) UpdateBuyerById(id int, firstName string, lastName string, address string, document string) (domain.Buyer, error) {
+	document, err := s.validateDocument(id, document)
+
+	if err != nil {
+		return domain.Buyer{}, err
+	}
+
 	w, err := s.repository.UpdateBuyerById(id, firstName, lastName, address, document)
 
 	if err != nil {
@@ -60,12 +100,37 @@ func (s *service) UpdateBuyerById(id int, firstName string, lastName string, add
 	return w, nil
 }
 
-func (s *service) DeleteBuyerById(id int) error {
-	err := s.repository.DeleteBuyerById(id)
+// DeleteBuyerById refuses a hard delete of buyers with purchase orders.
+func (s *service) DeleteBuyerById(id int, hard bool) error {
+	if !hard {
+		return s.repository.SoftDeleteBuyerById(id)
+	}
+
+	hasOrders, err := s.repository.HasPurchaseOrders(id)
+
+	if err != nil {
+		return err
+	}
+
+	if hasOrders {
+		return ErrBuyerHasOrders
+	}
+
+	err = s.repository.DeleteBuyerById(id)
 
 	if err != nil {
 		return err
 	}
 
 	return nil
+}
+
+func (s *service) RestoreBuyerById(id int) (domain.Buyer, error) {
+	b, err := s.repository.RestoreBuyerById(id)
+
+	if err != nil {
+		return domain.Buyer{}, err
+	}
+
+	return b, nil
 }
\ No newline at end of file
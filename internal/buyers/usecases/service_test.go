@@ -12,11 +12,11 @@ import (
 )
 
 func makeCreateParams() (string, string, string, string) {
-	return "valid_first_name", "valid_last_name", "valid_address", "valid_document_number"
+	return "valid_first_name", "valid_last_name", "valid_address", "52998224725"
 }
 
 func makeUpdateParams() (int, string, string, string, string) {
-	return 2, "updated_first_name", "updated_last_name", "updated_address", "updated_document_number"
+	return 2, "updated_first_name", "updated_last_name", "updated_address", "11222333000181"
 }
 
 func makeBuyer() domain.Buyer {
@@ -25,7 +25,7 @@ func makeBuyer() domain.Buyer {
 		FirstName:      "valid_first_name",
 		LastName:       "valid_last_name",
 		Address:        "valid_address",
-		DocumentNumber: "valid_document_number",
+		DocumentNumber: "529.982.247-25",
 	}
 }
 
@@ -35,7 +35,7 @@ func makeUpdateBuyer() domain.Buyer {
 		FirstName:      "updated_first_name",
 		LastName:       "updated_last_name",
 		Address:        "updated_address",
-		DocumentNumber: "updated_document_number",
+		DocumentNumber: "11.222.333/0001-81",
 	}
 }
 
@@ -45,11 +45,11 @@ func TestGetAll(t *testing.T) {
 
 	t.Run("find_all", func(t *testing.T) {
 		mockBuyerRepository.
-			On("GetAll").
+			On("GetAll", false).
 			Return(domain.Buyers{makeBuyer()}, nil).
 			Once()
 
-		ps, err := service.GetAll()
+		ps, err := service.GetAll(false)
 
 		assert.Equal(t, domain.Buyers{makeBuyer()}, ps)
 		assert.Nil(t, err)
@@ -83,9 +83,27 @@ func TestCreate(t *testing.T) {
 	mockBuyerRepository := mocks.NewBuyerRepository(t)
 	service := usecases.CreateBuyerService(mockBuyerRepository)
 
+	t.Run("create_invalid_document", func(t *testing.T) {
+		_, err := service.Create("valid_first_name", "valid_last_name", "valid_address", "52998224724")
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidDocument)
+	})
+
+	t.Run("create_document_in_use", func(t *testing.T) {
+		mockBuyerRepository.On("GetBuyerByDocument", "529.982.247-25").Return(makeBuyer(), nil).Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.ErrorIs(t, err, usecases.ErrDocumentInUse)
+	})
+
 	t.Run("create_ok", func(t *testing.T) {
 		mockBuyerRepository.
-			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+			On("GetBuyerByDocument", "529.982.247-25").
+			Return(domain.Buyer{}, &usecases.ErrNoElementFound{Err: errors.New("Error")}).
+			Once()
+		mockBuyerRepository.
+			On("Create", "valid_first_name", "valid_last_name", "valid_address", "529.982.247-25").
 			Return(makeBuyer(), nil).
 			Once()
 
@@ -100,7 +118,19 @@ func TestUpdate(t *testing.T) {
 	mockBuyerRepository := mocks.NewBuyerRepository(t)
 	service := usecases.CreateBuyerService(mockBuyerRepository)
 
+	t.Run("update_document_in_use", func(t *testing.T) {
+		mockBuyerRepository.On("GetBuyerByDocument", "11.222.333/0001-81").Return(makeBuyer(), nil).Once()
+
+		_, err := service.UpdateBuyerById(makeUpdateParams())
+
+		assert.ErrorIs(t, err, usecases.ErrDocumentInUse)
+	})
+
 	t.Run("update_ok", func(t *testing.T) {
+		mockBuyerRepository.
+			On("GetBuyerByDocument", "11.222.333/0001-81").
+			Return(makeUpdateBuyer(), nil).
+			Once()
 		mockBuyerRepository.
 			On("UpdateBuyerById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).
 			Return(makeUpdateBuyer(), nil).
@@ -119,18 +149,49 @@ func TestDelete(t *testing.T) {
 
 	t.Run("delete_non_existent", func(t *testing.T) {
 		mockBuyerRepository.
-			On("DeleteBuyerById", mock.AnythingOfType("int")).Return(errors.New("Error")).Once()
+			On("SoftDeleteBuyerById", mock.AnythingOfType("int")).Return(errors.New("Error")).Once()
 
-		err := service.DeleteBuyerById(1)
+		err := service.DeleteBuyerById(1, false)
 
 		assert.EqualError(t, err, "Error")
 	})
 
 	t.Run("delete_ok", func(t *testing.T) {
-		mockBuyerRepository.On("DeleteBuyerById", mock.AnythingOfType("int")).Return(nil)
+		mockBuyerRepository.On("SoftDeleteBuyerById", 1).Return(nil).Once()
 
-		p := service.DeleteBuyerById(1)
+		p := service.DeleteBuyerById(1, false)
 
 		assert.Nil(t, p)
 	})
+
+	t.Run("hard_delete_with_orders", func(t *testing.T) {
+		mockBuyerRepository.On("HasPurchaseOrders", 1).Return(true, nil).Once()
+
+		err := service.DeleteBuyerById(1, true)
+
+		assert.ErrorIs(t, err, usecases.ErrBuyerHasOrders)
+	})
+
+	t.Run("hard_delete_ok", func(t *testing.T) {
+		mockBuyerRepository.On("HasPurchaseOrders", 1).Return(false, nil).Once()
+		mockBuyerRepository.On("DeleteBuyerById", 1).Return(nil).Once()
+
+		err := service.DeleteBuyerById(1, true)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	mockBuyerRepository := mocks.NewBuyerRepository(t)
+	service := usecases.CreateBuyerService(mockBuyerRepository)
+
+	t.Run("restore_ok", func(t *testing.T) {
+		mockBuyerRepository.On("RestoreBuyerById", 1).Return(makeBuyer(), nil).Once()
+
+		b, err := service.RestoreBuyerById(1)
+
+		assert.Equal(t, makeBuyer(), b)
+		assert.Nil(t, err)
+	})
 }
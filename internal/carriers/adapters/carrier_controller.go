@@ -8,7 +8,9 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/carriers/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/carriers/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/web"
 )
 
 type CarrierController struct {
@@ -53,6 +55,13 @@ func (cc *CarrierController) CreateCarrier(ctx *gin.Context) {
 		return
 	}
 
+	if errors.Is(err, usecases.ErrInvalidCid) {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
 	if errors.Is(err, usecases.ErrInvalidLocalityId) {
 		ctx.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
@@ -68,23 +77,6 @@ func (cc *CarrierController) CreateCarrier(ctx *gin.Context) {
 func (cc *CarrierController) GetNumberOfCarriersPerLocality(ctx *gin.Context) {
 	stringIds := ctx.QueryArray("id")
 
-	if len(stringIds) == 0 {
-		report, err := cc.service.GetAllNumberOfCarriersPerLocality()
-
-		if err != nil {
-			ctx.JSON(http.StatusInternalServerError, gin.H{
-				"error": "internal server error",
-			})
-			return
-		}
-
-		ctx.JSON(http.StatusOK, gin.H{
-			"data": report,
-		})
-
-		return
-	}
-
 	ids := []int{}
 
 	for _, stringId := range stringIds {
@@ -109,7 +101,7 @@ func (cc *CarrierController) GetNumberOfCarriersPerLocality(ctx *gin.Context) {
 		return
 	}
 
-	if len(reports) == 0 {
+	if len(ids) > 0 && len(reports) == 0 {
 		ctx.JSON(http.StatusNotFound, gin.H{
 			"data": reports,
 		})
@@ -121,6 +113,210 @@ func (cc *CarrierController) GetNumberOfCarriersPerLocality(ctx *gin.Context) {
 	})
 }
 
+func (cc *CarrierController) GetAllCarriers(ctx *gin.Context) {
+	includeDeleted, err := web.QueryBool(ctx, "include_deleted")
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "include_deleted must be true or false",
+		})
+		return
+	}
+
+	carriers, err := cc.service.GetAll(includeDeleted)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": carriers,
+	})
+}
+
+func (cc *CarrierController) GetCarrierById(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	c, err := cc.service.GetById(id)
+
+	if err == nil {
+		ctx.JSON(http.StatusOK, gin.H{
+			"data": c,
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrNoElementFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": "internal server error",
+	})
+}
+
+func (cc *CarrierController) UpdateCarrier(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req carrierUpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c, err := cc.service.Update(id, domain.CarrierUpdate{
+		Cid:         req.Cid,
+		CompanyName: req.CompanyName,
+		Address:     req.Address,
+		Telephone:   req.Telephone,
+		LocalityId:  req.LocalityId,
+	})
+
+	if err == nil {
+		ctx.JSON(http.StatusOK, gin.H{
+			"data": c,
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrNoElementFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrCidInUse) {
+		ctx.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrInvalidCid) {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrInvalidLocalityId) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": "internal server error",
+	})
+}
+
+func (cc *CarrierController) DeleteCarrier(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	hard, err := web.QueryBool(ctx, "hard")
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "hard must be true or false",
+		})
+		return
+	}
+
+	err = cc.service.Delete(id, hard)
+
+	if err == nil {
+		ctx.JSON(http.StatusNoContent, gin.H{})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrNoElementFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrCarrierHasOrders) {
+		ctx.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": "internal server error",
+	})
+}
+
+func (cc *CarrierController) RestoreCarrier(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	c, err := cc.service.Restore(id)
+
+	if err == nil {
+		ctx.JSON(http.StatusOK, gin.H{
+			"data": c,
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrNoElementFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": "internal server error",
+	})
+}
+
 type carryCreateRequest struct {
 	Cid         string `json:"cid" binding:"required"`
 	CompanyName string `json:"company_name" binding:"required"`
@@ -146,7 +342,7 @@ func (ccr *carryCreateRequest) Validate() error {
 		return errors.New("telephone can't be empty")
 	}
 
-	if match, err := regexp.MatchString("^\\([1-9]{2}\\)\\s[0-9]{4,5}-[0-9]{4}$", ccr.Telephone); err != nil || !match {
+	if !validTelephone(ccr.Telephone) {
 		return errors.New("telephone must respect the pattern (xx) xxxxx-xxxx or (xx) xxxx-xxxx")
 	}
 
@@ -156,3 +352,42 @@ func (ccr *carryCreateRequest) Validate() error {
 
 	return nil
 }
+
+type carrierUpdateRequest struct {
+	Cid         *string `json:"cid"`
+	CompanyName *string `json:"company_name"`
+	Address     *string `json:"address"`
+	Telephone   *string `json:"telephone"`
+	LocalityId  *int    `json:"locality_id"`
+}
+
+// Validate checks only the fields sent in the request.
+func (cur *carrierUpdateRequest) Validate() error {
+	if cur.Cid != nil && strings.TrimSpace(*cur.Cid) == "" {
+		return errors.New("cid can't be empty")
+	}
+
+	if cur.CompanyName != nil && strings.TrimSpace(*cur.CompanyName) == "" {
+		return errors.New("company_name can't be empty")
+	}
+
+	if cur.Address != nil && strings.TrimSpace(*cur.Address) == "" {
+		return errors.New("address can't be empty")
+	}
+
+	if cur.Telephone != nil && !validTelephone(*cur.Telephone) {
+		return errors.New("telephone must respect the pattern (xx) xxxxx-xxxx or (xx) xxxx-xxxx")
+	}
+
+	if cur.LocalityId != nil && *cur.LocalityId <= 0 {
+		return errors.New("invalid locality_id")
+	}
+
+	return nil
+}
+
+func validTelephone(telephone string) bool {
+	match, err := regexp.MatchString("^\\([1-9]{2}\\)\\s[0-9]{4,5}-[0-9]{4}$", telephone)
+
+	return err == nil && match
+}
@@ -187,6 +187,17 @@ func TestCreateCarrier(t *testing.T) {
 		assert.Equal(t, "{\"error\":\"this cid is in use\"}", rr.Body.String())
 	})
 
+	t.Run("Should return an error and 422 status if cid is not a valid CPF or CNPJ", func(t *testing.T) {
+		r, mockCarrierService := makeSut()
+		mockCarrierService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(domain.Carrier{}, usecases.ErrInvalidCid).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/carriers", makeValidCreateBody())
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "{\"error\":\"cid must be a valid CPF or CNPJ\"}", rr.Body.String())
+	})
+
 	t.Run("Should return an error and 400 status if locality id did not exists", func(t *testing.T) {
 		r, mockCarrierService := makeSut()
 		mockCarrierService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(domain.Carrier{}, usecases.ErrInvalidLocalityId).Once()
@@ -257,41 +268,28 @@ func Test_GetNumberOfCarriersPerLocality(t *testing.T) {
 			},
 		}
 	}
-	t.Run("Should call GetAllNumberOfCarriersPerLocality from Carrier Service if no id is provided", func(t *testing.T) {
-		server, mockCarrierService := makeSut()
-		mockCarrierService.On("GetAllNumberOfCarriersPerLocality").Return(makeReportsNumberOfCarriersPerLocality, nil)
-
-		rr := httptest.NewRecorder()
-		req, _ := http.NewRequest(http.MethodGet, "/carriers", nil)
-		server.ServeHTTP(rr, req)
-
-		mockCarrierService.AssertCalled(t, "GetAllNumberOfCarriersPerLocality")
-		mockCarrierService.AssertNumberOfCalls(t, "GetAllNumberOfCarriersPerLocality", 1)
-	})
-
-	t.Run("Should return 500 status and error if GetAllNumberOfCarriersPerLocality from Carrier Service returns an error", func(t *testing.T) {
+	t.Run("Should call GetNumberOfCarriersPerLocalities with an empty slice if no id is provided", func(t *testing.T) {
 		server, mockCarrierService := makeSut()
-		mockCarrierService.On("GetAllNumberOfCarriersPerLocality").Return(domain.ReportsNumberOfCarriersPerLocality{}, errors.New("any_error"))
+		mockCarrierService.On("GetNumberOfCarriersPerLocalities", []int{}).Return(makeReportsNumberOfCarriersPerLocality(), nil).Once()
 
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/carriers", nil)
 		server.ServeHTTP(rr, req)
 
-		assert.Equal(t, http.StatusInternalServerError, rr.Code)
-		assert.Equal(t, "{\"error\":\"internal server error\"}", rr.Body.String())
-
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, makeExpectedReportBodyResponse(), rr.Body.String())
 	})
 
-	t.Run("Should return 200 status and reports on success", func(t *testing.T) {
+	t.Run("Should return 200 status and an empty report if there is no locality and no id is provided", func(t *testing.T) {
 		server, mockCarrierService := makeSut()
-		mockCarrierService.On("GetAllNumberOfCarriersPerLocality").Return(makeReportsNumberOfCarriersPerLocality, nil)
+		mockCarrierService.On("GetNumberOfCarriersPerLocalities", []int{}).Return(domain.ReportsNumberOfCarriersPerLocality{}, nil).Once()
 
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/carriers", nil)
 		server.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, makeExpectedReportBodyResponse(), rr.Body.String())
+		assert.Equal(t, "{\"data\":[]}", rr.Body.String())
 	})
 
 	t.Run("Should return 400 if invalid id is provided", func(t *testing.T) {
@@ -353,3 +351,277 @@ func Test_GetNumberOfCarriersPerLocality(t *testing.T) {
 		assert.Equal(t, makeExpectedReportBodyResponse(), rr.Body.String())
 	})
 }
+
+func TestGetAllCarriers(t *testing.T) {
+	makeSut := func() (*gin.Engine, *mocks.CarrierService) {
+		gin.SetMode(gin.TestMode)
+		mockCarrierService := mocks.NewCarrierService(t)
+		sut := adapters.CreateCarryController(mockCarrierService)
+		server := gin.Default()
+		server.GET("/carriers", sut.GetAllCarriers)
+		return server, mockCarrierService
+	}
+
+	t.Run("Should return 400 if include_deleted is invalid", func(t *testing.T) {
+		server, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers?include_deleted=any", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should return 500 if GetAll from Carrier Service returns an error", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("GetAll", false).Return(nil, errors.New("any_error")).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("Should return 200 and the carriers on success", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("GetAll", true).Return(domain.Carriers{{Id: 1, Cid: "valid_cid"}}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers?include_deleted=true", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"cid\":\"valid_cid\"")
+	})
+}
+
+func TestGetCarrierById(t *testing.T) {
+	makeSut := func() (*gin.Engine, *mocks.CarrierService) {
+		gin.SetMode(gin.TestMode)
+		mockCarrierService := mocks.NewCarrierService(t)
+		sut := adapters.CreateCarryController(mockCarrierService)
+		server := gin.Default()
+		server.GET("/carriers/:id", sut.GetCarrierById)
+		return server, mockCarrierService
+	}
+
+	t.Run("Should return 400 if invalid id is provided", func(t *testing.T) {
+		server, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers/any", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should return 404 if carrier is not found", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("GetById", 1).Return(domain.Carrier{}, usecases.ErrNoElementFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers/1", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return 200 and the carrier on success", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("GetById", 1).Return(domain.Carrier{Id: 1, Cid: "valid_cid"}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers/1", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"cid\":\"valid_cid\"")
+	})
+}
+
+func TestUpdateCarrier(t *testing.T) {
+	makeSut := func() (*gin.Engine, *mocks.CarrierService) {
+		gin.SetMode(gin.TestMode)
+		mockCarrierService := mocks.NewCarrierService(t)
+		sut := adapters.CreateCarryController(mockCarrierService)
+		server := gin.Default()
+		server.PATCH("/carriers/:id", sut.UpdateCarrier)
+		return server, mockCarrierService
+	}
+
+	makeValidBody := func() *bytes.Buffer {
+		return bytes.NewBuffer([]byte(`
+			{
+				"cid": "11.222.333/0001-81",
+				"company_name": "valid_name",
+				"address": "valid_address",
+				"telephone": "(11) 91111-1111",
+				"locality_id": 1
+			}
+		`))
+	}
+
+	makeValidUpdate := func() domain.CarrierUpdate {
+		cid, companyName, address, telephone, localityId := "11.222.333/0001-81", "valid_name", "valid_address", "(11) 91111-1111", 1
+		return domain.CarrierUpdate{Cid: &cid, CompanyName: &companyName, Address: &address, Telephone: &telephone, LocalityId: &localityId}
+	}
+
+	t.Run("Should return 400 if invalid id is provided", func(t *testing.T) {
+		server, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/any", makeValidBody())
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should return 422 if body request contains unprocessable data", func(t *testing.T) {
+		server, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/1", bytes.NewBuffer([]byte(`{"locality_id": "any"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Should return 400 if a provided field is invalid", func(t *testing.T) {
+		server, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/1", bytes.NewBuffer([]byte(`{"telephone": "11 1111"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should return 404 if carrier is not found", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Update", 1, makeValidUpdate()).Return(domain.Carrier{}, usecases.ErrNoElementFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/1", makeValidBody())
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return 409 if cid is in use by another carrier", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Update", 1, makeValidUpdate()).Return(domain.Carrier{}, usecases.ErrCidInUse).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/1", makeValidBody())
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Should return 400 if locality id did not exists", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Update", 1, makeValidUpdate()).Return(domain.Carrier{}, usecases.ErrInvalidLocalityId).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/1", makeValidBody())
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"this locality_id is invalid\"}", rr.Body.String())
+	})
+
+	t.Run("Should send only the provided fields to the service", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		telephone := "(11) 93333-3333"
+		mockCarrierService.On("Update", 1, domain.CarrierUpdate{Telephone: &telephone}).Return(domain.Carrier{Id: 1, Telephone: telephone}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/1", bytes.NewBuffer([]byte(`{"telephone": "(11) 93333-3333"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"telephone\":\"(11) 93333-3333\"")
+	})
+
+	t.Run("Should return 200 and the updated carrier on success", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Update", 1, makeValidUpdate()).Return(domain.Carrier{Id: 1, Cid: "11.222.333/0001-81"}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/carriers/1", makeValidBody())
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"cid\":\"11.222.333/0001-81\"")
+	})
+}
+
+func TestDeleteCarrier(t *testing.T) {
+	makeSut := func() (*gin.Engine, *mocks.CarrierService) {
+		gin.SetMode(gin.TestMode)
+		mockCarrierService := mocks.NewCarrierService(t)
+		sut := adapters.CreateCarryController(mockCarrierService)
+		server := gin.Default()
+		server.DELETE("/carriers/:id", sut.DeleteCarrier)
+		return server, mockCarrierService
+	}
+
+	t.Run("Should return 400 if invalid id is provided", func(t *testing.T) {
+		server, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/carriers/any", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should soft delete by default and return 204", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Delete", 1, false).Return(nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/carriers/1", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("Should return 404 if carrier is not found", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Delete", 1, false).Return(usecases.ErrNoElementFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/carriers/1", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return 409 on a hard delete of a carrier with purchase orders", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Delete", 1, true).Return(usecases.ErrCarrierHasOrders).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/carriers/1?hard=true", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Equal(t, "{\"error\":\"carrier has purchase orders and can't be permanently deleted\"}", rr.Body.String())
+	})
+}
+
+func TestRestoreCarrier(t *testing.T) {
+	makeSut := func() (*gin.Engine, *mocks.CarrierService) {
+		gin.SetMode(gin.TestMode)
+		mockCarrierService := mocks.NewCarrierService(t)
+		sut := adapters.CreateCarryController(mockCarrierService)
+		server := gin.Default()
+		server.POST("/carriers/:id/restore", sut.RestoreCarrier)
+		return server, mockCarrierService
+	}
+
+	t.Run("Should return 404 if there is no deleted carrier", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Restore", 1).Return(domain.Carrier{}, usecases.ErrNoElementFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/carriers/1/restore", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return 200 and the restored carrier on success", func(t *testing.T) {
+		server, mockCarrierService := makeSut()
+		mockCarrierService.On("Restore", 1).Return(domain.Carrier{Id: 1, Cid: "valid_cid"}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/carriers/1/restore", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"cid\":\"valid_cid\"")
+	})
+}
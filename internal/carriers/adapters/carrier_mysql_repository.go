@@ -3,9 +3,12 @@ package adapters
 import (
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/carriers/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/carriers/usecases"
+	localities "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/document"
 )
 
 type carrierMySQLRepositoryAdapter struct {
@@ -53,24 +56,58 @@ func (r *carrierMySQLRepositoryAdapter) Create(cid string, companyName string, a
 	}, nil
 }
 
-func (r *carrierMySQLRepositoryAdapter) GetNumberOfCarriersPerLocality(localityId int) (int, error) {
-	const query = `SELECT COUNT(*) FROM carrier WHERE locality_id=?;`
+// GetReportNumberOfCarriersPerLocality counts the carriers of every locality,
+// or only of the given ones, with the same totals as the localities report.
+func (r *carrierMySQLRepositoryAdapter) GetReportNumberOfCarriersPerLocality(localitiesIds []int) (domain.ReportsNumberOfCarriersPerLocality, error) {
+	query := `SELECT l.id, l.name, COALESCE(c.total, 0) FROM locality l LEFT JOIN (` + localities.ReportSources["carriers"] + `) c ON c.locality_id = l.id`
 
-	quantity := 0
-	err := r.db.QueryRow(query, localityId).Scan(&quantity)
+	args := []interface{}{}
+
+	if len(localitiesIds) > 0 {
+		placeholders := make([]string, len(localitiesIds))
+
+		for i, id := range localitiesIds {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+
+		query += ` WHERE l.id IN (` + strings.Join(placeholders, ", ") + `)`
+	}
+
+	query += ` ORDER BY l.id`
+
+	rows, err := r.db.Query(query, args...)
 
 	if err != nil {
-		return 0, err
+		return domain.ReportsNumberOfCarriersPerLocality{}, err
+	}
+
+	defer rows.Close()
+
+	reports := domain.ReportsNumberOfCarriersPerLocality{}
+
+	for rows.Next() {
+		report := domain.ReportNumberOfCarriersPerLocality{}
+
+		if err := rows.Scan(&report.LocalityId, &report.LocalityName, &report.CarriersCount); err != nil {
+			return domain.ReportsNumberOfCarriersPerLocality{}, err
+		}
+
+		reports = append(reports, report)
 	}
 
-	return quantity, nil
+	if err = rows.Err(); err != nil {
+		return domain.ReportsNumberOfCarriersPerLocality{}, err
+	}
+
+	return reports, nil
 }
 
 func (r *carrierMySQLRepositoryAdapter) GetByCid(cid string) (domain.Carrier, error) {
-	const query = `SELECT id, cid, company_name, address, telephone, locality_id FROM carrier WHERE cid=?`
+	query := `SELECT id, cid, company_name, address, telephone, locality_id FROM carrier WHERE ` + document.KeySQL("cid") + `=?`
 
 	c := domain.Carrier{}
-	err := r.db.QueryRow(query, cid).Scan(&c.Id, &c.Cid, &c.CompanyName, &c.Address, &c.Telephone, &c.LocalityId)
+	err := r.db.QueryRow(query, document.Key(cid)).Scan(&c.Id, &c.Cid, &c.CompanyName, &c.Address, &c.Telephone, &c.LocalityId)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Carrier{}, usecases.ErrNoElementFound
@@ -82,3 +119,135 @@ func (r *carrierMySQLRepositoryAdapter) GetByCid(cid string) (domain.Carrier, er
 
 	return c, nil
 }
+
+func (r *carrierMySQLRepositoryAdapter) GetAll(includeDeleted bool) (domain.Carriers, error) {
+	query := `SELECT id, cid, company_name, address, telephone, locality_id, deleted_at FROM carrier`
+
+	if !includeDeleted {
+		query += ` WHERE deleted_at IS NULL`
+	}
+
+	rows, err := r.db.Query(query + ` ORDER BY id`)
+
+	if err != nil {
+		return domain.Carriers{}, err
+	}
+
+	defer rows.Close()
+
+	cs := domain.Carriers{}
+
+	for rows.Next() {
+		c := domain.Carrier{}
+
+		if err := rows.Scan(&c.Id, &c.Cid, &c.CompanyName, &c.Address, &c.Telephone, &c.LocalityId, &c.DeletedAt); err != nil {
+			return domain.Carriers{}, err
+		}
+
+		cs = append(cs, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Carriers{}, err
+	}
+
+	return cs, nil
+}
+
+func (r *carrierMySQLRepositoryAdapter) GetById(id int) (domain.Carrier, error) {
+	const query = `SELECT id, cid, company_name, address, telephone, locality_id FROM carrier WHERE id=? AND deleted_at IS NULL`
+
+	c := domain.Carrier{}
+	err := r.db.QueryRow(query, id).Scan(&c.Id, &c.Cid, &c.CompanyName, &c.Address, &c.Telephone, &c.LocalityId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Carrier{}, usecases.ErrNoElementFound
+	}
+
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+
+	return c, nil
+}
+
+func (r *carrierMySQLRepositoryAdapter) Update(id int, cid string, companyName string, address string, telephone string, localityId int) (domain.Carrier, error) {
+	const query = `UPDATE carrier SET cid=?, company_name=?, address=?, telephone=?, locality_id=? WHERE id=? AND deleted_at IS NULL`
+
+	res, err := r.db.Exec(query, cid, companyName, address, telephone, localityId, id)
+
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+
+	if rows == 0 {
+		if c, _ := r.GetById(id); c.Id == 0 {
+			return domain.Carrier{}, usecases.ErrNoElementFound
+		}
+	}
+
+	return domain.Carrier{
+		Id:          id,
+		Cid:         cid,
+		CompanyName: companyName,
+		Address:     address,
+		Telephone:   telephone,
+		LocalityId:  localityId,
+	}, nil
+}
+
+func (r *carrierMySQLRepositoryAdapter) Delete(id int) error {
+	const query = `DELETE FROM carrier WHERE id=?`
+
+	return r.execOnCarrier(query, id)
+}
+
+func (r *carrierMySQLRepositoryAdapter) SoftDelete(id int) error {
+	const query = `UPDATE carrier SET deleted_at=NOW() WHERE id=? AND deleted_at IS NULL`
+
+	return r.execOnCarrier(query, id)
+}
+
+func (r *carrierMySQLRepositoryAdapter) Restore(id int) error {
+	const query = `UPDATE carrier SET deleted_at=NULL WHERE id=? AND deleted_at IS NOT NULL`
+
+	return r.execOnCarrier(query, id)
+}
+
+func (r *carrierMySQLRepositoryAdapter) HasPurchaseOrders(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM purchase_order WHERE carrier_id=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *carrierMySQLRepositoryAdapter) execOnCarrier(query string, id int) error {
+	res, err := r.db.Exec(query, id)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return usecases.ErrNoElementFound
+	}
+
+	return nil
+}
@@ -125,7 +125,7 @@ func TestCreate(t *testing.T) {
 	})
 }
 
-func TestGetNumberOfCarriersPerLocality(t *testing.T) {
+func TestGetReportNumberOfCarriersPerLocality(t *testing.T) {
 	makeSut := func() (usecases.CarrierRepository, sqlmock.Sqlmock) {
 		db, mock, err := sqlmock.New()
 		if err != nil {
@@ -137,43 +137,52 @@ func TestGetNumberOfCarriersPerLocality(t *testing.T) {
 		return sut, mock
 	}
 
-	t.Run("Should execute correct query in database", func(t *testing.T) {
+	makeRows := func() *sqlmock.Rows {
+		rows := sqlmock.NewRows([]string{"id", "name", "total"})
+		rows.AddRow(1, "valid_name", 2)
+		rows.AddRow(2, "other_name", 0)
+
+		return rows
+	}
+
+	t.Run("Should count every locality in a single query if no id is given", func(t *testing.T) {
 		sut, mock := makeSut()
-		rows := sqlmock.NewRows([]string{"count"})
-		rows.AddRow(1)
-		mock.ExpectQuery("SELECT COUNT(.+) FROM carrier").WithArgs(1).WillReturnRows(rows)
+		mock.ExpectQuery(`SELECT l.id, l.name, COALESCE\(c.total, 0\) FROM locality l LEFT JOIN \(SELECT locality_id, COUNT\(\*\) AS total FROM carrier WHERE deleted_at IS NULL GROUP BY locality_id\) c ON c.locality_id = l.id ORDER BY l.id`).
+			WithArgs().WillReturnRows(makeRows())
 
-		sut.GetNumberOfCarriersPerLocality(1)
+		result, err := sut.GetReportNumberOfCarriersPerLocality([]int{})
 
-		err := mock.ExpectationsWereMet()
+		expected := domain.ReportsNumberOfCarriersPerLocality{
+			{LocalityId: 1, LocalityName: "valid_name", CarriersCount: 2},
+			{LocalityId: 2, LocalityName: "other_name", CarriersCount: 0},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
 	})
 
-	t.Run("Should return error if query fails", func(t *testing.T) {
+	t.Run("Should filter the requested localities", func(t *testing.T) {
 		sut, mock := makeSut()
+		mock.ExpectQuery(`WHERE l.id IN \(\?, \?\) ORDER BY l.id`).WithArgs(1, 2).WillReturnRows(makeRows())
 
-		mock.ExpectQuery("SELECT COUNT(.+) FROM carrier").WithArgs(1).WillReturnError(errors.New("query_error"))
+		_, err := sut.GetReportNumberOfCarriersPerLocality([]int{1, 2})
 
-		result, err := sut.GetNumberOfCarriersPerLocality(1)
-
-		assert.Equal(t, result, 0)
-		assert.EqualError(t, err, "query_error")
+		assert.Nil(t, err)
 
 		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
 	})
 
-	t.Run("Should return an integer on success", func(t *testing.T) {
+	t.Run("Should return error if query fails", func(t *testing.T) {
 		sut, mock := makeSut()
+		mock.ExpectQuery("SELECT l.id, l.name").WithArgs(1).WillReturnError(errors.New("query_error"))
 
-		rows := sqlmock.NewRows([]string{"count"})
-		rows.AddRow(1)
-		mock.ExpectQuery("SELECT COUNT(.+) FROM carrier").WithArgs(1).WillReturnRows(rows)
-
-		result, err := sut.GetNumberOfCarriersPerLocality(1)
+		result, err := sut.GetReportNumberOfCarriersPerLocality([]int{1})
 
-		assert.Equal(t, result, 1)
-		assert.Nil(t, err)
+		assert.Equal(t, domain.ReportsNumberOfCarriersPerLocality{}, result)
+		assert.EqualError(t, err, "query_error")
 
 		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
@@ -196,9 +205,9 @@ func TestGetByCid(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id"})
 		rows.AddRow(1, "valid_cid", "valid_name", "valid_address", "valid_phone", 1)
-		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("valid_cid").WillReturnRows(rows)
+		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("11222333000181").WillReturnRows(rows)
 
-		sut.GetByCid("valid_cid")
+		sut.GetByCid("11.222.333/0001-81")
 
 		err := mock.ExpectationsWereMet()
 		assert.Nil(t, err)
@@ -207,9 +216,9 @@ func TestGetByCid(t *testing.T) {
 	t.Run("Should return ErrNoElementFound if can't find element in database", func(t *testing.T) {
 		sut, mock := makeSut()
 
-		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("valid_cid").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("11222333000181").WillReturnError(sql.ErrNoRows)
 
-		result, err := sut.GetByCid("valid_cid")
+		result, err := sut.GetByCid("11.222.333/0001-81")
 
 		assert.Equal(t, result, domain.Carrier{})
 		assert.Equal(t, err, usecases.ErrNoElementFound)
@@ -221,9 +230,9 @@ func TestGetByCid(t *testing.T) {
 	t.Run("Should return error if query fails", func(t *testing.T) {
 		sut, mock := makeSut()
 
-		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("valid_cid").WillReturnError(errors.New("query_error"))
+		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("11222333000181").WillReturnError(errors.New("query_error"))
 
-		result, err := sut.GetByCid("valid_cid")
+		result, err := sut.GetByCid("11.222.333/0001-81")
 
 		assert.Equal(t, result, domain.Carrier{})
 		assert.EqualError(t, err, "query_error")
@@ -237,9 +246,9 @@ func TestGetByCid(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id"})
 		rows.AddRow(1, "valid_cid", "valid_name", "valid_address", "valid_phone", 1)
-		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("valid_cid").WillReturnRows(rows)
+		mock.ExpectQuery("SELECT id, cid, company_name, address, telephone, locality_id FROM carrier").WithArgs("11222333000181").WillReturnRows(rows)
 
-		result, err := sut.GetByCid("valid_cid")
+		result, err := sut.GetByCid("11.222.333/0001-81")
 
 		expected := domain.Carrier{
 			Id:          1,
@@ -256,3 +265,145 @@ func TestGetByCid(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func Test_GetAll(t *testing.T) {
+	makeSut := func() (usecases.CarrierRepository, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+
+		sut := adapters.CreateCarrierMySQLRepository(db)
+
+		return sut, mock
+	}
+
+	t.Run("Should list only carriers not deleted by default", func(t *testing.T) {
+		sut, mock := makeSut()
+		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id", "deleted_at"})
+		rows.AddRow(1, "valid_cid", "valid_name", "valid_address", "valid_phone", 1, nil)
+		mock.ExpectQuery("SELECT (.+) FROM carrier WHERE deleted_at IS NULL ORDER BY id").WillReturnRows(rows)
+
+		result, err := sut.GetAll(false)
+
+		assert.Equal(t, domain.Carriers{{Id: 1, Cid: "valid_cid", CompanyName: "valid_name", Address: "valid_address", Telephone: "valid_phone", LocalityId: 1}}, result)
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return error if query fails", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectQuery("SELECT (.+) FROM carrier ORDER BY id").WillReturnError(errors.New("any_error"))
+
+		result, err := sut.GetAll(true)
+
+		assert.Equal(t, domain.Carriers{}, result)
+		assert.EqualError(t, err, "any_error")
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	makeSut := func() (usecases.CarrierRepository, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+
+		sut := adapters.CreateCarrierMySQLRepository(db)
+
+		return sut, mock
+	}
+
+	t.Run("Should return the updated carrier on success", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectExec("UPDATE carrier SET cid=(.+) WHERE id=(.+) AND deleted_at IS NULL").
+			WithArgs("valid_cid", "valid_name", "valid_address", "valid_phone", 2, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := sut.Update(1, "valid_cid", "valid_name", "valid_address", "valid_phone", 2)
+
+		assert.Equal(t, domain.Carrier{Id: 1, Cid: "valid_cid", CompanyName: "valid_name", Address: "valid_address", Telephone: "valid_phone", LocalityId: 2}, result)
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return ErrNoElementFound if carrier does not exist", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectExec("UPDATE carrier SET cid=").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT (.+) FROM carrier WHERE id=").WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.Update(1, "valid_cid", "valid_name", "valid_address", "valid_phone", 2)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSoftDelete(t *testing.T) {
+	makeSut := func() (usecases.CarrierRepository, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+
+		sut := adapters.CreateCarrierMySQLRepository(db)
+
+		return sut, mock
+	}
+
+	t.Run("Should set deleted_at on the carrier", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectExec("UPDATE carrier SET deleted_at=NOW()").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := sut.SoftDelete(1)
+
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return ErrNoElementFound if no carrier was deleted", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectExec("UPDATE carrier SET deleted_at=NOW()").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := sut.SoftDelete(1)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("Should hard delete a carrier that was already soft deleted", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+
+		sut := adapters.CreateCarrierMySQLRepository(db)
+		mock.ExpectExec("UPDATE carrier SET deleted_at=NOW() WHERE id=? AND deleted_at IS NULL").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM carrier WHERE id=?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.Nil(t, sut.SoftDelete(1))
+		assert.Nil(t, sut.Delete(1))
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestHasPurchaseOrders(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	sut := adapters.CreateCarrierMySQLRepository(db)
+
+	rows := sqlmock.NewRows([]string{"count"})
+	rows.AddRow(2)
+	mock.ExpectQuery("SELECT COUNT(.+) FROM purchase_order WHERE carrier_id").WithArgs(1).WillReturnRows(rows)
+
+	result, err := sut.HasPurchaseOrders(1)
+
+	assert.True(t, result)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -81,72 +81,3 @@ func TestGetById(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
-
-func TestGetAll(t *testing.T) {
-	makeGetAllReturn := func() domain.Localities {
-		return domain.Localities{
-			domain.Locality{
-				Id:         1,
-				Name:       "valid_name",
-				ProvinceId: 1,
-			},
-			domain.Locality{
-				Id:         2,
-				Name:       "valid_name",
-				ProvinceId: 2,
-			},
-		}
-	}
-
-	makeSut := func() (usecases.LocalityRepository, sqlmock.Sqlmock) {
-		db, mock, err := sqlmock.New()
-		if err != nil {
-			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-		}
-		sut := adapters.CreateLocalityMySQLRepository(db)
-
-		return sut, mock
-	}
-	t.Run("Should execute correct query in database", func(t *testing.T) {
-		sut, mock := makeSut()
-		rows := sqlmock.NewRows([]string{"id", "name", "province_id"})
-		rows.AddRow(1, "valid_name", 1)
-		rows.AddRow(2, "valid_name", 2)
-		rows.AddRow(3, "valid_name", 3)
-		mock.ExpectQuery("SELECT id, name, province_id FROM locality").WithArgs().WillReturnRows(rows)
-
-		sut.GetAll()
-
-		err := mock.ExpectationsWereMet()
-		assert.Nil(t, err)
-	})
-
-	t.Run("Should return error if query fails", func(t *testing.T) {
-		sut, mock := makeSut()
-		mock.ExpectQuery("SELECT id, name, province_id FROM locality").WithArgs().WillReturnError(errors.New("query_error"))
-		result, err := sut.GetAll()
-
-		assert.Equal(t, domain.Localities{}, result)
-		assert.EqualError(t, err, "query_error")
-
-		err = mock.ExpectationsWereMet()
-		assert.Nil(t, err)
-	})
-
-	t.Run("Should return locality slice on success", func(t *testing.T) {
-		sut, mock := makeSut()
-		rows := sqlmock.NewRows([]string{"id", "name", "province_id"})
-		rows.AddRow(1, "valid_name", 1)
-		rows.AddRow(2, "valid_name", 2)
-		mock.ExpectQuery("SELECT id, name, province_id FROM locality").WithArgs().WillReturnRows(rows)
-
-		result, err := sut.GetAll()
-
-		expected := makeGetAllReturn()
-		assert.Equal(t, expected, result)
-		assert.Nil(t, err)
-
-		err = mock.ExpectationsWereMet()
-		assert.Nil(t, err)
-	})
-}
@@ -1,12 +1,24 @@
 package domain
 
 type Carrier struct {
-	Id          int    `json:"id"`
-	Cid         string `json:"cid"`
-	CompanyName string `json:"company_name"`
-	Address     string `json:"address"`
-	Telephone   string `json:"telephone"`
-	LocalityId  int    `json:"locality_id"`
+	Id          int     `json:"id"`
+	Cid         string  `json:"cid"`
+	CompanyName string  `json:"company_name"`
+	Address     string  `json:"address"`
+	Telephone   string  `json:"telephone"`
+	LocalityId  int     `json:"locality_id"`
+	DeletedAt   *string `json:"deleted_at,omitempty"`
+}
+
+type Carriers []Carrier
+
+// CarrierUpdate holds the fields of a partial update; nil fields keep their current value.
+type CarrierUpdate struct {
+	Cid         *string
+	CompanyName *string
+	Address     *string
+	Telephone   *string
+	LocalityId  *int
 }
 
 type ReportNumberOfCarriersPerLocality struct {
@@ -6,6 +6,13 @@ import (
 
 type CarrierRepository interface {
 	Create(cid string, companyName string, address string, telephone string, localityId int) (domain.Carrier, error)
-	GetNumberOfCarriersPerLocality(localityId int) (int, error)
+	GetReportNumberOfCarriersPerLocality(localitiesIds []int) (domain.ReportsNumberOfCarriersPerLocality, error)
 	GetByCid(cid string) (domain.Carrier, error)
+	GetAll(includeDeleted bool) (domain.Carriers, error)
+	GetById(id int) (domain.Carrier, error)
+	Update(id int, cid string, companyName string, address string, telephone string, localityId int) (domain.Carrier, error)
+	Delete(id int) error
+	SoftDelete(id int) error
+	Restore(id int) error
+	HasPurchaseOrders(id int) (bool, error)
 }
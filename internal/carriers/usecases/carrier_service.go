@@ -4,12 +4,17 @@ import (
 	"errors"
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/carriers/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/document"
 )
 
 type CarrierService interface {
 	Create(cid string, companyName string, address string, telephone string, localityId int) (domain.Carrier, error)
 	GetNumberOfCarriersPerLocalities(localitiesIds []int) (domain.ReportsNumberOfCarriersPerLocality, error)
-	GetAllNumberOfCarriersPerLocality() (domain.ReportsNumberOfCarriersPerLocality, error)
+	GetAll(includeDeleted bool) (domain.Carriers, error)
+	GetById(id int) (domain.Carrier, error)
+	Update(id int, update domain.CarrierUpdate) (domain.Carrier, error)
+	Delete(id int, hard bool) error
+	Restore(id int) (domain.Carrier, error)
 }
 
 type carrierService struct {
@@ -25,6 +30,12 @@ func CreateCarrierService(cr CarrierRepository, lr LocalityRepository) CarrierSe
 }
 
 func (s *carrierService) Create(cid string, companyName string, address string, telephone string, localityId int) (domain.Carrier, error) {
+	cid, _, err := document.Normalize(cid)
+
+	if err != nil {
+		return domain.Carrier{}, ErrInvalidCid
+	}
+
 	c, err := s.carrierRepository.GetByCid(cid)
 
 	if c.Id != 0 {
@@ -54,62 +65,112 @@ func (s *carrierService) Create(cid string, companyName string, address string,
 	return carrier, nil
 }
 
+// GetNumberOfCarriersPerLocalities reports the given localities, or every
+// locality if no id is given; unknown ids are left out of the report.
 func (s *carrierService) GetNumberOfCarriersPerLocalities(localitiesIds []int) (domain.ReportsNumberOfCarriersPerLocality, error) {
-	reports := domain.ReportsNumberOfCarriersPerLocality{}
+	return s.carrierRepository.GetReportNumberOfCarriersPerLocality(localitiesIds)
+}
 
-	for _, localityId := range localitiesIds {
-		locality, err := s.localityRepository.GetById(localityId)
+func (s *carrierService) GetAll(includeDeleted bool) (domain.Carriers, error) {
+	carriers, err := s.carrierRepository.GetAll(includeDeleted)
 
-		if errors.Is(err, ErrNoElementFound) {
-			continue
-		}
+	if err != nil {
+		return domain.Carriers{}, err
+	}
+
+	return carriers, nil
+}
+
+func (s *carrierService) GetById(id int) (domain.Carrier, error) {
+	carrier, err := s.carrierRepository.GetById(id)
+
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+
+	return carrier, nil
+}
+
+func (s *carrierService) Update(id int, update domain.CarrierUpdate) (domain.Carrier, error) {
+	carrier, err := s.carrierRepository.GetById(id)
+
+	if err != nil {
+		return domain.Carrier{}, err
+	}
+
+	// a stored cid from before the document validation is kept unless the request changes it
+	if update.Cid != nil && document.Key(*update.Cid) != document.Key(carrier.Cid) {
+		cid, _, err := document.Normalize(*update.Cid)
 
 		if err != nil {
-			return domain.ReportsNumberOfCarriersPerLocality{}, err
+			return domain.Carrier{}, ErrInvalidCid
 		}
 
-		carriersPerLocality, err := s.carrierRepository.GetNumberOfCarriersPerLocality(localityId)
+		c, err := s.carrierRepository.GetByCid(cid)
 
-		if err != nil {
-			return domain.ReportsNumberOfCarriersPerLocality{}, err
+		if c.Id != 0 && c.Id != id {
+			return domain.Carrier{}, ErrCidInUse
 		}
 
-		report := domain.ReportNumberOfCarriersPerLocality{
-			LocalityId:    locality.Id,
-			LocalityName:  locality.Name,
-			CarriersCount: carriersPerLocality,
+		if err != nil && !errors.Is(err, ErrNoElementFound) {
+			return domain.Carrier{}, err
 		}
 
-		reports = append(reports, report)
+		carrier.Cid = cid
 	}
 
-	return reports, nil
-}
+	if update.LocalityId != nil && *update.LocalityId != carrier.LocalityId {
+		_, err = s.localityRepository.GetById(*update.LocalityId)
 
-func (s *carrierService) GetAllNumberOfCarriersPerLocality() (domain.ReportsNumberOfCarriersPerLocality, error) {
-	localities, err := s.localityRepository.GetAll()
+		if err != nil && errors.Is(err, ErrNoElementFound) {
+			return domain.Carrier{}, ErrInvalidLocalityId
+		}
 
-	if err != nil {
-		return domain.ReportsNumberOfCarriersPerLocality{}, err
+		if err != nil {
+			return domain.Carrier{}, err
+		}
+
+		carrier.LocalityId = *update.LocalityId
 	}
 
-	reports := domain.ReportsNumberOfCarriersPerLocality{}
+	if update.CompanyName != nil {
+		carrier.CompanyName = *update.CompanyName
+	}
 
-	for _, locality := range localities {
-		carriersPerLocality, err := s.carrierRepository.GetNumberOfCarriersPerLocality(locality.Id)
+	if update.Address != nil {
+		carrier.Address = *update.Address
+	}
 
-		if err != nil {
-			return domain.ReportsNumberOfCarriersPerLocality{}, err
-		}
+	if update.Telephone != nil {
+		carrier.Telephone = *update.Telephone
+	}
 
-		report := domain.ReportNumberOfCarriersPerLocality{
-			LocalityId:    locality.Id,
-			LocalityName:  locality.Name,
-			CarriersCount: carriersPerLocality,
-		}
+	return s.carrierRepository.Update(id, carrier.Cid, carrier.CompanyName, carrier.Address, carrier.Telephone, carrier.LocalityId)
+}
+
+// Delete soft deletes the carrier; with hard it removes it if it has no orders.
+func (s *carrierService) Delete(id int, hard bool) error {
+	if !hard {
+		return s.carrierRepository.SoftDelete(id)
+	}
+
+	hasOrders, err := s.carrierRepository.HasPurchaseOrders(id)
+
+	if err != nil {
+		return err
+	}
+
+	if hasOrders {
+		return ErrCarrierHasOrders
+	}
 
-		reports = append(reports, report)
+	return s.carrierRepository.Delete(id)
+}
+
+func (s *carrierService) Restore(id int) (domain.Carrier, error) {
+	if err := s.carrierRepository.Restore(id); err != nil {
+		return domain.Carrier{}, err
 	}
 
-	return reports, nil
+	return s.carrierRepository.GetById(id)
 }
@@ -14,7 +14,7 @@ import (
 func makeCarrier() domain.Carrier {
 	return domain.Carrier{
 		Id:          1,
-		Cid:         "valid_cid",
+		Cid:         "11.222.333/0001-81",
 		CompanyName: "valid_name",
 		Address:     "valid_address",
 		Telephone:   "valid_phone",
@@ -30,26 +30,6 @@ func makeLocality() domain.Locality {
 	}
 }
 
-func makeLocalities() domain.Localities {
-	return domain.Localities{
-		domain.Locality{
-			Id:         1,
-			Name:       "valid_name_1",
-			ProvinceId: 1,
-		},
-		domain.Locality{
-			Id:         2,
-			Name:       "valid_name_2",
-			ProvinceId: 1,
-		},
-		domain.Locality{
-			Id:         3,
-			Name:       "valid_name_3",
-			ProvinceId: 1,
-		},
-	}
-}
-
 func TestCreate(t *testing.T) {
 	makeSut := func() (usecases.CarrierService, *mocks.CarrierRepository, *mocks.LocalityRepository) {
 		mockCarrierRepository := mocks.NewCarrierRepository(t)
@@ -59,9 +39,17 @@ func TestCreate(t *testing.T) {
 	}
 
 	makeCreateParams := func() (string, string, string, string, int) {
-		return "valid_cid", "valid_name", "valid_address", "valid_phone", 1
+		return "11222333000181", "valid_name", "valid_address", "valid_phone", 1
 	}
 
+	t.Run("Should return ErrInvalidCid if cid is not a valid CPF or CNPJ", func(t *testing.T) {
+		sut, _, _ := makeSut()
+
+		_, err := sut.Create("11222333000182", "valid_name", "valid_address", "valid_phone", 1)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidCid)
+	})
+
 	t.Run("Should call GetByCid from Carrier Repository with correct cid", func(t *testing.T) {
 		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
 		mockCarrierRepository.
@@ -79,7 +67,7 @@ func TestCreate(t *testing.T) {
 
 		sut.Create(makeCreateParams())
 
-		mockCarrierRepository.AssertCalled(t, "GetByCid", "valid_cid")
+		mockCarrierRepository.AssertCalled(t, "GetByCid", "11.222.333/0001-81")
 	})
 
 	t.Run("Should return error if cid provided is in use", func(t *testing.T) {
@@ -149,7 +137,7 @@ func TestCreate(t *testing.T) {
 
 		sut.Create(makeCreateParams())
 
-		mockCarrierRepository.AssertCalled(t, "Create", "valid_cid", "valid_name", "valid_address", "valid_phone", 1)
+		mockCarrierRepository.AssertCalled(t, "Create", "11.222.333/0001-81", "valid_name", "valid_address", "valid_phone", 1)
 	})
 
 	t.Run("Should return error if Create from Carrier Repository returns an error", func(t *testing.T) {
@@ -193,104 +181,97 @@ func TestCreate(t *testing.T) {
 }
 
 func TestGetNumberOfCarriersPerLocalities(t *testing.T) {
-	makeSut := func() (usecases.CarrierService, *mocks.CarrierRepository, *mocks.LocalityRepository) {
+	makeSut := func() (usecases.CarrierService, *mocks.CarrierRepository) {
 		mockCarrierRepository := mocks.NewCarrierRepository(t)
-		mockLocalityRepository := mocks.NewLocalityRepository(t)
-		sut := usecases.CreateCarrierService(mockCarrierRepository, mockLocalityRepository)
-		return sut, mockCarrierRepository, mockLocalityRepository
+		sut := usecases.CreateCarrierService(mockCarrierRepository, mocks.NewLocalityRepository(t))
+		return sut, mockCarrierRepository
 	}
 
-	t.Run("Should call GetById from Locality Repository for each id received", func(t *testing.T) {
-		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(makeLocality(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(2, nil)
+	makeReports := func() domain.ReportsNumberOfCarriersPerLocality {
+		return domain.ReportsNumberOfCarriersPerLocality{
+			{LocalityId: 1, LocalityName: "valid_name", CarriersCount: 3},
+		}
+	}
 
-		sut.GetNumberOfCarriersPerLocalities([]int{1, 2, 3})
+	t.Run("Should ask the Carrier Repository for the report of every locality if no id is given", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("GetReportNumberOfCarriersPerLocality", []int{}).Return(makeReports(), nil).Once()
 
-		mockLocalityRepository.AssertNumberOfCalls(t, "GetById", 3)
-		mockLocalityRepository.AssertCalled(t, "GetById", 1)
-		mockLocalityRepository.AssertCalled(t, "GetById", 2)
-		mockLocalityRepository.AssertCalled(t, "GetById", 3)
+		result, err := sut.GetNumberOfCarriersPerLocalities([]int{})
+
+		assert.Equal(t, makeReports(), result)
+		assert.Nil(t, err)
 	})
 
-	t.Run("Should return an error if GetById from Locality Repository returns an error different than ErrNoElementFound", func(t *testing.T) {
-		sut, _, mockLocalityRepository := makeSut()
-
-		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(domain.Locality{}, errors.New("any_error"))
+	t.Run("Should ask the Carrier Repository for the requested localities in a single call", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("GetReportNumberOfCarriersPerLocality", []int{1, 2, 3}).Return(makeReports(), nil).Once()
 
 		result, err := sut.GetNumberOfCarriersPerLocalities([]int{1, 2, 3})
 
-		assert.Equal(t, domain.ReportsNumberOfCarriersPerLocality{}, result)
-		assert.EqualError(t, err, "any_error")
+		assert.Equal(t, makeReports(), result)
+		assert.Nil(t, err)
+		mockCarrierRepository.AssertNumberOfCalls(t, "GetReportNumberOfCarriersPerLocality", 1)
 	})
 
-	t.Run("Should call GetNumberOfCarriersPerLocality from Carrier Repository  for each id received", func(t *testing.T) {
-		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(makeLocality(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(2, nil)
+	t.Run("Should return an error if GetReportNumberOfCarriersPerLocality from Carrier Repository returns an error", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("GetReportNumberOfCarriersPerLocality", []int{1}).Return(nil, errors.New("any_error")).Once()
 
-		sut.GetNumberOfCarriersPerLocalities([]int{1, 2, 3})
+		_, err := sut.GetNumberOfCarriersPerLocalities([]int{1})
 
-		mockCarrierRepository.AssertNumberOfCalls(t, "GetNumberOfCarriersPerLocality", 3)
-		mockCarrierRepository.AssertCalled(t, "GetNumberOfCarriersPerLocality", 1)
-		mockCarrierRepository.AssertCalled(t, "GetNumberOfCarriersPerLocality", 2)
-		mockCarrierRepository.AssertCalled(t, "GetNumberOfCarriersPerLocality", 3)
+		assert.EqualError(t, err, "any_error")
 	})
+}
+func TestGetAll(t *testing.T) {
+	mockCarrierRepository := mocks.NewCarrierRepository(t)
+	mockLocalityRepository := mocks.NewLocalityRepository(t)
+	sut := usecases.CreateCarrierService(mockCarrierRepository, mockLocalityRepository)
 
-	t.Run("Should return an error if GetNumberOfCarriersPerLocality from Carrier Repository returns an error", func(t *testing.T) {
-		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-
-		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(makeLocality(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(0, errors.New("any_error"))
+	t.Run("Should return an error if GetAll from Carrier Repository returns an error", func(t *testing.T) {
+		mockCarrierRepository.On("GetAll", false).Return(nil, errors.New("any_error")).Once()
 
-		result, err := sut.GetNumberOfCarriersPerLocalities([]int{1, 2, 3})
+		result, err := sut.GetAll(false)
 
-		assert.Equal(t, domain.ReportsNumberOfCarriersPerLocality{}, result)
+		assert.Equal(t, domain.Carriers{}, result)
 		assert.EqualError(t, err, "any_error")
 	})
 
-	t.Run("Should return an report list on success", func(t *testing.T) {
-		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
+	t.Run("Should return the carriers on success", func(t *testing.T) {
+		mockCarrierRepository.On("GetAll", true).Return(domain.Carriers{makeCarrier()}, nil).Once()
 
-		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(makeLocality(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(3, nil)
-
-		result, err := sut.GetNumberOfCarriersPerLocalities([]int{1, 2})
-
-		expected := domain.ReportsNumberOfCarriersPerLocality{
-			domain.ReportNumberOfCarriersPerLocality{
-				LocalityId:    1,
-				LocalityName:  "valid_name",
-				CarriersCount: 3,
-			},
-			domain.ReportNumberOfCarriersPerLocality{
-				LocalityId:    1,
-				LocalityName:  "valid_name",
-				CarriersCount: 3,
-			},
-		}
+		result, err := sut.GetAll(true)
 
-		assert.Equal(t, expected, result)
+		assert.Equal(t, domain.Carriers{makeCarrier()}, result)
 		assert.Nil(t, err)
 	})
+}
 
-	t.Run("Should return an report list if GetById from Locality Repository returns ErrNoElementFound", func(t *testing.T) {
-		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(makeLocality(), nil).Times(1)
-		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(domain.Locality{}, usecases.ErrNoElementFound).Times(2)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(2, nil)
+func TestGetById(t *testing.T) {
+	mockCarrierRepository := mocks.NewCarrierRepository(t)
+	mockLocalityRepository := mocks.NewLocalityRepository(t)
+	sut := usecases.CreateCarrierService(mockCarrierRepository, mockLocalityRepository)
 
-		result, err := sut.GetNumberOfCarriersPerLocalities([]int{1, 2, 3})
+	t.Run("Should return ErrNoElementFound if carrier does not exist", func(t *testing.T) {
+		mockCarrierRepository.On("GetById", 1).Return(domain.Carrier{}, usecases.ErrNoElementFound).Once()
 
-		mockLocalityRepository.AssertNumberOfCalls(t, "GetById", 3)
-		mockCarrierRepository.AssertNumberOfCalls(t, "GetNumberOfCarriersPerLocality", 1)
+		result, err := sut.GetById(1)
 
-		assert.Equal(t, 1, len(result))
+		assert.Equal(t, domain.Carrier{}, result)
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
+
+	t.Run("Should return the carrier on success", func(t *testing.T) {
+		mockCarrierRepository.On("GetById", 1).Return(makeCarrier(), nil).Once()
+
+		result, err := sut.GetById(1)
+
+		assert.Equal(t, makeCarrier(), result)
 		assert.Nil(t, err)
 	})
 }
 
-func TestGetAllNumberOfCarriersPerLocalities(t *testing.T) {
+func TestUpdate(t *testing.T) {
 	makeSut := func() (usecases.CarrierService, *mocks.CarrierRepository, *mocks.LocalityRepository) {
 		mockCarrierRepository := mocks.NewCarrierRepository(t)
 		mockLocalityRepository := mocks.NewLocalityRepository(t)
@@ -298,78 +279,175 @@ func TestGetAllNumberOfCarriersPerLocalities(t *testing.T) {
 		return sut, mockCarrierRepository, mockLocalityRepository
 	}
 
-	t.Run("Should call GetAll from Locality Repository", func(t *testing.T) {
-		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetAll").Return(makeLocalities(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(2, nil)
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
 
-		sut.GetAllNumberOfCarriersPerLocality()
+	t.Run("Should return ErrNoElementFound if carrier does not exist", func(t *testing.T) {
+		sut, mockCarrierRepository, _ := makeSut()
+		mockCarrierRepository.On("GetById", 1).Return(domain.Carrier{}, usecases.ErrNoElementFound).Once()
 
-		mockLocalityRepository.AssertCalled(t, "GetAll")
-		mockLocalityRepository.AssertNumberOfCalls(t, "GetAll", 1)
+		_, err := sut.Update(1, domain.CarrierUpdate{CompanyName: str("valid_name")})
 
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
 	})
 
-	t.Run("Should return an error if GetAll from Locality Repository returns an error", func(t *testing.T) {
-		sut, _, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetAll").Return(domain.Localities{}, errors.New("any_error"))
+	t.Run("Should return ErrInvalidCid if a new cid is not a valid CPF or CNPJ", func(t *testing.T) {
+		sut, mockCarrierRepository, _ := makeSut()
+		mockCarrierRepository.On("GetById", 1).Return(makeCarrier(), nil).Once()
 
-		result, err := sut.GetAllNumberOfCarriersPerLocality()
+		_, err := sut.Update(1, domain.CarrierUpdate{Cid: str("11.222.333/0001-82")})
 
-		assert.Equal(t, domain.ReportsNumberOfCarriersPerLocality{}, result)
-		assert.EqualError(t, err, "any_error")
+		assert.ErrorIs(t, err, usecases.ErrInvalidCid)
+	})
+
+	t.Run("Should return ErrCidInUse if cid belongs to another carrier", func(t *testing.T) {
+		sut, mockCarrierRepository, _ := makeSut()
+		mockCarrierRepository.On("GetById", 2).Return(domain.Carrier{Id: 2, Cid: "529.982.247-25"}, nil).Once()
+		mockCarrierRepository.On("GetByCid", "11.222.333/0001-81").Return(makeCarrier(), nil).Once()
+
+		_, err := sut.Update(2, domain.CarrierUpdate{Cid: str("11222333000181")})
+
+		assert.ErrorIs(t, err, usecases.ErrCidInUse)
 	})
 
-	t.Run("Should call GetNumberOfCarriersPerLocality from Carrier Repository  for each id received", func(t *testing.T) {
+	t.Run("Should return ErrInvalidLocalityId if locality_id provided is invalid", func(t *testing.T) {
 		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetAll").Return(makeLocalities(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(2, nil)
+		mockCarrierRepository.On("GetById", 1).Return(makeCarrier(), nil).Once()
+		mockLocalityRepository.On("GetById", 9).Return(domain.Locality{}, usecases.ErrNoElementFound).Once()
 
-		sut.GetAllNumberOfCarriersPerLocality()
+		_, err := sut.Update(1, domain.CarrierUpdate{LocalityId: num(9)})
 
-		mockCarrierRepository.AssertNumberOfCalls(t, "GetNumberOfCarriersPerLocality", 3)
-		mockCarrierRepository.AssertCalled(t, "GetNumberOfCarriersPerLocality", 1)
-		mockCarrierRepository.AssertCalled(t, "GetNumberOfCarriersPerLocality", 2)
-		mockCarrierRepository.AssertCalled(t, "GetNumberOfCarriersPerLocality", 3)
+		assert.ErrorIs(t, err, usecases.ErrInvalidLocalityId)
 	})
 
-	t.Run("Should return an error if GetNumberOfCarriersPerLocality from Carrier Repository returns an error", func(t *testing.T) {
+	t.Run("Should keep a legacy cid that the request doesn't change", func(t *testing.T) {
+		sut, mockCarrierRepository, _ := makeSut()
+		legacy := makeCarrier()
+		legacy.Cid = "CID01"
+		mockCarrierRepository.On("GetById", 1).Return(legacy, nil).Once()
+		mockCarrierRepository.
+			On("Update", 1, "CID01", "valid_name", "valid_address", "(11) 93333-3333", 1).
+			Return(legacy, nil).
+			Once()
+
+		_, err := sut.Update(1, domain.CarrierUpdate{Cid: str("CID01"), Telephone: str("(11) 93333-3333")})
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should merge the provided fields onto the current carrier", func(t *testing.T) {
 		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetAll").Return(makeLocalities(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(0, errors.New("any_error"))
+		mockCarrierRepository.On("GetById", 1).Return(makeCarrier(), nil).Once()
+		mockCarrierRepository.On("GetByCid", "529.982.247-25").Return(domain.Carrier{}, usecases.ErrNoElementFound).Once()
+		mockLocalityRepository.On("GetById", 2).Return(makeLocality(), nil).Once()
+		mockCarrierRepository.
+			On("Update", 1, "529.982.247-25", "new_name", "valid_address", "valid_phone", 2).
+			Return(makeCarrier(), nil).
+			Once()
 
-		result, err := sut.GetAllNumberOfCarriersPerLocality()
+		result, err := sut.Update(1, domain.CarrierUpdate{Cid: str("52998224725"), CompanyName: str("new_name"), LocalityId: num(2)})
+
+		assert.Equal(t, makeCarrier(), result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return error if Update from Carrier Repository returns an error", func(t *testing.T) {
+		sut, mockCarrierRepository, _ := makeSut()
+		mockCarrierRepository.On("GetById", 1).Return(makeCarrier(), nil).Once()
+		mockCarrierRepository.
+			On("Update", 1, "11.222.333/0001-81", "valid_name", "new_address", "valid_phone", 1).
+			Return(domain.Carrier{}, errors.New("any_error")).
+			Once()
+
+		_, err := sut.Update(1, domain.CarrierUpdate{Address: str("new_address")})
 
-		assert.Equal(t, domain.ReportsNumberOfCarriersPerLocality{}, result)
 		assert.EqualError(t, err, "any_error")
 	})
+}
 
-	t.Run("Should return an report list on success", func(t *testing.T) {
-		sut, mockCarrierRepository, mockLocalityRepository := makeSut()
-		mockLocalityRepository.On("GetAll").Return(makeLocalities(), nil)
-		mockCarrierRepository.On("GetNumberOfCarriersPerLocality", mock.AnythingOfType("int")).Return(3, nil)
-
-		result, err := sut.GetAllNumberOfCarriersPerLocality()
-
-		expected := domain.ReportsNumberOfCarriersPerLocality{
-			domain.ReportNumberOfCarriersPerLocality{
-				LocalityId:    1,
-				LocalityName:  "valid_name_1",
-				CarriersCount: 3,
-			},
-			domain.ReportNumberOfCarriersPerLocality{
-				LocalityId:    2,
-				LocalityName:  "valid_name_2",
-				CarriersCount: 3,
-			},
-			domain.ReportNumberOfCarriersPerLocality{
-				LocalityId:    3,
-				LocalityName:  "valid_name_3",
-				CarriersCount: 3,
-			},
-		}
+func TestDelete(t *testing.T) {
+	makeSut := func() (usecases.CarrierService, *mocks.CarrierRepository) {
+		mockCarrierRepository := mocks.NewCarrierRepository(t)
+		mockLocalityRepository := mocks.NewLocalityRepository(t)
+		sut := usecases.CreateCarrierService(mockCarrierRepository, mockLocalityRepository)
+		return sut, mockCarrierRepository
+	}
+
+	t.Run("Should soft delete the carrier if hard is not set", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("SoftDelete", 1).Return(nil).Once()
+
+		err := sut.Delete(1, false)
+
+		assert.Nil(t, err)
+		mockCarrierRepository.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("Should return ErrNoElementFound if SoftDelete finds no carrier", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("SoftDelete", 1).Return(usecases.ErrNoElementFound).Once()
+
+		err := sut.Delete(1, false)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
 
-		assert.Equal(t, expected, result)
+	t.Run("Should return ErrCarrierHasOrders on a hard delete of a carrier with purchase orders", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("HasPurchaseOrders", 1).Return(true, nil).Once()
+
+		err := sut.Delete(1, true)
+
+		assert.ErrorIs(t, err, usecases.ErrCarrierHasOrders)
+		mockCarrierRepository.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("Should return an error if HasPurchaseOrders returns an error", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("HasPurchaseOrders", 1).Return(false, errors.New("any_error")).Once()
+
+		err := sut.Delete(1, true)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should hard delete a carrier without purchase orders", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("HasPurchaseOrders", 1).Return(false, nil).Once()
+		mockCarrierRepository.On("Delete", 1).Return(nil).Once()
+
+		err := sut.Delete(1, true)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	makeSut := func() (usecases.CarrierService, *mocks.CarrierRepository) {
+		mockCarrierRepository := mocks.NewCarrierRepository(t)
+		mockLocalityRepository := mocks.NewLocalityRepository(t)
+		sut := usecases.CreateCarrierService(mockCarrierRepository, mockLocalityRepository)
+		return sut, mockCarrierRepository
+	}
+
+	t.Run("Should return ErrNoElementFound if there is no deleted carrier", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("Restore", 1).Return(usecases.ErrNoElementFound).Once()
+
+		result, err := sut.Restore(1)
+
+		assert.Equal(t, domain.Carrier{}, result)
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
+
+	t.Run("Should return the restored carrier on success", func(t *testing.T) {
+		sut, mockCarrierRepository := makeSut()
+		mockCarrierRepository.On("Restore", 1).Return(nil).Once()
+		mockCarrierRepository.On("GetById", 1).Return(makeCarrier(), nil).Once()
+
+		result, err := sut.Restore(1)
+
+		assert.Equal(t, makeCarrier(), result)
 		assert.Nil(t, err)
 	})
 }
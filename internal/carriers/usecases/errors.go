@@ -4,6 +4,10 @@ import "errors"
 
 var ErrCidInUse = errors.New("this cid is in use")
 
+var ErrInvalidCid = errors.New("cid must be a valid CPF or CNPJ")
+
 var ErrInvalidLocalityId = errors.New("this locality_id is invalid")
 
 var ErrNoElementFound = errors.New("can't find element")
+
+var ErrCarrierHasOrders = errors.New("carrier has purchase orders and can't be permanently deleted")
@@ -33,6 +33,43 @@ func (_m *CarrierRepository) Create(cid string, companyName string, address stri
 	return r0, r1
 }
 
+// Delete provides a mock function with given fields: id
+func (_m *CarrierRepository) Delete(id int) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: includeDeleted
+func (_m *CarrierRepository) GetAll(includeDeleted bool) (domain.Carriers, error) {
+	ret := _m.Called(includeDeleted)
+
+	var r0 domain.Carriers
+	if rf, ok := ret.Get(0).(func(bool) domain.Carriers); ok {
+		r0 = rf(includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.Carriers)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByCid provides a mock function with given fields: cid
 func (_m *CarrierRepository) GetByCid(cid string) (domain.Carrier, error) {
 	ret := _m.Called(cid)
@@ -54,20 +91,113 @@ func (_m *CarrierRepository) GetByCid(cid string) (domain.Carrier, error) {
 	return r0, r1
 }
 
-// GetNumberOfCarriersPerLocality provides a mock function with given fields: localityId
-func (_m *CarrierRepository) GetNumberOfCarriersPerLocality(localityId int) (int, error) {
-	ret := _m.Called(localityId)
+// GetById provides a mock function with given fields: id
+func (_m *CarrierRepository) GetById(id int) (domain.Carrier, error) {
+	ret := _m.Called(id)
 
-	var r0 int
-	if rf, ok := ret.Get(0).(func(int) int); ok {
-		r0 = rf(localityId)
+	var r0 domain.Carrier
+	if rf, ok := ret.Get(0).(func(int) domain.Carrier); ok {
+		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Get(0).(domain.Carrier)
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(localityId)
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReportNumberOfCarriersPerLocality provides a mock function with given fields: localitiesIds
+func (_m *CarrierRepository) GetReportNumberOfCarriersPerLocality(localitiesIds []int) (domain.ReportsNumberOfCarriersPerLocality, error) {
+	ret := _m.Called(localitiesIds)
+
+	var r0 domain.ReportsNumberOfCarriersPerLocality
+	if rf, ok := ret.Get(0).(func([]int) domain.ReportsNumberOfCarriersPerLocality); ok {
+		r0 = rf(localitiesIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ReportsNumberOfCarriersPerLocality)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]int) error); ok {
+		r1 = rf(localitiesIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasPurchaseOrders provides a mock function with given fields: id
+func (_m *CarrierRepository) HasPurchaseOrders(id int) (bool, error) {
+	ret := _m.Called(id)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: id
+func (_m *CarrierRepository) Restore(id int) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDelete provides a mock function with given fields: id
+func (_m *CarrierRepository) SoftDelete(id int) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: id, cid, companyName, address, telephone, localityId
+func (_m *CarrierRepository) Update(id int, cid string, companyName string, address string, telephone string, localityId int) (domain.Carrier, error) {
+	ret := _m.Called(id, cid, companyName, address, telephone, localityId)
+
+	var r0 domain.Carrier
+	if rf, ok := ret.Get(0).(func(int, string, string, string, string, int) domain.Carrier); ok {
+		r0 = rf(id, cid, companyName, address, telephone, localityId)
+	} else {
+		r0 = ret.Get(0).(domain.Carrier)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, string, string, string, string, int) error); ok {
+		r1 = rf(id, cid, companyName, address, telephone, localityId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -33,22 +33,57 @@ func (_m *CarrierService) Create(cid string, companyName string, address string,
 	return r0, r1
 }
 
-// GetAllNumberOfCarriersPerLocality provides a mock function with given fields:
-func (_m *CarrierService) GetAllNumberOfCarriersPerLocality() (domain.ReportsNumberOfCarriersPerLocality, error) {
-	ret := _m.Called()
+// Delete provides a mock function with given fields: id, hard
+func (_m *CarrierService) Delete(id int, hard bool) error {
+	ret := _m.Called(id, hard)
 
-	var r0 domain.ReportsNumberOfCarriersPerLocality
-	if rf, ok := ret.Get(0).(func() domain.ReportsNumberOfCarriersPerLocality); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, bool) error); ok {
+		r0 = rf(id, hard)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: includeDeleted
+func (_m *CarrierService) GetAll(includeDeleted bool) (domain.Carriers, error) {
+	ret := _m.Called(includeDeleted)
+
+	var r0 domain.Carriers
+	if rf, ok := ret.Get(0).(func(bool) domain.Carriers); ok {
+		r0 = rf(includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(domain.ReportsNumberOfCarriersPerLocality)
+			r0 = ret.Get(0).(domain.Carriers)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: id
+func (_m *CarrierService) GetById(id int) (domain.Carrier, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Carrier
+	if rf, ok := ret.Get(0).(func(int) domain.Carrier); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Carrier)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,6 +114,48 @@ func (_m *CarrierService) GetNumberOfCarriersPerLocalities(localitiesIds []int)
 	return r0, r1
 }
 
+// Restore provides a mock function with given fields: id
+func (_m *CarrierService) Restore(id int) (domain.Carrier, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Carrier
+	if rf, ok := ret.Get(0).(func(int) domain.Carrier); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Carrier)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: id, update
+func (_m *CarrierService) Update(id int, update domain.CarrierUpdate) (domain.Carrier, error) {
+	ret := _m.Called(id, update)
+
+	var r0 domain.Carrier
+	if rf, ok := ret.Get(0).(func(int, domain.CarrierUpdate) domain.Carrier); ok {
+		r0 = rf(id, update)
+	} else {
+		r0 = ret.Get(0).(domain.Carrier)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, domain.CarrierUpdate) error); ok {
+		r1 = rf(id, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewCarrierService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,29 +12,6 @@ type LocalityRepository struct {
 	mock.Mock
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *LocalityRepository) GetAll() (domain.Localities, error) {
-	ret := _m.Called()
-
-	var r0 domain.Localities
-	if rf, ok := ret.Get(0).(func() domain.Localities); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(domain.Localities)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
 // GetById provides a mock function with given fields: id
 func (_m *LocalityRepository) GetById(id int) (domain.Locality, error) {
 	ret := _m.Called(id)
@@ -0,0 +1,90 @@
+package employee
+
+import (
+	"database/sql"
+)
+
+type AssignmentRepository interface {
+	GetByEmployeeId(employeeId int) ([]Assignment, error)
+	Transfer(employeeId int, wareHouseId int, date string) (Assignment, error)
+}
+
+type assignmentRepository struct {
+	db *sql.DB
+}
+
+func CreateAssignmentRepository(db *sql.DB) AssignmentRepository {
+	return &assignmentRepository{
+		db: db,
+	}
+}
+
+func (r *assignmentRepository) GetByEmployeeId(employeeId int) ([]Assignment, error) {
+	as := []Assignment{}
+
+	rows, err := r.db.Query(
+		"SELECT id, employee_id, warehouse_id, DATE_FORMAT(start_date, '%Y-%m-%d'), DATE_FORMAT(end_date, '%Y-%m-%d') FROM employee_assignment WHERE employee_id=? ORDER BY start_date, id",
+		employeeId,
+	)
+
+	if err != nil {
+		return as, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var a Assignment
+
+		if err := rows.Scan(&a.Id, &a.Employee_id, &a.Warehouse_id, &a.Start_date, &a.End_date); err != nil {
+			return as, err
+		}
+
+		as = append(as, a)
+	}
+
+	return as, rows.Err()
+}
+
+// Transfer closes the employee's current assignment at date, opens one at
+// wareHouseId from date on and moves the employee there, all or nothing.
+func (r *assignmentRepository) Transfer(employeeId int, wareHouseId int, date string) (Assignment, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("UPDATE employee_assignment SET end_date=? WHERE employee_id=? AND end_date IS NULL", date, employeeId); err != nil {
+		return Assignment{}, err
+	}
+
+	res, err := tx.Exec("INSERT INTO employee_assignment (employee_id, warehouse_id, start_date) VALUES (?, ?, ?)", employeeId, wareHouseId, date)
+
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	if _, err := tx.Exec("UPDATE employee SET warehouse_id=? WHERE id=?", wareHouseId, employeeId); err != nil {
+		return Assignment{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Assignment{}, err
+	}
+
+	return Assignment{
+		Id:           int(id),
+		Employee_id:  employeeId,
+		Warehouse_id: wareHouseId,
+		Start_date:   date,
+	}, nil
+}
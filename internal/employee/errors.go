@@ -1,5 +1,7 @@
 package employee
 
+import "errors"
+
 type BusinessRuleError struct {
 	Err error
 }
@@ -15,3 +17,11 @@ type NoElementInFileError struct {
 func (b *NoElementInFileError) Error() string {
 	return b.Err.Error()
 }
+
+// ErrTransferRequired is returned when an update tries to change the
+// employee's warehouse, which only a transfer may do.
+var ErrTransferRequired = &BusinessRuleError{errors.New("warehouse_id can only be changed through a transfer")}
+
+// ErrShiftOverlap is returned when an employee would work two shifts at the
+// same time.
+var ErrShiftOverlap = &BusinessRuleError{errors.New("employee is already assigned to an overlapping shift")}
@@ -12,20 +12,20 @@ type EmployeeRepositoryInterface struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: cardNumberId, firstName, lastName, wareHouseId
-func (_m *EmployeeRepositoryInterface) Create(cardNumberId int, firstName string, lastName string, wareHouseId int) (employee.Employee, error) {
-	ret := _m.Called(cardNumberId, firstName, lastName, wareHouseId)
+// Create provides a mock function with given fields: cardNumberId, firstName, lastName, wareHouseId, role, supervisorId, startDate
+func (_m *EmployeeRepositoryInterface) Create(cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int, startDate string) (employee.Employee, error) {
+	ret := _m.Called(cardNumberId, firstName, lastName, wareHouseId, role, supervisorId, startDate)
 
 	var r0 employee.Employee
-	if rf, ok := ret.Get(0).(func(int, string, string, int) employee.Employee); ok {
-		r0 = rf(cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(0).(func(int, string, string, int, string, int, string) employee.Employee); ok {
+		r0 = rf(cardNumberId, firstName, lastName, wareHouseId, role, supervisorId, startDate)
 	} else {
 		r0 = ret.Get(0).(employee.Employee)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string, string, int) error); ok {
-		r1 = rf(cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(1).(func(int, string, string, int, string, int, string) error); ok {
+		r1 = rf(cardNumberId, firstName, lastName, wareHouseId, role, supervisorId, startDate)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,20 +112,20 @@ func (_m *EmployeeRepositoryInterface) GetById(id int) (employee.Employee, error
 	return r0, r1
 }
 
-// UpdateById provides a mock function with given fields: id, cardNumberId, firstName, lastName, wareHouseId
-func (_m *EmployeeRepositoryInterface) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int) (employee.Employee, error) {
-	ret := _m.Called(id, cardNumberId, firstName, lastName, wareHouseId)
+// UpdateById provides a mock function with given fields: id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId
+func (_m *EmployeeRepositoryInterface) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (employee.Employee, error) {
+	ret := _m.Called(id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 
 	var r0 employee.Employee
-	if rf, ok := ret.Get(0).(func(int, int, string, string, int) employee.Employee); ok {
-		r0 = rf(id, cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(0).(func(int, int, string, string, int, string, int) employee.Employee); ok {
+		r0 = rf(id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 	} else {
 		r0 = ret.Get(0).(employee.Employee)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int, string, string, int) error); ok {
-		r1 = rf(id, cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(1).(func(int, int, string, string, int, string, int) error); ok {
+		r1 = rf(id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 	} else {
 		r1 = ret.Error(1)
 	}
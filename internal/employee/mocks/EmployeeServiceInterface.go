@@ -12,20 +12,20 @@ type EmployeeServiceInterface struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: cardNumberId, firstName, lastName, wareHouseId
-func (_m *EmployeeServiceInterface) Create(cardNumberId int, firstName string, lastName string, wareHouseId int) (employee.Employee, error) {
-	ret := _m.Called(cardNumberId, firstName, lastName, wareHouseId)
+// Create provides a mock function with given fields: cardNumberId, firstName, lastName, wareHouseId, role, supervisorId
+func (_m *EmployeeServiceInterface) Create(cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (employee.Employee, error) {
+	ret := _m.Called(cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 
 	var r0 employee.Employee
-	if rf, ok := ret.Get(0).(func(int, string, string, int) employee.Employee); ok {
-		r0 = rf(cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(0).(func(int, string, string, int, string, int) employee.Employee); ok {
+		r0 = rf(cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 	} else {
 		r0 = ret.Get(0).(employee.Employee)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string, string, int) error); ok {
-		r1 = rf(cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(1).(func(int, string, string, int, string, int) error); ok {
+		r1 = rf(cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,6 +70,29 @@ func (_m *EmployeeServiceInterface) GetAll() ([]employee.Employee, error) {
 	return r0, r1
 }
 
+// GetAssignments provides a mock function with given fields: id
+func (_m *EmployeeServiceInterface) GetAssignments(id int) ([]employee.Assignment, error) {
+	ret := _m.Called(id)
+
+	var r0 []employee.Assignment
+	if rf, ok := ret.Get(0).(func(int) []employee.Assignment); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]employee.Assignment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetById provides a mock function with given fields: id
 func (_m *EmployeeServiceInterface) GetById(id int) (employee.Employee, error) {
 	ret := _m.Called(id)
@@ -91,20 +114,41 @@ func (_m *EmployeeServiceInterface) GetById(id int) (employee.Employee, error) {
 	return r0, r1
 }
 
-// UpdateById provides a mock function with given fields: id, cardNumberId, firstName, lastName, wareHouseId
-func (_m *EmployeeServiceInterface) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int) (employee.Employee, error) {
-	ret := _m.Called(id, cardNumberId, firstName, lastName, wareHouseId)
+// Transfer provides a mock function with given fields: id, wareHouseId, date
+func (_m *EmployeeServiceInterface) Transfer(id int, wareHouseId int, date string) (employee.Employee, error) {
+	ret := _m.Called(id, wareHouseId, date)
+
+	var r0 employee.Employee
+	if rf, ok := ret.Get(0).(func(int, int, string) employee.Employee); ok {
+		r0 = rf(id, wareHouseId, date)
+	} else {
+		r0 = ret.Get(0).(employee.Employee)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, int, string) error); ok {
+		r1 = rf(id, wareHouseId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateById provides a mock function with given fields: id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId
+func (_m *EmployeeServiceInterface) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (employee.Employee, error) {
+	ret := _m.Called(id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 
 	var r0 employee.Employee
-	if rf, ok := ret.Get(0).(func(int, int, string, string, int) employee.Employee); ok {
-		r0 = rf(id, cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(0).(func(int, int, string, string, int, string, int) employee.Employee); ok {
+		r0 = rf(id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 	} else {
 		r0 = ret.Get(0).(employee.Employee)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int, string, string, int) error); ok {
-		r1 = rf(id, cardNumberId, firstName, lastName, wareHouseId)
+	if rf, ok := ret.Get(1).(func(int, int, string, string, int, string, int) error); ok {
+		r1 = rf(id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 	} else {
 		r1 = ret.Error(1)
 	}
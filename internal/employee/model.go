@@ -6,6 +6,42 @@ type Employee struct {
 	First_name     string `json:"first_name"`
 	Last_name      string `json:"Last_name"`
 	Warehouse_id   int    `json:"warehouse_id"`
+	Role           string `json:"role"`
+	Supervisor_id  int    `json:"supervisor_id,omitempty"`
+}
+
+const (
+	RolePicker     = "picker"
+	RoleReceiver   = "receiver"
+	RoleSupervisor = "supervisor"
+	RoleManager    = "manager"
+)
+
+var Roles = []string{RolePicker, RoleReceiver, RoleSupervisor, RoleManager}
+
+func IsValidRole(role string) bool {
+	for _, r := range Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanSupervise tells whether employees with the role may supervise others.
+func CanSupervise(role string) bool {
+	return role == RoleSupervisor || role == RoleManager
+}
+
+// Assignment is a period an employee worked at a warehouse. The current one
+// has no End_date.
+type Assignment struct {
+	Id           int     `json:"id"`
+	Employee_id  int     `json:"employee_id"`
+	Warehouse_id int     `json:"warehouse_id"`
+	Start_date   string  `json:"start_date"`
+	End_date     *string `json:"end_date,omitempty"`
 }
 
 type Warehouse struct {
@@ -16,3 +52,27 @@ type Warehouse struct {
 	MinimumCapacity    int     `json:"minimum_capacity"`
 	MinimumTemperature float64 `json:"minimum_temperature"`
 }
+
+// Shift is a work period of a warehouse, in HH:MM. A shift whose end is not
+// after its start runs past midnight.
+type Shift struct {
+	Id            int    `json:"id"`
+	Warehouse_id  int    `json:"warehouse_id"`
+	Name          string `json:"name"`
+	Start_time    string `json:"start_time"`
+	End_time      string `json:"end_time"`
+	Minimum_staff int    `json:"minimum_staff"`
+}
+
+type ShiftAssignment struct {
+	Id          int    `json:"id"`
+	Shift_id    int    `json:"shift_id"`
+	Employee_id int    `json:"employee_id"`
+	Date        string `json:"date"`
+}
+
+type RosterShift struct {
+	Shift
+	Employees    []Employee `json:"employees"`
+	Understaffed bool       `json:"understaffed"`
+}
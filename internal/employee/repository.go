@@ -1,174 +1,193 @@
 package employee
 
 import (
+	"database/sql"
 	"errors"
 
-	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/store"
+	"github.com/go-sql-driver/mysql"
 )
 
 type employeeInterface interface {
-	Create(cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error)
+	Create(cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int, startDate string) (Employee, error)
 	GetAll() ([]Employee, error)
 	GetById(id int) (Employee, error)
-	UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error)
+	UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (Employee, error)
 	DeleteById(id int) error
 	GetByCardNumberId(cardNumberId int) (Employee, error)
 }
 
 type repository struct {
-	file store.Store
+	db *sql.DB
 }
 
-func CreateRepository(file store.Store) employeeInterface {
+func CreateRepository(db *sql.DB) employeeInterface {
 	return &repository{
-		file: file,
+		db: db,
 	}
 }
 
+const selectEmployee = "SELECT id, id_card_number, first_name, last_name, warehouse_id, role, COALESCE(supervisor_id, 0) FROM employee"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanEmployee(row scanner) (Employee, error) {
+	var e Employee
+
+	err := row.Scan(&e.Id, &e.Card_number_id, &e.First_name, &e.Last_name, &e.Warehouse_id, &e.Role, &e.Supervisor_id)
+
+	return e, err
+}
+
+// nullableId stores the zero id, used for "no supervisor", as NULL.
+func nullableId(id int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id != 0}
+}
+
 func (r *repository) GetByCardNumberId(cardNumberId int) (Employee, error) {
-	var es []Employee
-	if err := r.file.Read(&es); err != nil {
-		return Employee{}, nil
-	}
+	e, err := scanEmployee(r.db.QueryRow(selectEmployee+" WHERE id_card_number=?", cardNumberId))
 
-	result, found := Employee{}, false
-	for _, e := range es {
-		if e.Card_number_id == cardNumberId {
-			result, found = e, true
-			break
-		}
+	if errors.Is(err, sql.ErrNoRows) {
+		return Employee{}, &NoElementInFileError{errors.New("can't find element with this cardNumberId")}
 	}
 
-	if !found {
-		return Employee{}, &NoElementInFileError{errors.New("can't find element with this cardNumberId")}
+	if err != nil {
+		return Employee{}, err
 	}
 
-	return result, nil
+	return e, nil
 }
 
-func (r *repository) Create(cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error) {
+// Create stores the employee together with its first assignment, starting at
+// startDate, all or nothing.
+func (r *repository) Create(cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int, startDate string) (Employee, error) {
+	tx, err := r.db.Begin()
 
-	var es []Employee
-	if err := r.file.Read(&es); err != nil {
+	if err != nil {
 		return Employee{}, err
 	}
 
-	lastId, _ := r.lastId()
+	defer tx.Rollback()
 
-	e := Employee{
-		Id:             lastId + 1,
-		Card_number_id: cardNumberId,
-		First_name:     firstName,
-		Last_name:      lastName,
-		Warehouse_id:   wareHouseId,
+	res, err := tx.Exec(
+		"INSERT INTO employee (id_card_number, first_name, last_name, warehouse_id, role, supervisor_id) VALUES (?, ?, ?, ?, ?, ?)",
+		cardNumberId, firstName, lastName, wareHouseId, role, nullableId(supervisorId),
+	)
+
+	if err != nil {
+		return Employee{}, err
 	}
-	es = append(es, e)
 
-	if err := r.file.Write(es); err != nil {
+	id, err := res.LastInsertId()
+
+	if err != nil {
 		return Employee{}, err
 	}
-	return e, nil
-}
 
-func (r *repository) GetAll() ([]Employee, error) {
-	var es []Employee
-	if err := r.file.Read(&es); err != nil {
-		return []Employee{}, nil
+	if _, err := tx.Exec("INSERT INTO employee_assignment (employee_id, warehouse_id, start_date) VALUES (?, ?, ?)", id, wareHouseId, startDate); err != nil {
+		return Employee{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Employee{}, err
 	}
-	return es, nil
+
+	return Employee{
+		Id:             int(id),
+		Card_number_id: cardNumberId,
+		First_name:     firstName,
+		Last_name:      lastName,
+		Warehouse_id:   wareHouseId,
+		Role:           role,
+		Supervisor_id:  supervisorId,
+	}, nil
 }
 
-func (r *repository) GetById(id int) (Employee, error) {
-	var ws []Employee
-	if err := r.file.Read(&ws); err != nil {
-		return Employee{}, nil
+func (r *repository) GetAll() ([]Employee, error) {
+	es := []Employee{}
+
+	rows, err := r.db.Query(selectEmployee)
+
+	if err != nil {
+		return es, err
 	}
 
-	result, found := Employee{}, false
-	for _, w := range ws {
-		if w.Id == id {
-			result, found = w, true
-			break
+	defer rows.Close()
+
+	for rows.Next() {
+		e, err := scanEmployee(rows)
+
+		if err != nil {
+			return es, err
 		}
+
+		es = append(es, e)
 	}
 
-	if !found {
+	return es, rows.Err()
+}
+
+func (r *repository) GetById(id int) (Employee, error) {
+	e, err := scanEmployee(r.db.QueryRow(selectEmployee+" WHERE id=?", id))
+
+	if errors.Is(err, sql.ErrNoRows) {
 		return Employee{}, &NoElementInFileError{errors.New("can't find element with this id")}
 	}
 
-	return result, nil
+	if err != nil {
+		return Employee{}, err
+	}
+
+	return e, nil
 }
 
-func (r *repository) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error) {
-	var es []Employee
-	if err := r.file.Read(&es); err != nil {
-		return Employee{}, nil
-	}
-
-	result, updated := Employee{}, false
-	for i, e := range es {
-		if e.Id == id {
-			es[i], updated = Employee{
-				Id:             id,
-				Card_number_id: cardNumberId,
-				First_name:     firstName,
-				Last_name:      lastName,
-				Warehouse_id:   wareHouseId,
-			}, true
-			result = es[i]
-			break
-		}
+func (r *repository) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (Employee, error) {
+	if _, err := r.GetById(id); err != nil {
+		return Employee{}, err
 	}
 
-	if !updated {
-		return Employee{}, &NoElementInFileError{errors.New("can't find element with this id")}
-	}
+	_, err := r.db.Exec(
+		"UPDATE employee SET id_card_number=?, first_name=?, last_name=?, warehouse_id=?, role=?, supervisor_id=? WHERE id=?",
+		cardNumberId, firstName, lastName, wareHouseId, role, nullableId(supervisorId), id,
+	)
 
-	if err := r.file.Write(es); err != nil {
+	if err != nil {
 		return Employee{}, err
 	}
 
-	return result, nil
+	return Employee{
+		Id:             id,
+		Card_number_id: cardNumberId,
+		First_name:     firstName,
+		Last_name:      lastName,
+		Warehouse_id:   wareHouseId,
+		Role:           role,
+		Supervisor_id:  supervisorId,
+	}, nil
 }
 
 func (r *repository) DeleteById(id int) error {
-	var es []Employee
-	if err := r.file.Read(&es); err != nil {
-		return nil
-	}
-
-	deleted := false
-	for i, w := range es {
-		if w.Id == id {
-			newEs := []Employee{}
-			newEs = append(newEs, es[:i]...)
-			newEs = append(newEs, es[i+1:]...)
-			es = newEs
-			deleted = true
-			break
-		}
-	}
+	res, err := r.db.Exec("DELETE FROM employee WHERE id=?", id)
 
-	if !deleted {
-		return &NoElementInFileError{errors.New("can't find element with this id")}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1451 {
+		return &BusinessRuleError{errors.New("employee has inbound orders or picked order lines")}
 	}
 
-	if err := r.file.Write(es); err != nil {
+	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	affected, err := res.RowsAffected()
 
-func (r *repository) lastId() (int, error) {
-	var es []Employee
-	if err := r.file.Read(&es); err != nil {
-		return 0, err
+	if err != nil {
+		return err
 	}
 
-	if len(es) == 0 {
-		return 0, nil
+	if affected == 0 {
+		return &NoElementInFileError{errors.New("can't find element with this id")}
 	}
 
-	return es[len(es)-1].Id, nil
+	return nil
 }
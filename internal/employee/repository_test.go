@@ -0,0 +1,226 @@
+package employee_test
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee"
+	"github.com/stretchr/testify/assert"
+)
+
+var employeeColumns = []string{"id", "id_card_number", "first_name", "last_name", "warehouse_id", "role", "supervisor_id"}
+
+func TestRepositoryGetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateRepository(db)
+
+	t.Run("Should return the employee", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM employee WHERE id=?")).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(employeeColumns).AddRow(1, "123", "valid_name", "valid_last_name", 1, "picker", 2))
+
+		e, err := r.GetById(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, employee.Employee{Id: 1, Card_number_id: 123, First_name: "valid_name", Last_name: "valid_last_name", Warehouse_id: 1, Role: "picker", Supervisor_id: 2}, e)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return NoElementInFileError if the employee doesn't exist", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM employee WHERE id=?")).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		_, err := r.GetById(2)
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateRepository(db)
+
+	t.Run("Should store an employee without supervisor as NULL", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO employee (")).
+			WithArgs(123, "valid_name", "valid_last_name", 1, "picker", nil).
+			WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO employee_assignment")).WithArgs(3, 1, "2022-05-01").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		e, err := r.Create(123, "valid_name", "valid_last_name", 1, "picker", 0, "2022-05-01")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, e.Id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should roll back the employee if its first assignment can't be stored", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO employee (")).WillReturnResult(sqlmock.NewResult(4, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO employee_assignment")).WithArgs(4, 1, "2022-05-01").WillReturnError(errors.New("any_error"))
+		mock.ExpectRollback()
+
+		_, err := r.Create(123, "valid_name", "valid_last_name", 1, "picker", 0, "2022-05-01")
+
+		assert.EqualError(t, err, "any_error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryDeleteById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateRepository(db)
+
+	t.Run("Should return NoElementInFileError if the employee doesn't exist", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM employee WHERE id=?")).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := r.DeleteById(1)
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return BusinessRuleError if other records reference the employee", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM employee WHERE id=?")).WithArgs(1).WillReturnError(&mysql.MySQLError{Number: 1451})
+
+		err := r.DeleteById(1)
+
+		var be *employee.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAssignmentRepositoryGetByEmployeeId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateAssignmentRepository(db)
+
+	t.Run("Should return the employee assignments", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM employee_assignment WHERE employee_id=?")).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "warehouse_id", "start_date", "end_date"}).
+				AddRow(1, 1, 1, "2022-01-01", "2022-05-01").
+				AddRow(2, 1, 2, "2022-05-01", nil))
+
+		as, err := r.GetByEmployeeId(1)
+
+		end := "2022-05-01"
+		assert.NoError(t, err)
+		assert.Equal(t, []employee.Assignment{
+			{Id: 1, Employee_id: 1, Warehouse_id: 1, Start_date: "2022-01-01", End_date: &end},
+			{Id: 2, Employee_id: 1, Warehouse_id: 2, Start_date: "2022-05-01"},
+		}, as)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWarehouseRepositoryGetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateWarehouseRepository(db)
+
+	t.Run("Should return NoElementInFileError if the warehouse doesn't exist or was deleted", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM warehouse WHERE id=? AND deleted_at IS NULL")).WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := r.GetById(1)
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestShiftRepositoryGetByWarehouseId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateShiftRepository(db)
+
+	t.Run("Should return the warehouse shifts", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM shift WHERE warehouse_id=?")).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_id", "name", "start_time", "end_time", "minimum_staff"}).
+				AddRow(1, 1, "night", "22:00", "06:00", 2))
+
+		ss, err := r.GetByWarehouseId(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []employee.Shift{{Id: 1, Warehouse_id: 1, Name: "night", Start_time: "22:00", End_time: "06:00", Minimum_staff: 2}}, ss)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return NoElementInFileError if the shift doesn't exist", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM shift WHERE id=?")).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		_, err := r.GetById(2)
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestShiftAssignmentRepositoryGetByDate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateShiftAssignmentRepository(db)
+
+	t.Run("Should return the assignments of the day", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM shift_assignment WHERE date=?")).WithArgs("2022-05-01").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "shift_id", "employee_id", "date"}).AddRow(1, 1, 2, "2022-05-01"))
+
+		as, err := r.GetByDate("2022-05-01")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []employee.ShiftAssignment{{Id: 1, Shift_id: 1, Employee_id: 2, Date: "2022-05-01"}}, as)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAssignmentRepositoryTransfer(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := employee.CreateAssignmentRepository(db)
+
+	t.Run("Should move the employee and its assignments in one transaction", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE employee_assignment SET end_date=?")).WithArgs("2022-05-01", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO employee_assignment")).WithArgs(1, 2, "2022-05-01").WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE employee SET warehouse_id=?")).WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		a, err := r.Transfer(1, 2, "2022-05-01")
+
+		assert.NoError(t, err)
+		assert.Equal(t, employee.Assignment{Id: 2, Employee_id: 1, Warehouse_id: 2, Start_date: "2022-05-01"}, a)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should roll back the closed assignment if the employee can't be moved", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE employee_assignment SET end_date=?")).WithArgs("2022-05-01", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO employee_assignment")).WithArgs(1, 2, "2022-05-01").WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE employee SET warehouse_id=?")).WithArgs(2, 1).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, err := r.Transfer(1, 2, "2022-05-01")
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -2,29 +2,96 @@ package employee
 
 import (
 	"errors"
+	"time"
 )
 
 type EmployeeServiceInterface interface {
-	Create(cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error)
+	Create(cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (Employee, error)
 	GetAll() ([]Employee, error)
 	GetById(id int) (Employee, error)
-	UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error)
+	UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (Employee, error)
 	DeleteById(id int) error
+	Transfer(id int, wareHouseId int, date string) (Employee, error)
+	GetAssignments(id int) ([]Assignment, error)
 }
 
 type service struct {
-	repository    employeeInterface
-	wareHouseRepo WareHouseRepository
+	repository     employeeInterface
+	wareHouseRepo  WareHouseRepository
+	assignmentRepo AssignmentRepository
 }
 
-func CreateService(r employeeInterface, w WareHouseRepository) EmployeeServiceInterface {
+func CreateService(r employeeInterface, w WareHouseRepository, a AssignmentRepository) EmployeeServiceInterface {
 	return &service{
-		repository:    r,
-		wareHouseRepo: w,
+		repository:     r,
+		wareHouseRepo:  w,
+		assignmentRepo: a,
 	}
 }
 
-func (s *service) Create(cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error) {
+func today() string {
+	return time.Now().Format("2006-01-02")
+}
+
+// checkSupervisor validates that supervisorId, when informed, is another
+// employee allowed to supervise and that it doesn't report to employee id.
+func (s *service) checkSupervisor(id int, supervisorId int) error {
+	if supervisorId == 0 {
+		return nil
+	}
+
+	if supervisorId == id {
+		return &BusinessRuleError{errors.New("an employee can't supervise itself")}
+	}
+
+	supervisor, err := s.repository.GetById(supervisorId)
+
+	if err != nil {
+		return &BusinessRuleError{errors.New("supervisor not found")}
+	}
+
+	if !CanSupervise(supervisor.Role) {
+		return &BusinessRuleError{errors.New("supervisor must have the supervisor or manager role")}
+	}
+
+	visited := map[int]bool{supervisorId: true}
+
+	for next := supervisor.Supervisor_id; next != 0 && !visited[next]; {
+		if next == id {
+			return &BusinessRuleError{errors.New("supervisor can't report to the employee")}
+		}
+
+		visited[next] = true
+
+		e, err := s.repository.GetById(next)
+
+		if err != nil {
+			return err
+		}
+
+		next = e.Supervisor_id
+	}
+
+	return nil
+}
+
+func (s *service) hasSubordinates(id int) (bool, error) {
+	es, err := s.repository.GetAll()
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, e := range es {
+		if e.Supervisor_id == id {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *service) Create(cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (Employee, error) {
 
 	_, errCardNumberId := s.repository.GetByCardNumberId(cardNumberId)
 	_, wareHouseErr := s.wareHouseRepo.GetById(wareHouseId)
@@ -36,13 +103,15 @@ func (s *service) Create(cardNumberId int, firstName string, lastName string, wa
 		return Employee{}, errors.New("This WareHouse Id does not Exist, check the wareHuse list to get an id, or create a new WareHouse!")
 	}
 
-	employee, err := s.repository.Create(cardNumberId, firstName, lastName, wareHouseId)
-
-	if err != nil {
+	if err := s.checkSupervisor(0, supervisorId); err != nil {
 		return Employee{}, err
 	}
 
-	return employee, nil
+	if role == "" {
+		role = RolePicker
+	}
+
+	return s.repository.Create(cardNumberId, firstName, lastName, wareHouseId, role, supervisorId, today())
 }
 
 func (s *service) GetAll() ([]Employee, error) {
@@ -65,7 +134,7 @@ func (s *service) GetById(id int) (Employee, error) {
 	return e, nil
 }
 
-func (s *service) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int) (Employee, error) {
+func (s *service) UpdateById(id int, cardNumberId int, firstName string, lastName string, wareHouseId int, role string, supervisorId int) (Employee, error) {
 	isCardNumberInUse, err := s.repository.GetByCardNumberId(cardNumberId)
 
 	if err == nil {
@@ -74,7 +143,37 @@ func (s *service) UpdateById(id int, cardNumberId int, firstName string, lastNam
 		}
 	}
 
-	e, err := s.repository.UpdateById(id, cardNumberId, firstName, lastName, wareHouseId)
+	current, err := s.repository.GetById(id)
+
+	if err != nil {
+		return Employee{}, err
+	}
+
+	if current.Warehouse_id != wareHouseId {
+		return Employee{}, ErrTransferRequired
+	}
+
+	if role == "" {
+		role = current.Role
+	}
+
+	if err := s.checkSupervisor(id, supervisorId); err != nil {
+		return Employee{}, err
+	}
+
+	if CanSupervise(current.Role) && !CanSupervise(role) {
+		supervises, err := s.hasSubordinates(id)
+
+		if err != nil {
+			return Employee{}, err
+		}
+
+		if supervises {
+			return Employee{}, &BusinessRuleError{errors.New("employee supervises other employees and must keep a supervisor or manager role")}
+		}
+	}
+
+	e, err := s.repository.UpdateById(id, cardNumberId, firstName, lastName, wareHouseId, role, supervisorId)
 
 	if err != nil {
 		return Employee{}, err
@@ -84,7 +183,17 @@ func (s *service) UpdateById(id int, cardNumberId int, firstName string, lastNam
 }
 
 func (s *service) DeleteById(id int) error {
-	err := s.repository.DeleteById(id)
+	supervises, err := s.hasSubordinates(id)
+
+	if err != nil {
+		return err
+	}
+
+	if supervises {
+		return &BusinessRuleError{errors.New("employee supervises other employees")}
+	}
+
+	err = s.repository.DeleteById(id)
 
 	if err != nil {
 		return err
@@ -92,3 +201,53 @@ func (s *service) DeleteById(id int) error {
 
 	return nil
 }
+
+// Transfer moves the employee to another warehouse from date on, closing the
+// current assignment and opening a new one. An empty date means today.
+func (s *service) Transfer(id int, wareHouseId int, date string) (Employee, error) {
+	e, err := s.repository.GetById(id)
+
+	if err != nil {
+		return Employee{}, err
+	}
+
+	if e.Warehouse_id == wareHouseId {
+		return Employee{}, &BusinessRuleError{errors.New("employee is already assigned to this warehouse")}
+	}
+
+	if _, err := s.wareHouseRepo.GetById(wareHouseId); err != nil {
+		return Employee{}, &BusinessRuleError{errors.New("warehouse not found")}
+	}
+
+	if date == "" {
+		date = today()
+	}
+
+	assignments, err := s.assignmentRepo.GetByEmployeeId(id)
+
+	if err != nil {
+		return Employee{}, err
+	}
+
+	for _, a := range assignments {
+		if a.End_date == nil && date < a.Start_date {
+			return Employee{}, &BusinessRuleError{errors.New("transfer date can't be before the current assignment start")}
+		}
+	}
+
+	if _, err := s.assignmentRepo.Transfer(id, wareHouseId, date); err != nil {
+		return Employee{}, err
+	}
+
+	e.Warehouse_id = wareHouseId
+
+	return e, nil
+}
+
+func (s *service) GetAssignments(id int) ([]Assignment, error) {
+	if _, err := s.repository.GetById(id); err != nil {
+		return []Assignment{}, err
+	}
+
+	return s.assignmentRepo.GetByEmployeeId(id)
+}
@@ -10,12 +10,12 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-func makeCreateParams() (int, string, string, int) {
-	return 123, "valid_name", "valid_last_name", 1
+func makeCreateParams() (int, string, string, int, string, int) {
+	return 123, "valid_name", "valid_last_name", 1, "picker", 0
 }
 
-func makeUpdateByIdParams() (int, int, string, string, int) {
-	return 1, 1234, "valid_name", "valid_last_name", 1
+func makeUpdateByIdParams() (int, int, string, string, int, string, int) {
+	return 1, 1234, "valid_name", "valid_last_name", 1, "picker", 0
 }
 
 func makeEmployee() employee.Employee {
@@ -25,6 +25,7 @@ func makeEmployee() employee.Employee {
 		First_name:     "valid_name",
 		Last_name:      "valid_last_name",
 		Warehouse_id:   1,
+		Role:           "picker",
 	}
 }
 
@@ -35,6 +36,7 @@ func makeUpdatedEmployee() employee.Employee {
 		First_name:     "valid_name",
 		Last_name:      "valid_last_name",
 		Warehouse_id:   1,
+		Role:           "picker",
 	}
 }
 
@@ -52,7 +54,8 @@ func makeEmployeeWareHouse() employee.Warehouse {
 func TestCreate(t *testing.T) {
 	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
 	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
-	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository)
+	mockAssignmentRepository := mocks.NewAssignmentRepository(t)
+	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository, mockAssignmentRepository)
 
 	t.Run("Should call GetByCardNumberId from Employee Repository with correct CardNumberId", func(t *testing.T) {
 		mockEmployeeRepository.
@@ -60,7 +63,7 @@ func TestCreate(t *testing.T) {
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
 		mockEmployeeRepository.
-			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string")).
 			Return(makeEmployee(), nil).
 			Once()
 		mockWarehouseRepository.
@@ -91,7 +94,7 @@ func TestCreate(t *testing.T) {
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
 		mockEmployeeRepository.
-			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string")).
 			Return(makeEmployee(), nil).Once()
 		mockWarehouseRepository.
 			On("GetById", mock.AnythingOfType("int")).
@@ -100,7 +103,7 @@ func TestCreate(t *testing.T) {
 
 		sut.Create(makeCreateParams())
 
-		mockEmployeeRepository.AssertCalled(t, "Create", 123, "valid_name", "valid_last_name", 1)
+		mockEmployeeRepository.AssertCalled(t, "Create", 123, "valid_name", "valid_last_name", 1, "picker", 0, mock.AnythingOfType("string"))
 	})
 
 	t.Run("Should return error if Create from Employee Repository returns an error", func(t *testing.T) {
@@ -109,7 +112,7 @@ func TestCreate(t *testing.T) {
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
 		mockEmployeeRepository.
-			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string")).
 			Return(employee.Employee{}, errors.New("any_error")).Once()
 		mockWarehouseRepository.On("GetById", mock.AnythingOfType("int")).
 			Return(makeEmployeeWareHouse(), nil).
@@ -125,7 +128,7 @@ func TestCreate(t *testing.T) {
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
 		mockEmployeeRepository.
-			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("Create", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string")).
 			Return(makeEmployee(), nil).
 			Once()
 		mockWarehouseRepository.
@@ -138,12 +141,44 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, makeEmployee(), w)
 		assert.Nil(t, err)
 	})
+
+	t.Run("Should default an empty role to picker", func(t *testing.T) {
+		mockEmployeeRepository.On("GetByCardNumberId", 123).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+		mockWarehouseRepository.On("GetById", 1).Return(makeEmployeeWareHouse(), nil).Once()
+		mockEmployeeRepository.On("Create", 123, "valid_name", "valid_last_name", 1, employee.RolePicker, 0, mock.AnythingOfType("string")).Return(makeEmployee(), nil).Once()
+
+		_, err := sut.Create(123, "valid_name", "valid_last_name", 1, "", 0)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return error if supervisor does not exist", func(t *testing.T) {
+		mockEmployeeRepository.On("GetByCardNumberId", 123).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+		mockWarehouseRepository.On("GetById", 1).Return(makeEmployeeWareHouse(), nil).Once()
+		mockEmployeeRepository.On("GetById", 9).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+
+		_, err := sut.Create(123, "valid_name", "valid_last_name", 1, "picker", 9)
+
+		assert.EqualError(t, err, "supervisor not found")
+	})
+
+	t.Run("Should return error if supervisor can't supervise", func(t *testing.T) {
+		mockEmployeeRepository.On("GetByCardNumberId", 123).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+		mockWarehouseRepository.On("GetById", 1).Return(makeEmployeeWareHouse(), nil).Once()
+		mockEmployeeRepository.On("GetById", 9).Return(employee.Employee{Id: 9, Role: "receiver"}, nil).Once()
+
+		_, err := sut.Create(123, "valid_name", "valid_last_name", 1, "picker", 9)
+
+		var be *employee.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, "supervisor must have the supervisor or manager role")
+	})
 }
 
 func TestGetAll(t *testing.T) {
 	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
 	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
-	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository)
+	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository, mocks.NewAssignmentRepository(t))
 
 	t.Run("Should call GetAll from Employee Repository", func(t *testing.T) {
 		mockEmployeeRepository.
@@ -183,7 +218,7 @@ func TestGetAll(t *testing.T) {
 func TestGetById(t *testing.T) {
 	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
 	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
-	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository)
+	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository, mocks.NewAssignmentRepository(t))
 
 	t.Run("Should call GetById from Employee Repository with correct id", func(t *testing.T) {
 		mockEmployeeRepository.
@@ -223,15 +258,16 @@ func TestGetById(t *testing.T) {
 func TestUpdateById(t *testing.T) {
 	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
 	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
-	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository)
+	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository, mocks.NewAssignmentRepository(t))
 
 	t.Run("Should call GetByCardNumberId from Employee Repository with correct CardNumberId", func(t *testing.T) {
 		mockEmployeeRepository.
 			On("GetByCardNumberId", mock.AnythingOfType("int")).
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
 		mockEmployeeRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
 			Return(makeUpdatedEmployee(), nil).
 			Once()
 
@@ -255,13 +291,14 @@ func TestUpdateById(t *testing.T) {
 			On("GetByCardNumberId", mock.AnythingOfType("int")).
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
 		mockEmployeeRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
 			Return(makeUpdatedEmployee(), nil).Once()
 
 		sut.UpdateById(makeUpdateByIdParams())
 
-		mockEmployeeRepository.AssertCalled(t, "UpdateById", 1, 1234, "valid_name", "valid_last_name", 1)
+		mockEmployeeRepository.AssertCalled(t, "UpdateById", 1, 1234, "valid_name", "valid_last_name", 1, "picker", 0)
 	})
 
 	t.Run("Should return error if UpdateById from Employee Repository returns an error", func(t *testing.T) {
@@ -269,8 +306,9 @@ func TestUpdateById(t *testing.T) {
 			On("GetByCardNumberId", mock.AnythingOfType("int")).
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
 		mockEmployeeRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
 			Return(employee.Employee{}, errors.New("any_error")).Once()
 		_, err := sut.UpdateById(makeUpdateByIdParams())
 
@@ -282,8 +320,9 @@ func TestUpdateById(t *testing.T) {
 			On("GetByCardNumberId", mock.AnythingOfType("int")).
 			Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).
 			Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
 		mockEmployeeRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).
 			Return(makeUpdatedEmployee(), nil).
 			Once()
 
@@ -292,14 +331,60 @@ func TestUpdateById(t *testing.T) {
 		assert.Equal(t, makeUpdatedEmployee(), w)
 		assert.Nil(t, err)
 	})
+
+	t.Run("Should keep the current role if none is provided", func(t *testing.T) {
+		supervisor := makeEmployee()
+		supervisor.Role = "supervisor"
+		mockEmployeeRepository.On("GetByCardNumberId", 1234).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+		mockEmployeeRepository.On("GetById", 1).Return(supervisor, nil).Once()
+		mockEmployeeRepository.On("UpdateById", 1, 1234, "valid_name", "valid_last_name", 1, "supervisor", 0).Return(makeUpdatedEmployee(), nil).Once()
+
+		_, err := sut.UpdateById(1, 1234, "valid_name", "valid_last_name", 1, "", 0)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return error if the warehouse changes", func(t *testing.T) {
+		mockEmployeeRepository.On("GetByCardNumberId", 1234).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+
+		_, err := sut.UpdateById(1, 1234, "valid_name", "valid_last_name", 2, "picker", 0)
+
+		assert.ErrorIs(t, err, employee.ErrTransferRequired)
+	})
+
+	t.Run("Should return error if supervisor reports to the employee", func(t *testing.T) {
+		mockEmployeeRepository.On("GetByCardNumberId", 1234).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockEmployeeRepository.On("GetById", 2).Return(employee.Employee{Id: 2, Role: "supervisor", Supervisor_id: 3}, nil).Once()
+		mockEmployeeRepository.On("GetById", 3).Return(employee.Employee{Id: 3, Role: "manager", Supervisor_id: 1}, nil).Once()
+
+		_, err := sut.UpdateById(1, 1234, "valid_name", "valid_last_name", 1, "manager", 2)
+
+		assert.EqualError(t, err, "supervisor can't report to the employee")
+	})
+
+	t.Run("Should return error if a supervisor with subordinates loses the role", func(t *testing.T) {
+		supervisor := makeEmployee()
+		supervisor.Role = "supervisor"
+		mockEmployeeRepository.On("GetByCardNumberId", 1234).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+		mockEmployeeRepository.On("GetById", 1).Return(supervisor, nil).Once()
+		mockEmployeeRepository.On("GetAll").Return([]employee.Employee{supervisor, {Id: 2, Role: "picker", Supervisor_id: 1}}, nil).Once()
+
+		_, err := sut.UpdateById(makeUpdateByIdParams())
+
+		var be *employee.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+	})
 }
 
 func TestDeleteById(t *testing.T) {
 	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
 	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
-	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository)
+	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository, mocks.NewAssignmentRepository(t))
 
 	t.Run("Should call DeleteById from Employee Repository with correct id", func(t *testing.T) {
+		mockEmployeeRepository.On("GetAll").Return([]employee.Employee{makeEmployee()}, nil).Once()
 		mockEmployeeRepository.
 			On("DeleteById", mock.AnythingOfType("int")).
 			Return(nil).
@@ -311,6 +396,7 @@ func TestDeleteById(t *testing.T) {
 	})
 
 	t.Run("Should return an error if DeleteById from Employee Repository returns an error", func(t *testing.T) {
+		mockEmployeeRepository.On("GetAll").Return([]employee.Employee{makeEmployee()}, nil).Once()
 		mockEmployeeRepository.
 			On("DeleteById", mock.AnythingOfType("int")).
 			Return(errors.New("any_error")).
@@ -322,6 +408,7 @@ func TestDeleteById(t *testing.T) {
 	})
 
 	t.Run("Should return nil on success", func(t *testing.T) {
+		mockEmployeeRepository.On("GetAll").Return([]employee.Employee{makeEmployee()}, nil).Once()
 		mockEmployeeRepository.
 			On("DeleteById", mock.AnythingOfType("int")).
 			Return(nil).
@@ -331,4 +418,111 @@ func TestDeleteById(t *testing.T) {
 
 		assert.Nil(t, err)
 	})
+
+	t.Run("Should return error if the employee supervises others", func(t *testing.T) {
+		mockEmployeeRepository.On("GetAll").Return([]employee.Employee{{Id: 2, Supervisor_id: 1}}, nil).Once()
+
+		err := sut.DeleteById(1)
+
+		var be *employee.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+	})
+}
+
+func TestTransfer(t *testing.T) {
+	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
+	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
+	mockAssignmentRepository := mocks.NewAssignmentRepository(t)
+	sut := employee.CreateService(mockEmployeeRepository, mockWarehouseRepository, mockAssignmentRepository)
+
+	t.Run("Should return error if the employee does not exist", func(t *testing.T) {
+		mockEmployeeRepository.On("GetById", 1).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+
+		_, err := sut.Transfer(1, 2, "2022-05-01")
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+	})
+
+	t.Run("Should return error if the employee is already in the warehouse", func(t *testing.T) {
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+
+		_, err := sut.Transfer(1, 1, "2022-05-01")
+
+		assert.EqualError(t, err, "employee is already assigned to this warehouse")
+	})
+
+	t.Run("Should return error if the warehouse does not exist", func(t *testing.T) {
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockWarehouseRepository.On("GetById", 2).Return(employee.Warehouse{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+
+		_, err := sut.Transfer(1, 2, "2022-05-01")
+
+		assert.EqualError(t, err, "warehouse not found")
+	})
+
+	t.Run("Should return error if the date is before the current assignment", func(t *testing.T) {
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockWarehouseRepository.On("GetById", 2).Return(makeEmployeeWareHouse(), nil).Once()
+		mockAssignmentRepository.On("GetByEmployeeId", 1).Return([]employee.Assignment{{Id: 1, Employee_id: 1, Warehouse_id: 1, Start_date: "2022-06-01"}}, nil).Once()
+
+		_, err := sut.Transfer(1, 2, "2022-05-01")
+
+		assert.EqualError(t, err, "transfer date can't be before the current assignment start")
+	})
+
+	t.Run("Should return error and keep the employee if the transfer fails", func(t *testing.T) {
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockWarehouseRepository.On("GetById", 2).Return(makeEmployeeWareHouse(), nil).Once()
+		mockAssignmentRepository.On("GetByEmployeeId", 1).Return([]employee.Assignment{{Id: 1, Employee_id: 1, Warehouse_id: 1, Start_date: "2022-01-01"}}, nil).Once()
+		mockAssignmentRepository.On("Transfer", 1, 2, "2022-05-01").Return(employee.Assignment{}, errors.New("any_error")).Once()
+
+		_, err := sut.Transfer(1, 2, "2022-05-01")
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should close the current assignment and open a new one on success", func(t *testing.T) {
+		transferred := makeEmployee()
+		transferred.Warehouse_id = 2
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockWarehouseRepository.On("GetById", 2).Return(makeEmployeeWareHouse(), nil).Once()
+		mockAssignmentRepository.On("GetByEmployeeId", 1).Return([]employee.Assignment{{Id: 1, Employee_id: 1, Warehouse_id: 1, Start_date: "2022-01-01"}}, nil).Once()
+		mockAssignmentRepository.On("Transfer", 1, 2, "2022-05-01").Return(employee.Assignment{Id: 2}, nil).Once()
+
+		e, err := sut.Transfer(1, 2, "2022-05-01")
+
+		assert.Equal(t, transferred, e)
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetAssignments(t *testing.T) {
+	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
+	mockAssignmentRepository := mocks.NewAssignmentRepository(t)
+	sut := employee.CreateService(mockEmployeeRepository, mocks.NewWareHouseRepository(t), mockAssignmentRepository)
+
+	t.Run("Should return error if the employee does not exist", func(t *testing.T) {
+		mockEmployeeRepository.On("GetById", 1).Return(employee.Employee{}, &employee.NoElementInFileError{errors.New("can't find element with this id")}).Once()
+
+		_, err := sut.GetAssignments(1)
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+	})
+
+	t.Run("Should return the assignment history on success", func(t *testing.T) {
+		end := "2022-05-01"
+		history := []employee.Assignment{
+			{Id: 1, Employee_id: 1, Warehouse_id: 1, Start_date: "2022-01-01", End_date: &end},
+			{Id: 2, Employee_id: 1, Warehouse_id: 2, Start_date: "2022-05-01"},
+		}
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockAssignmentRepository.On("GetByEmployeeId", 1).Return(history, nil).Once()
+
+		result, err := sut.GetAssignments(1)
+
+		assert.Equal(t, history, result)
+		assert.Nil(t, err)
+	})
 }
@@ -0,0 +1,74 @@
+package employee
+
+import (
+	"database/sql"
+)
+
+type ShiftAssignmentRepository interface {
+	Create(shiftId int, employeeId int, date string) (ShiftAssignment, error)
+	GetByEmployeeId(employeeId int) ([]ShiftAssignment, error)
+	GetByDate(date string) ([]ShiftAssignment, error)
+}
+
+type shiftAssignmentRepository struct {
+	db *sql.DB
+}
+
+func CreateShiftAssignmentRepository(db *sql.DB) ShiftAssignmentRepository {
+	return &shiftAssignmentRepository{
+		db: db,
+	}
+}
+
+func (r *shiftAssignmentRepository) Create(shiftId int, employeeId int, date string) (ShiftAssignment, error) {
+	res, err := r.db.Exec("INSERT INTO shift_assignment (shift_id, employee_id, date) VALUES (?, ?, ?)", shiftId, employeeId, date)
+
+	if err != nil {
+		return ShiftAssignment{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return ShiftAssignment{}, err
+	}
+
+	return ShiftAssignment{
+		Id:          int(id),
+		Shift_id:    shiftId,
+		Employee_id: employeeId,
+		Date:        date,
+	}, nil
+}
+
+func (r *shiftAssignmentRepository) GetByEmployeeId(employeeId int) ([]ShiftAssignment, error) {
+	return r.query("WHERE employee_id=?", employeeId)
+}
+
+func (r *shiftAssignmentRepository) GetByDate(date string) ([]ShiftAssignment, error) {
+	return r.query("WHERE date=?", date)
+}
+
+func (r *shiftAssignmentRepository) query(where string, arg interface{}) ([]ShiftAssignment, error) {
+	as := []ShiftAssignment{}
+
+	rows, err := r.db.Query("SELECT id, shift_id, employee_id, DATE_FORMAT(date, '%Y-%m-%d') FROM shift_assignment "+where+" ORDER BY id", arg)
+
+	if err != nil {
+		return as, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var a ShiftAssignment
+
+		if err := rows.Scan(&a.Id, &a.Shift_id, &a.Employee_id, &a.Date); err != nil {
+			return as, err
+		}
+
+		as = append(as, a)
+	}
+
+	return as, rows.Err()
+}
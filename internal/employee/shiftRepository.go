@@ -0,0 +1,96 @@
+package employee
+
+import (
+	"database/sql"
+	"errors"
+)
+
+type ShiftRepository interface {
+	Create(wareHouseId int, name string, startTime string, endTime string, minimumStaff int) (Shift, error)
+	GetById(id int) (Shift, error)
+	GetByWarehouseId(wareHouseId int) ([]Shift, error)
+}
+
+type shiftRepository struct {
+	db *sql.DB
+}
+
+func CreateShiftRepository(db *sql.DB) ShiftRepository {
+	return &shiftRepository{
+		db: db,
+	}
+}
+
+const selectShift = "SELECT id, warehouse_id, name, TIME_FORMAT(start_time, '%H:%i'), TIME_FORMAT(end_time, '%H:%i'), minimum_staff FROM shift"
+
+func scanShift(row scanner) (Shift, error) {
+	var s Shift
+
+	err := row.Scan(&s.Id, &s.Warehouse_id, &s.Name, &s.Start_time, &s.End_time, &s.Minimum_staff)
+
+	return s, err
+}
+
+func (r *shiftRepository) Create(wareHouseId int, name string, startTime string, endTime string, minimumStaff int) (Shift, error) {
+	res, err := r.db.Exec(
+		"INSERT INTO shift (warehouse_id, name, start_time, end_time, minimum_staff) VALUES (?, ?, ?, ?, ?)",
+		wareHouseId, name, startTime, endTime, minimumStaff,
+	)
+
+	if err != nil {
+		return Shift{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return Shift{}, err
+	}
+
+	return Shift{
+		Id:            int(id),
+		Warehouse_id:  wareHouseId,
+		Name:          name,
+		Start_time:    startTime,
+		End_time:      endTime,
+		Minimum_staff: minimumStaff,
+	}, nil
+}
+
+func (r *shiftRepository) GetById(id int) (Shift, error) {
+	s, err := scanShift(r.db.QueryRow(selectShift+" WHERE id=?", id))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return Shift{}, &NoElementInFileError{errors.New("can't find shift with this id")}
+	}
+
+	if err != nil {
+		return Shift{}, err
+	}
+
+	return s, nil
+}
+
+func (r *shiftRepository) GetByWarehouseId(wareHouseId int) ([]Shift, error) {
+	ss := []Shift{}
+
+	rows, err := r.db.Query(selectShift+" WHERE warehouse_id=? ORDER BY start_time, id", wareHouseId)
+
+	if err != nil {
+		return ss, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		s, err := scanShift(rows)
+
+		if err != nil {
+			return ss, err
+		}
+
+		ss = append(ss, s)
+	}
+
+	return ss, rows.Err()
+}
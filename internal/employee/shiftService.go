@@ -0,0 +1,183 @@
+package employee
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+type ShiftServiceInterface interface {
+	Create(wareHouseId int, name string, startTime string, endTime string, minimumStaff int) (Shift, error)
+	GetByWarehouseId(wareHouseId int) ([]Shift, error)
+	Assign(shiftId int, employeeId int, date string) (ShiftAssignment, error)
+	Roster(wareHouseId int, date string) ([]RosterShift, error)
+}
+
+type shiftService struct {
+	shiftRepo      ShiftRepository
+	assignmentRepo ShiftAssignmentRepository
+	employeeRepo   employeeInterface
+	wareHouseRepo  WareHouseRepository
+}
+
+func CreateShiftService(s ShiftRepository, a ShiftAssignmentRepository, e employeeInterface, w WareHouseRepository) ShiftServiceInterface {
+	return &shiftService{
+		shiftRepo:      s,
+		assignmentRepo: a,
+		employeeRepo:   e,
+		wareHouseRepo:  w,
+	}
+}
+
+// period returns when the shift happens on date; shifts ending at or before
+// their start end on the next day.
+func period(s Shift, date string) (time.Time, time.Time, error) {
+	day, err := time.Parse("2006-01-02", date)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	start, err := time.Parse("15:04", s.Start_time)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end, err := time.Parse("15:04", s.End_time)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	from := day.Add(time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute)
+	to := day.Add(time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute)
+
+	if !to.After(from) {
+		to = to.Add(24 * time.Hour)
+	}
+
+	return from, to, nil
+}
+
+func (s *shiftService) Create(wareHouseId int, name string, startTime string, endTime string, minimumStaff int) (Shift, error) {
+	if _, err := s.wareHouseRepo.GetById(wareHouseId); err != nil {
+		return Shift{}, err
+	}
+
+	if startTime == endTime {
+		return Shift{}, &BusinessRuleError{errors.New("start_time and end_time can't be equal")}
+	}
+
+	return s.shiftRepo.Create(wareHouseId, name, startTime, endTime, minimumStaff)
+}
+
+func (s *shiftService) GetByWarehouseId(wareHouseId int) ([]Shift, error) {
+	if _, err := s.wareHouseRepo.GetById(wareHouseId); err != nil {
+		return []Shift{}, err
+	}
+
+	return s.shiftRepo.GetByWarehouseId(wareHouseId)
+}
+
+// Assign puts the employee on the shift on date, refusing employees of other
+// warehouses and assignments overlapping another shift of the employee.
+func (s *shiftService) Assign(shiftId int, employeeId int, date string) (ShiftAssignment, error) {
+	shift, err := s.shiftRepo.GetById(shiftId)
+
+	if err != nil {
+		return ShiftAssignment{}, err
+	}
+
+	e, err := s.employeeRepo.GetById(employeeId)
+
+	if err != nil {
+		return ShiftAssignment{}, err
+	}
+
+	if e.Warehouse_id != shift.Warehouse_id {
+		return ShiftAssignment{}, &BusinessRuleError{errors.New("employee does not work at the shift's warehouse")}
+	}
+
+	from, to, err := period(shift, date)
+
+	if err != nil {
+		return ShiftAssignment{}, err
+	}
+
+	assigned, err := s.assignmentRepo.GetByEmployeeId(employeeId)
+
+	if err != nil {
+		return ShiftAssignment{}, err
+	}
+
+	for _, a := range assigned {
+		other, err := s.shiftRepo.GetById(a.Shift_id)
+
+		if err != nil {
+			return ShiftAssignment{}, err
+		}
+
+		otherFrom, otherTo, err := period(other, a.Date)
+
+		if err != nil {
+			return ShiftAssignment{}, err
+		}
+
+		if from.Before(otherTo) && otherFrom.Before(to) {
+			return ShiftAssignment{}, ErrShiftOverlap
+		}
+	}
+
+	return s.assignmentRepo.Create(shiftId, employeeId, date)
+}
+
+// Roster lists the warehouse shifts starting on date, by start time, with
+// the employees on duty and whether each one is below its minimum staff.
+func (s *shiftService) Roster(wareHouseId int, date string) ([]RosterShift, error) {
+	shifts, err := s.GetByWarehouseId(wareHouseId)
+
+	if err != nil {
+		return []RosterShift{}, err
+	}
+
+	assignments, err := s.assignmentRepo.GetByDate(date)
+
+	if err != nil {
+		return []RosterShift{}, err
+	}
+
+	es, err := s.employeeRepo.GetAll()
+
+	if err != nil {
+		return []RosterShift{}, err
+	}
+
+	employees := map[int]Employee{}
+	for _, e := range es {
+		employees[e.Id] = e
+	}
+
+	sort.SliceStable(shifts, func(i, j int) bool {
+		return shifts[i].Start_time < shifts[j].Start_time
+	})
+
+	roster := []RosterShift{}
+	for _, shift := range shifts {
+		onDuty := []Employee{}
+
+		for _, a := range assignments {
+			if e, ok := employees[a.Employee_id]; ok && a.Shift_id == shift.Id {
+				onDuty = append(onDuty, e)
+			}
+		}
+
+		roster = append(roster, RosterShift{
+			Shift:        shift,
+			Employees:    onDuty,
+			Understaffed: len(onDuty) < shift.Minimum_staff,
+		})
+	}
+
+	return roster, nil
+}
@@ -0,0 +1,127 @@
+package employee_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/employee/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeShifts() []employee.Shift {
+	return []employee.Shift{
+		{Id: 1, Warehouse_id: 1, Name: "night", Start_time: "22:00", End_time: "06:00", Minimum_staff: 1},
+		{Id: 2, Warehouse_id: 1, Name: "morning", Start_time: "06:00", End_time: "14:00", Minimum_staff: 2},
+		{Id: 3, Warehouse_id: 1, Name: "early", Start_time: "05:00", End_time: "13:00", Minimum_staff: 0},
+	}
+}
+
+func TestCreateShift(t *testing.T) {
+	mockShiftRepository := mocks.NewShiftRepository(t)
+	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
+	sut := employee.CreateShiftService(mockShiftRepository, mocks.NewShiftAssignmentRepository(t), mocks.NewEmployeeRepositoryInterface(t), mockWarehouseRepository)
+
+	t.Run("Should return error if the warehouse does not exist", func(t *testing.T) {
+		mockWarehouseRepository.On("GetById", 1).Return(employee.Warehouse{}, &employee.NoElementInFileError{Err: errors.New("can't find element with this id")}).Once()
+
+		_, err := sut.Create(1, "night", "22:00", "06:00", 1)
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+	})
+
+	t.Run("Should return error if start and end are equal", func(t *testing.T) {
+		mockWarehouseRepository.On("GetById", 1).Return(makeEmployeeWareHouse(), nil).Once()
+
+		_, err := sut.Create(1, "all day", "08:00", "08:00", 1)
+
+		assert.EqualError(t, err, "start_time and end_time can't be equal")
+	})
+
+	t.Run("Should return the shift on success", func(t *testing.T) {
+		mockWarehouseRepository.On("GetById", 1).Return(makeEmployeeWareHouse(), nil).Once()
+		mockShiftRepository.On("Create", 1, "night", "22:00", "06:00", 1).Return(makeShifts()[0], nil).Once()
+
+		s, err := sut.Create(1, "night", "22:00", "06:00", 1)
+
+		assert.Equal(t, makeShifts()[0], s)
+		assert.Nil(t, err)
+	})
+}
+
+func TestAssignShift(t *testing.T) {
+	mockShiftRepository := mocks.NewShiftRepository(t)
+	mockShiftAssignmentRepository := mocks.NewShiftAssignmentRepository(t)
+	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
+	sut := employee.CreateShiftService(mockShiftRepository, mockShiftAssignmentRepository, mockEmployeeRepository, mocks.NewWareHouseRepository(t))
+	shifts := makeShifts()
+
+	t.Run("Should return error if the employee works at another warehouse", func(t *testing.T) {
+		mockShiftRepository.On("GetById", 2).Return(shifts[1], nil).Once()
+		mockEmployeeRepository.On("GetById", 1).Return(employee.Employee{Id: 1, Warehouse_id: 2}, nil).Once()
+
+		_, err := sut.Assign(2, 1, "2022-05-02")
+
+		assert.EqualError(t, err, "employee does not work at the shift's warehouse")
+	})
+
+	t.Run("Should return error if the shift overlaps an overnight shift of the day before", func(t *testing.T) {
+		mockShiftRepository.On("GetById", 3).Return(shifts[2], nil).Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockShiftAssignmentRepository.On("GetByEmployeeId", 1).Return([]employee.ShiftAssignment{{Id: 1, Shift_id: 1, Employee_id: 1, Date: "2022-05-01"}}, nil).Once()
+		mockShiftRepository.On("GetById", 1).Return(shifts[0], nil).Once()
+
+		_, err := sut.Assign(3, 1, "2022-05-02")
+
+		assert.ErrorIs(t, err, employee.ErrShiftOverlap)
+	})
+
+	t.Run("Should assign a shift starting when the previous one ends", func(t *testing.T) {
+		assignment := employee.ShiftAssignment{Id: 2, Shift_id: 2, Employee_id: 1, Date: "2022-05-02"}
+		mockShiftRepository.On("GetById", 2).Return(shifts[1], nil).Once()
+		mockEmployeeRepository.On("GetById", 1).Return(makeEmployee(), nil).Once()
+		mockShiftAssignmentRepository.On("GetByEmployeeId", 1).Return([]employee.ShiftAssignment{{Id: 1, Shift_id: 1, Employee_id: 1, Date: "2022-05-01"}}, nil).Once()
+		mockShiftRepository.On("GetById", 1).Return(shifts[0], nil).Once()
+		mockShiftAssignmentRepository.On("Create", 2, 1, "2022-05-02").Return(assignment, nil).Once()
+
+		a, err := sut.Assign(2, 1, "2022-05-02")
+
+		assert.Equal(t, assignment, a)
+		assert.Nil(t, err)
+	})
+}
+
+func TestRoster(t *testing.T) {
+	mockShiftRepository := mocks.NewShiftRepository(t)
+	mockShiftAssignmentRepository := mocks.NewShiftAssignmentRepository(t)
+	mockEmployeeRepository := mocks.NewEmployeeRepositoryInterface(t)
+	mockWarehouseRepository := mocks.NewWareHouseRepository(t)
+	sut := employee.CreateShiftService(mockShiftRepository, mockShiftAssignmentRepository, mockEmployeeRepository, mockWarehouseRepository)
+
+	t.Run("Should return error if the warehouse does not exist", func(t *testing.T) {
+		mockWarehouseRepository.On("GetById", 1).Return(employee.Warehouse{}, &employee.NoElementInFileError{Err: errors.New("can't find element with this id")}).Once()
+
+		_, err := sut.Roster(1, "2022-05-02")
+
+		var fe *employee.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+	})
+
+	t.Run("Should list shifts by start time with employees on duty and understaffing", func(t *testing.T) {
+		shifts := makeShifts()
+		picker := employee.Employee{Id: 1, First_name: "Ana", Warehouse_id: 1, Role: "picker"}
+		mockWarehouseRepository.On("GetById", 1).Return(makeEmployeeWareHouse(), nil).Once()
+		mockShiftRepository.On("GetByWarehouseId", 1).Return(makeShifts()[:2], nil).Once()
+		mockShiftAssignmentRepository.On("GetByDate", "2022-05-02").Return([]employee.ShiftAssignment{{Id: 1, Shift_id: 1, Employee_id: 1, Date: "2022-05-02"}}, nil).Once()
+		mockEmployeeRepository.On("GetAll").Return([]employee.Employee{picker}, nil).Once()
+
+		roster, err := sut.Roster(1, "2022-05-02")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []employee.RosterShift{
+			{Shift: shifts[1], Employees: []employee.Employee{}, Understaffed: true},
+			{Shift: shifts[0], Employees: []employee.Employee{picker}, Understaffed: false},
+		}, roster)
+	})
+}
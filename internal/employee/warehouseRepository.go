@@ -1,9 +1,8 @@
 package employee
 
 import (
+	"database/sql"
 	"errors"
-
-	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/store"
 )
 
 type WareHouseRepository interface {
@@ -11,27 +10,29 @@ type WareHouseRepository interface {
 }
 
 type warehouseRepository struct {
-	file store.Store
+	db *sql.DB
 }
 
-func CreateWarehouseRepository(file store.Store) WareHouseRepository {
+func CreateWarehouseRepository(db *sql.DB) WareHouseRepository {
 	return &warehouseRepository{
-		file: file,
+		db: db,
 	}
 }
 
 func (r *warehouseRepository) GetById(id int) (Warehouse, error) {
-	var ws []Warehouse
+	var w Warehouse
 
-	if err := r.file.Read(&ws); err != nil {
-		return Warehouse{}, err
+	row := r.db.QueryRow("SELECT id, warehouse_code, address, telephone, minimum_capacity, minimum_temperature FROM warehouse WHERE id=? AND deleted_at IS NULL", id)
+
+	err := row.Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return Warehouse{}, &NoElementInFileError{errors.New("can't find element with this id")}
 	}
 
-	for _, w := range ws {
-		if w.Id == id {
-			return w, nil
-		}
+	if err != nil {
+		return Warehouse{}, err
 	}
 
-	return Warehouse{}, &NoElementInFileError{errors.New("can't find element with this id")}
+	return w, nil
 }
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases"
+)
+
+type InboundOrderController struct {
+	service usecases.InboundOrderService
+}
+
+func NewInboundOrderController(s usecases.InboundOrderService) *InboundOrderController {
+	return &InboundOrderController{
+		service: s,
+	}
+}
+
+type createRequest struct {
+	Order_Date       string `json:"order_date" binding:"required"`
+	Order_Number     string `json:"order_number" binding:"required"`
+	Employee_Id      int    `json:"employee_id" binding:"required"`
+	Product_Batch_Id int    `json:"product_batch_id" binding:"required"`
+	Warehouse_Id     int    `json:"warehouse_id" binding:"required"`
+}
+
+func (c *InboundOrderController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req createRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid input. Check the data entered"})
+			return
+		}
+
+		order, err := c.service.Create(domain.InboundOrder{
+			Order_Date:       req.Order_Date,
+			Order_Number:     req.Order_Number,
+			Employee_Id:      req.Employee_Id,
+			Product_Batch_Id: req.Product_Batch_Id,
+			Warehouse_Id:     req.Warehouse_Id,
+		})
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, order)
+	}
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrEmployeeNotFound), errors.Is(err, usecases.ErrBatchNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrInvalidOrderDate), errors.Is(err, usecases.ErrEmployeeNotInWarehouse), errors.Is(err, usecases.ErrBatchNotInWarehouse):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
@@ -0,0 +1,82 @@
+package adapters_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+const validBody = `{"order_date": "2022-07-01", "order_number": "IN-1", "employee_id": 1, "product_batch_id": 2, "warehouse_id": 3}`
+
+func makeRequestedOrder() domain.InboundOrder {
+	return domain.InboundOrder{Order_Date: "2022-07-01", Order_Number: "IN-1", Employee_Id: 1, Product_Batch_Id: 2, Warehouse_Id: 3}
+}
+
+func TestCreateInboundOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockService := mocks.NewInboundOrderService(t)
+	controller := adapters.NewInboundOrderController(mockService)
+
+	r := gin.Default()
+	r.POST("/inboundOrders", controller.Create())
+
+	t.Run("Should return 400 status if the body is invalid", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/inboundOrders", bytes.NewBufferString(`{"order_date": "2022-07-01", "order_number": "IN-1"}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Should return 404 status if the employee does not exist", func(t *testing.T) {
+		mockService.On("Create", makeRequestedOrder()).Return(domain.InboundOrder{}, usecases.ErrEmployeeNotFound).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/inboundOrders", bytes.NewBufferString(validBody))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("Should return 422 status if the employee works at another warehouse", func(t *testing.T) {
+		mockService.On("Create", makeRequestedOrder()).Return(domain.InboundOrder{}, usecases.ErrEmployeeNotInWarehouse).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/inboundOrders", bytes.NewBufferString(validBody))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+		assert.Equal(t, "{\"error\":\"employee must work at the warehouse\"}", res.Body.String())
+	})
+
+	t.Run("Should return 500 status without the error details if the service fails", func(t *testing.T) {
+		mockService.On("Create", makeRequestedOrder()).Return(domain.InboundOrder{}, errors.New("connection refused")).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/inboundOrders", bytes.NewBufferString(validBody))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.Equal(t, "{\"error\":\"internal server error\"}", res.Body.String())
+	})
+
+	t.Run("Should return 201 status with the received order", func(t *testing.T) {
+		received := makeRequestedOrder()
+		received.Id = 1
+		received.Received_At = "2022-07-02 10:00:00"
+		mockService.On("Create", makeRequestedOrder()).Return(received, nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/inboundOrders", bytes.NewBufferString(validBody))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, `{"id":1,"order_date":"2022-07-01","order_number":"IN-1","employee_id":1,"product_batch_id":2,"warehouse_id":3,"received_at":"2022-07-02 10:00:00"}`, res.Body.String())
+	})
+}
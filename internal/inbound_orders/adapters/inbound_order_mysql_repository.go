@@ -0,0 +1,71 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases"
+)
+
+type inboundOrderMysqlRepository struct {
+	db *sql.DB
+}
+
+func NewInboundOrderMysqlRepository(db *sql.DB) usecases.InboundOrderRepository {
+	return &inboundOrderMysqlRepository{
+		db: db,
+	}
+}
+
+func (r *inboundOrderMysqlRepository) GetEmployeeWarehouseId(employee_id int) (int, error) {
+	warehouse_id := 0
+
+	err := r.db.QueryRow(`SELECT warehouse_id FROM employee WHERE id=?`, employee_id).Scan(&warehouse_id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, usecases.ErrEmployeeNotFound
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return warehouse_id, nil
+}
+
+func (r *inboundOrderMysqlRepository) GetBatchWarehouseId(product_batch_id int) (int, error) {
+	warehouse_id := 0
+
+	err := r.db.QueryRow(`SELECT s.warehouse_id FROM product_batch pb INNER JOIN section s ON s.id = pb.section_id WHERE pb.id=?`, product_batch_id).Scan(&warehouse_id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, usecases.ErrBatchNotFound
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return warehouse_id, nil
+}
+
+func (r *inboundOrderMysqlRepository) Create(order domain.InboundOrder) (domain.InboundOrder, error) {
+	const query = `INSERT INTO inbound_order (order_date, order_number, employee_id, product_batch_id, warehouse_id, received_at) VALUES (?, ?, ?, ?, ?, ?)`
+
+	res, err := r.db.Exec(query, order.Order_Date, order.Order_Number, order.Employee_Id, order.Product_Batch_Id, order.Warehouse_Id, order.Received_At)
+
+	if err != nil {
+		return domain.InboundOrder{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return domain.InboundOrder{}, err
+	}
+
+	order.Id = int(id)
+
+	return order, nil
+}
@@ -0,0 +1,80 @@
+package adapters_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEmployeeWarehouseId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewInboundOrderMysqlRepository(db)
+
+	t.Run("Should return ErrEmployeeNotFound if the employee does not exist", func(t *testing.T) {
+		mock.ExpectQuery("SELECT warehouse_id FROM employee").WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetEmployeeWarehouseId(1)
+
+		assert.ErrorIs(t, err, usecases.ErrEmployeeNotFound)
+	})
+
+	t.Run("Should return the employee warehouse", func(t *testing.T) {
+		mock.ExpectQuery("SELECT warehouse_id FROM employee").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow(3))
+
+		warehouse_id, err := sut.GetEmployeeWarehouseId(1)
+
+		assert.Equal(t, 3, warehouse_id)
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetBatchWarehouseId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewInboundOrderMysqlRepository(db)
+
+	t.Run("Should return ErrBatchNotFound if the batch does not exist", func(t *testing.T) {
+		mock.ExpectQuery("SELECT s.warehouse_id FROM product_batch pb").WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetBatchWarehouseId(2)
+
+		assert.ErrorIs(t, err, usecases.ErrBatchNotFound)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewInboundOrderMysqlRepository(db)
+
+	t.Run("Should store the receiving employee and time", func(t *testing.T) {
+		order := domain.InboundOrder{Order_Date: "2022-07-01", Order_Number: "IN-1", Employee_Id: 1, Product_Batch_Id: 2, Warehouse_Id: 3, Received_At: "2022-07-02 10:00:00"}
+		mock.ExpectExec("INSERT INTO inbound_order").
+			WithArgs("2022-07-01", "IN-1", 1, 2, 3, "2022-07-02 10:00:00").
+			WillReturnResult(sqlmock.NewResult(7, 1))
+
+		created, err := sut.Create(order)
+
+		order.Id = 7
+		assert.Equal(t, order, created)
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,13 @@
+package domain
+
+// InboundOrder records the receipt of a product batch at a warehouse by an
+// employee; Received_At is set when the order is registered.
+type InboundOrder struct {
+	Id               int    `json:"id"`
+	Order_Date       string `json:"order_date"`
+	Order_Number     string `json:"order_number"`
+	Employee_Id      int    `json:"employee_id"`
+	Product_Batch_Id int    `json:"product_batch_id"`
+	Warehouse_Id     int    `json:"warehouse_id"`
+	Received_At      string `json:"received_at"`
+}
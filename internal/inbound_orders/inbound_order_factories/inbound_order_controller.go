@@ -0,0 +1,15 @@
+package inbound_order_factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases"
+)
+
+func MakeInboundOrderController() *adapters.InboundOrderController {
+	ir := adapters.NewInboundOrderMysqlRepository(db.GetInstance())
+	is := usecases.NewInboundOrderService(ir)
+	ic := adapters.NewInboundOrderController(is)
+
+	return ic
+}
@@ -0,0 +1,13 @@
+package usecases
+
+import "errors"
+
+var ErrEmployeeNotFound = errors.New("employee not found")
+
+var ErrBatchNotFound = errors.New("product batch not found")
+
+var ErrInvalidOrderDate = errors.New("order_date must be a yyyy-mm-dd date not after today")
+
+var ErrEmployeeNotInWarehouse = errors.New("employee must work at the warehouse")
+
+var ErrBatchNotInWarehouse = errors.New("product batch must be stored at the warehouse")
@@ -0,0 +1,9 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/domain"
+
+type InboundOrderRepository interface {
+	GetEmployeeWarehouseId(employee_id int) (int, error)
+	GetBatchWarehouseId(product_batch_id int) (int, error)
+	Create(order domain.InboundOrder) (domain.InboundOrder, error)
+}
@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/domain"
+)
+
+type InboundOrderService interface {
+	Create(order domain.InboundOrder) (domain.InboundOrder, error)
+}
+
+type inboundOrderService struct {
+	repository InboundOrderRepository
+}
+
+func NewInboundOrderService(r InboundOrderRepository) InboundOrderService {
+	return &inboundOrderService{
+		repository: r,
+	}
+}
+
+// Create registers the receipt of the batch by the employee, who must work at
+// the warehouse the batch is stored in.
+func (s *inboundOrderService) Create(order domain.InboundOrder) (domain.InboundOrder, error) {
+	receivedAt := time.Now()
+
+	orderDate, err := time.Parse("2006-01-02", order.Order_Date)
+
+	if err != nil || orderDate.After(receivedAt) {
+		return domain.InboundOrder{}, ErrInvalidOrderDate
+	}
+
+	employeeWarehouseId, err := s.repository.GetEmployeeWarehouseId(order.Employee_Id)
+
+	if err != nil {
+		return domain.InboundOrder{}, err
+	}
+
+	if employeeWarehouseId != order.Warehouse_Id {
+		return domain.InboundOrder{}, ErrEmployeeNotInWarehouse
+	}
+
+	batchWarehouseId, err := s.repository.GetBatchWarehouseId(order.Product_Batch_Id)
+
+	if err != nil {
+		return domain.InboundOrder{}, err
+	}
+
+	if batchWarehouseId != order.Warehouse_Id {
+		return domain.InboundOrder{}, ErrBatchNotInWarehouse
+	}
+
+	order.Received_At = receivedAt.Format("2006-01-02 15:04:05")
+
+	return s.repository.Create(order)
+}
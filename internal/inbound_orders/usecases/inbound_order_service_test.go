@@ -0,0 +1,99 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/inbound_orders/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeInboundOrder() domain.InboundOrder {
+	return domain.InboundOrder{
+		Order_Date:       "2022-07-01",
+		Order_Number:     "IN-1",
+		Employee_Id:      1,
+		Product_Batch_Id: 2,
+		Warehouse_Id:     3,
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should return ErrInvalidOrderDate if the order date is invalid or in the future", func(t *testing.T) {
+		service := usecases.NewInboundOrderService(mocks.NewInboundOrderRepository(t))
+
+		for _, date := range []string{"01/07/2022", "2999-01-01"} {
+			order := makeInboundOrder()
+			order.Order_Date = date
+
+			_, err := service.Create(order)
+
+			assert.ErrorIs(t, err, usecases.ErrInvalidOrderDate, date)
+		}
+	})
+
+	t.Run("Should return ErrEmployeeNotFound if the employee does not exist", func(t *testing.T) {
+		mockRepository := mocks.NewInboundOrderRepository(t)
+		service := usecases.NewInboundOrderService(mockRepository)
+		mockRepository.On("GetEmployeeWarehouseId", 1).Return(0, usecases.ErrEmployeeNotFound).Once()
+
+		_, err := service.Create(makeInboundOrder())
+
+		assert.ErrorIs(t, err, usecases.ErrEmployeeNotFound)
+	})
+
+	t.Run("Should return ErrEmployeeNotInWarehouse if the employee works at another warehouse", func(t *testing.T) {
+		mockRepository := mocks.NewInboundOrderRepository(t)
+		service := usecases.NewInboundOrderService(mockRepository)
+		mockRepository.On("GetEmployeeWarehouseId", 1).Return(4, nil).Once()
+
+		_, err := service.Create(makeInboundOrder())
+
+		assert.ErrorIs(t, err, usecases.ErrEmployeeNotInWarehouse)
+	})
+
+	t.Run("Should return ErrBatchNotInWarehouse if the batch is stored at another warehouse", func(t *testing.T) {
+		mockRepository := mocks.NewInboundOrderRepository(t)
+		service := usecases.NewInboundOrderService(mockRepository)
+		mockRepository.On("GetEmployeeWarehouseId", 1).Return(3, nil).Once()
+		mockRepository.On("GetBatchWarehouseId", 2).Return(4, nil).Once()
+
+		_, err := service.Create(makeInboundOrder())
+
+		assert.ErrorIs(t, err, usecases.ErrBatchNotInWarehouse)
+	})
+
+	t.Run("Should return an error if Create from repository fails", func(t *testing.T) {
+		mockRepository := mocks.NewInboundOrderRepository(t)
+		service := usecases.NewInboundOrderService(mockRepository)
+		mockRepository.On("GetEmployeeWarehouseId", 1).Return(3, nil).Once()
+		mockRepository.On("GetBatchWarehouseId", 2).Return(3, nil).Once()
+		mockRepository.On("Create", mock.AnythingOfType("domain.InboundOrder")).Return(domain.InboundOrder{}, errors.New("any_error")).Once()
+
+		_, err := service.Create(makeInboundOrder())
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should record the receiving time and store the order", func(t *testing.T) {
+		mockRepository := mocks.NewInboundOrderRepository(t)
+		service := usecases.NewInboundOrderService(mockRepository)
+		mockRepository.On("GetEmployeeWarehouseId", 1).Return(3, nil).Once()
+		mockRepository.On("GetBatchWarehouseId", 2).Return(3, nil).Once()
+		mockRepository.On("Create", mock.MatchedBy(func(o domain.InboundOrder) bool {
+			return o.Employee_Id == 1 && o.Received_At != ""
+		})).Return(func(o domain.InboundOrder) domain.InboundOrder {
+			o.Id = 1
+			return o
+		}, nil).Once()
+
+		order, err := service.Create(makeInboundOrder())
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, order.Id)
+		assert.NotEmpty(t, order.Received_At)
+	})
+}
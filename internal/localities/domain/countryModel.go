@@ -5,3 +5,4 @@ type Country struct {
 	Name       string `json:"country_name"`
 }
 
+type Countries []Country
@@ -17,3 +17,17 @@ type FullLocality struct{
 
 
 type Localities []Locality
+
+type Coordinates struct {
+	LocalityId int     `json:"locality_id"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+}
+
+type CountReport struct {
+	Id           int            `json:"id"`
+	Name         string         `json:"name"`
+	ProvinceName string         `json:"province_name,omitempty"`
+	CountryName  string         `json:"country_name,omitempty"`
+	Counts       map[string]int `json:"counts"`
+}
@@ -2,6 +2,8 @@ package domain
 
 type Provincy struct {
 	Id         int    `json:"id"`
-	Name       string `json:"locality_name"`
+	Name       string `json:"province_name"`
 	Country_id int    `json:"country_id"`
-}
\ No newline at end of file
+}
+
+type Provinces []Provincy
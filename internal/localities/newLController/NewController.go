@@ -1,7 +1,9 @@
 package newLController
 
 import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/country"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/locality"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/province"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/services"
@@ -14,4 +16,17 @@ func NewLocalityController() *locality.LocalityController{
 	ls := services.NewService(lr, pr, ar)
 	lc := locality.NewLocality(ls)
 	return lc
-}
\ No newline at end of file
+}
+
+func NewCountryController() *country.CountryController {
+	cr := repository.CreateCountryRepository(db.GetInstance())
+	cs := services.NewCountryService(cr)
+	return country.NewCountry(cs)
+}
+
+func NewProvinceController() *province.ProvinceController {
+	pr := repository.CreateProvincyRepository(db.GetInstance())
+	cr := repository.CreateCountryRepository(db.GetInstance())
+	ps := services.NewProvinceService(pr, cr)
+	return province.NewProvince(ps)
+}
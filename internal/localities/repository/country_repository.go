@@ -13,8 +13,16 @@ type countryMysqlRepository struct {
 
 type CountryRepository interface {
 	GetById(id int) (domain.Country, error)
+	GetAll() (domain.Countries, error)
+	Create(name string) (domain.Country, error)
+	Update(id int, name string) (domain.Country, error)
+	Delete(id int) error
+	ExistsByName(name string, excludeId int) (bool, error)
+	HasProvinces(id int) (bool, error)
 }
 
+var ErrCountryNotFound = errors.New("country not found")
+
 
 func CreateCountryRepository(db *sql.DB) CountryRepository {
 	return &countryMysqlRepository{
@@ -30,7 +38,7 @@ func (r *countryMysqlRepository) GetById(id int) (domain.Country, error) {
 	err := r.db.QueryRow(query, id).Scan(&country.Id, &country.Name)
 
 	if errors.Is(err, sql.ErrNoRows) {
-		return domain.Country{}, errors.New("not found")
+		return domain.Country{}, ErrCountryNotFound
 	}
 
 	if err != nil {
@@ -38,4 +46,122 @@ func (r *countryMysqlRepository) GetById(id int) (domain.Country, error) {
 	}
 
 	return country, nil
-}
\ No newline at end of file
+}
+
+func (r *countryMysqlRepository) GetAll() (domain.Countries, error) {
+	const query = `SELECT id, name FROM country ORDER BY name`
+
+	rows, err := r.db.Query(query)
+
+	if err != nil {
+		return domain.Countries{}, err
+	}
+
+	defer rows.Close()
+
+	countries := domain.Countries{}
+
+	for rows.Next() {
+		country := domain.Country{}
+
+		if err := rows.Scan(&country.Id, &country.Name); err != nil {
+			return domain.Countries{}, err
+		}
+
+		countries = append(countries, country)
+	}
+
+	if err := rows.Err(); err != nil {
+		return domain.Countries{}, err
+	}
+
+	return countries, nil
+}
+
+func (r *countryMysqlRepository) Create(name string) (domain.Country, error) {
+	const query = `INSERT INTO country (name) VALUES (?)`
+
+	res, err := r.db.Exec(query, name)
+
+	if err != nil {
+		return domain.Country{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return domain.Country{}, err
+	}
+
+	return domain.Country{Id: int(id), Name: name}, nil
+}
+
+func (r *countryMysqlRepository) Update(id int, name string) (domain.Country, error) {
+	const query = `UPDATE country SET name=? WHERE id=?`
+
+	res, err := r.db.Exec(query, name, id)
+
+	if err != nil {
+		return domain.Country{}, err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return domain.Country{}, err
+	}
+
+	if rows == 0 {
+		if _, err := r.GetById(id); err != nil {
+			return domain.Country{}, err
+		}
+	}
+
+	return domain.Country{Id: id, Name: name}, nil
+}
+
+func (r *countryMysqlRepository) Delete(id int) error {
+	const query = `DELETE FROM country WHERE id=?`
+
+	res, err := r.db.Exec(query, id)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrCountryNotFound
+	}
+
+	return nil
+}
+
+func (r *countryMysqlRepository) ExistsByName(name string, excludeId int) (bool, error) {
+	const query = `SELECT EXISTS(SELECT 1 FROM country WHERE name=? AND id<>?)`
+
+	var exists bool
+
+	if err := r.db.QueryRow(query, name, excludeId).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *countryMysqlRepository) HasProvinces(id int) (bool, error) {
+	const query = `SELECT EXISTS(SELECT 1 FROM province WHERE country_id=?)`
+
+	var exists bool
+
+	if err := r.db.QueryRow(query, id).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
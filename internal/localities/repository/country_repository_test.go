@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountryGetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	countryRepo := repository.CreateCountryRepository(db)
+
+	query := `SELECT id, name FROM country WHERE id=?`
+
+	t.Run("GetById Not Found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := countryRepo.GetById(1)
+
+		assert.ErrorIs(t, err, repository.ErrCountryNotFound)
+	})
+}
+
+func TestCountryUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	countryRepo := repository.CreateCountryRepository(db)
+
+	query := `UPDATE country SET name=? WHERE id=?`
+
+	t.Run("Update OK", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs("Brasil", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := countryRepo.Update(1, "Brasil")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Country{Id: 1, Name: "Brasil"}, result)
+	})
+}
+
+func TestCountryExistsByName(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	countryRepo := repository.CreateCountryRepository(db)
+
+	query := `SELECT EXISTS(SELECT 1 FROM country WHERE name=? AND id<>?)`
+
+	t.Run("ExistsByName OK", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs("Brasil", 0).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		result, err := countryRepo.ExistsByName("Brasil", 0)
+
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+}
+
+func TestProvinceDelete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	provinceRepo := repository.CreateProvincyRepository(db)
+
+	query := `DELETE FROM province WHERE id=?`
+
+	t.Run("Delete Not Found", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := provinceRepo.Delete(1)
+
+		assert.ErrorIs(t, err, repository.ErrProvinceNotFound)
+	})
+}
@@ -58,6 +58,73 @@ func (_m *LocalityRepository) GetAll() ([]domain.Locality, error) {
 	return r0, r1
 }
 
+// GetById provides a mock function with given fields: id
+func (_m *LocalityRepository) GetById(id int) (domain.Locality, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Locality
+	if rf, ok := ret.Get(0).(func(int) domain.Locality); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Locality)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByProvinceId provides a mock function with given fields: provinceId
+func (_m *LocalityRepository) GetByProvinceId(provinceId int) ([]domain.FullLocality, error) {
+	ret := _m.Called(provinceId)
+
+	var r0 []domain.FullLocality
+	if rf, ok := ret.Get(0).(func(int) []domain.FullLocality); ok {
+		r0 = rf(provinceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.FullLocality)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(provinceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Report provides a mock function with given fields: include, groupBy
+func (_m *LocalityRepository) Report(include []string, groupBy string) ([]domain.CountReport, error) {
+	ret := _m.Called(include, groupBy)
+
+	var r0 []domain.CountReport
+	if rf, ok := ret.Get(0).(func([]string, string) []domain.CountReport); ok {
+		r0 = rf(include, groupBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CountReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string, string) error); ok {
+		r1 = rf(include, groupBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReportAll provides a mock function with given fields:
 func (_m *LocalityRepository) ReportAll() ([]repository.LocalityReport, error) {
 	ret := _m.Called()
@@ -102,6 +169,50 @@ func (_m *LocalityRepository) ReportById(id int) (repository.LocalityReport, err
 	return r0, r1
 }
 
+// Search provides a mock function with given fields: prefix, limit
+func (_m *LocalityRepository) Search(prefix string, limit int) ([]domain.FullLocality, error) {
+	ret := _m.Called(prefix, limit)
+
+	var r0 []domain.FullLocality
+	if rf, ok := ret.Get(0).(func(string, int) []domain.FullLocality); ok {
+		r0 = rf(prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.FullLocality)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCoordinates provides a mock function with given fields: id, latitude, longitude
+func (_m *LocalityRepository) UpdateCoordinates(id int, latitude float64, longitude float64) (domain.Coordinates, error) {
+	ret := _m.Called(id, latitude, longitude)
+
+	var r0 domain.Coordinates
+	if rf, ok := ret.Get(0).(func(int, float64, float64) domain.Coordinates); ok {
+		r0 = rf(id, latitude, longitude)
+	} else {
+		r0 = ret.Get(0).(domain.Coordinates)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, float64, float64) error); ok {
+		r1 = rf(id, latitude, longitude)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewLocalityRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -13,8 +13,17 @@ type provincyMysqlRepository struct {
 
 type ProvincyRepository interface {
 	GetById(id int) (domain.Provincy, error)
+	GetAll() (domain.Provinces, error)
+	GetByCountryId(countryId int) (domain.Provinces, error)
+	Create(name string, countryId int) (domain.Provincy, error)
+	Update(id int, name string, countryId int) (domain.Provincy, error)
+	Delete(id int) error
+	ExistsByName(name string, countryId int, excludeId int) (bool, error)
+	HasLocalities(id int) (bool, error)
 }
 
+var ErrProvinceNotFound = errors.New("province not found")
+
 
 func CreateProvincyRepository(db *sql.DB) ProvincyRepository {
 	return &provincyMysqlRepository{
@@ -30,7 +39,7 @@ func (r *provincyMysqlRepository) GetById(id int) (domain.Provincy, error) {
 	err := r.db.QueryRow(query, id).Scan(&provincy.Id, &provincy.Name, &provincy.Country_id)
 
 	if errors.Is(err, sql.ErrNoRows) {
-		return domain.Provincy{}, errors.New("not found")
+		return domain.Provincy{}, ErrProvinceNotFound
 	}
 
 	if err != nil {
@@ -38,4 +47,132 @@ func (r *provincyMysqlRepository) GetById(id int) (domain.Provincy, error) {
 	}
 
 	return provincy, nil
-}
\ No newline at end of file
+}
+
+func (r *provincyMysqlRepository) GetAll() (domain.Provinces, error) {
+	const query = `SELECT id, name, country_id FROM province ORDER BY country_id, name`
+
+	return r.queryProvinces(query)
+}
+
+func (r *provincyMysqlRepository) GetByCountryId(countryId int) (domain.Provinces, error) {
+	const query = `SELECT id, name, country_id FROM province WHERE country_id=? ORDER BY name`
+
+	return r.queryProvinces(query, countryId)
+}
+
+func (r *provincyMysqlRepository) queryProvinces(query string, args ...interface{}) (domain.Provinces, error) {
+	rows, err := r.db.Query(query, args...)
+
+	if err != nil {
+		return domain.Provinces{}, err
+	}
+
+	defer rows.Close()
+
+	provinces := domain.Provinces{}
+
+	for rows.Next() {
+		provincy := domain.Provincy{}
+
+		if err := rows.Scan(&provincy.Id, &provincy.Name, &provincy.Country_id); err != nil {
+			return domain.Provinces{}, err
+		}
+
+		provinces = append(provinces, provincy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return domain.Provinces{}, err
+	}
+
+	return provinces, nil
+}
+
+func (r *provincyMysqlRepository) Create(name string, countryId int) (domain.Provincy, error) {
+	const query = `INSERT INTO province (name, country_id) VALUES (?, ?)`
+
+	res, err := r.db.Exec(query, name, countryId)
+
+	if err != nil {
+		return domain.Provincy{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return domain.Provincy{}, err
+	}
+
+	return domain.Provincy{Id: int(id), Name: name, Country_id: countryId}, nil
+}
+
+func (r *provincyMysqlRepository) Update(id int, name string, countryId int) (domain.Provincy, error) {
+	const query = `UPDATE province SET name=?, country_id=? WHERE id=?`
+
+	res, err := r.db.Exec(query, name, countryId, id)
+
+	if err != nil {
+		return domain.Provincy{}, err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return domain.Provincy{}, err
+	}
+
+	if rows == 0 {
+		if _, err := r.GetById(id); err != nil {
+			return domain.Provincy{}, err
+		}
+	}
+
+	return domain.Provincy{Id: id, Name: name, Country_id: countryId}, nil
+}
+
+func (r *provincyMysqlRepository) Delete(id int) error {
+	const query = `DELETE FROM province WHERE id=?`
+
+	res, err := r.db.Exec(query, id)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrProvinceNotFound
+	}
+
+	return nil
+}
+
+func (r *provincyMysqlRepository) ExistsByName(name string, countryId int, excludeId int) (bool, error) {
+	const query = `SELECT EXISTS(SELECT 1 FROM province WHERE name=? AND country_id=? AND id<>?)`
+
+	var exists bool
+
+	if err := r.db.QueryRow(query, name, countryId, excludeId).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *provincyMysqlRepository) HasLocalities(id int) (bool, error) {
+	const query = `SELECT EXISTS(SELECT 1 FROM locality WHERE province_id=?)`
+
+	var exists bool
+
+	if err := r.db.QueryRow(query, id).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
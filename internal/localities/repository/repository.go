@@ -2,7 +2,9 @@ package repository
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
 )
@@ -27,9 +29,16 @@ type LocalityRepository interface {
 	ReportAll() ([]LocalityReport, error)
 	ReportById(id int) (LocalityReport, error)
 	GetAll() ([]domain.Locality, error)
+	GetById(id int) (domain.Locality, error)
+	UpdateCoordinates(id int, latitude float64, longitude float64) (domain.Coordinates, error)
+	GetByProvinceId(provinceId int) ([]domain.FullLocality, error)
+	Search(prefix string, limit int) ([]domain.FullLocality, error)
+	Report(include []string, groupBy string) ([]domain.CountReport, error)
 	
 }
 
+var ErrLocalityNotFound = errors.New("locality not found")
+
 type mySqlRepository struct {
 	db *sql.DB
 }
@@ -69,7 +78,7 @@ func (r *mySqlRepository) Create(name string, province_id int) (domain.Locality
 
 
 func (r *mySqlRepository) GetAll() ([]domain.Locality, error) {
-	const query = `SELECT * FROM locality`
+	const query = `SELECT id, name, province_id FROM locality`
 
 	rows, err := r.db.Query(query)
 
@@ -153,6 +162,184 @@ func (r *mySqlRepository) ReportById(id int) (LocalityReport, error) {
 	return locality, nil
 }
 
+func (r *mySqlRepository) GetById(id int) (domain.Locality, error) {
+	const query = `SELECT id, name, province_id FROM locality WHERE id=?`
+
+	locality := domain.Locality{}
+
+	err := r.db.QueryRow(query, id).Scan(&locality.Id, &locality.Name, &locality.Province_id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Locality{}, ErrLocalityNotFound
+	}
+
+	if err != nil {
+		return domain.Locality{}, err
+	}
+
+	return locality, nil
+}
+
+func (r *mySqlRepository) UpdateCoordinates(id int, latitude float64, longitude float64) (domain.Coordinates, error) {
+	const query = `UPDATE locality SET latitude=?, longitude=? WHERE id=?`
+
+	res, err := r.db.Exec(query, latitude, longitude, id)
+
+	if err != nil {
+		return domain.Coordinates{}, err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return domain.Coordinates{}, err
+	}
+
+	if rows == 0 {
+		if _, err := r.GetById(id); err != nil {
+			return domain.Coordinates{}, err
+		}
+	}
+
+	return domain.Coordinates{
+		LocalityId: id,
+		Latitude:   latitude,
+		Longitude:  longitude,
+	}, nil
+}
+
+const fullLocalityQuery = `SELECT l.id, l.name, p.name, c.name FROM locality l
+	INNER JOIN province p ON p.id = l.province_id
+	INNER JOIN country c ON c.id = p.country_id`
+
+func (r *mySqlRepository) GetByProvinceId(provinceId int) ([]domain.FullLocality, error) {
+	const query = fullLocalityQuery + ` WHERE l.province_id=? ORDER BY l.name`
+
+	return r.queryFullLocalities(query, provinceId)
+}
+
+// Search matches localities whose name starts with prefix, ignoring case and
+// accents through the utf8_general_ci collation.
+func (r *mySqlRepository) Search(prefix string, limit int) ([]domain.FullLocality, error) {
+	const query = fullLocalityQuery + ` WHERE l.name COLLATE utf8_general_ci LIKE CONVERT(? USING utf8) ORDER BY l.name, p.name LIMIT ?`
+
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+
+	return r.queryFullLocalities(query, escaped+"%", limit)
+}
+
+func (r *mySqlRepository) queryFullLocalities(query string, args ...interface{}) ([]domain.FullLocality, error) {
+	rows, err := r.db.Query(query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	localities := []domain.FullLocality{}
+
+	for rows.Next() {
+		l := domain.FullLocality{}
+
+		if err := rows.Scan(&l.Id, &l.Name, &l.ProvinceName, &l.CountryName); err != nil {
+			return nil, err
+		}
+
+		localities = append(localities, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return localities, nil
+}
+
+// ReportSources has, for each entity the report can count, a query with the
+// per-locality totals.
+var ReportSources = map[string]string{
+	"sellers":  `SELECT locality_id, COUNT(*) AS total FROM seller WHERE deleted_at IS NULL GROUP BY locality_id`,
+	"carriers": `SELECT locality_id, COUNT(*) AS total FROM carrier WHERE deleted_at IS NULL GROUP BY locality_id`,
+	"buyers": `SELECT ba.locality_id, COUNT(*) AS total FROM buyer_address ba
+	INNER JOIN buyer b ON b.id = ba.buyer_id
+	WHERE ba.is_default = 1 AND b.deleted_at IS NULL GROUP BY ba.locality_id`,
+	"warehouses": `SELECT locality_id, COUNT(*) AS total FROM warehouse WHERE deleted_at IS NULL GROUP BY locality_id`,
+}
+
+var reportLevels = map[string]struct{ columns, groupBy, orderBy string }{
+	"locality": {"l.id, l.name, p.name, c.name", "l.id, l.name, p.name, c.name", "c.name, p.name, l.name"},
+	"province": {"p.id, p.name, '', c.name", "p.id, p.name, c.name", "c.name, p.name"},
+	"country":  {"c.id, c.name, '', ''", "c.id, c.name", "c.name"},
+}
+
+// Report counts the included entities per locality, province or country in
+// a single query, joining the per-locality totals of each source.
+func (r *mySqlRepository) Report(include []string, groupBy string) ([]domain.CountReport, error) {
+	level, ok := reportLevels[groupBy]
+
+	if !ok {
+		return nil, fmt.Errorf("unknown report level %q", groupBy)
+	}
+
+	var counts, joins strings.Builder
+
+	for i, name := range include {
+		source, ok := ReportSources[name]
+
+		if !ok {
+			return nil, fmt.Errorf("unknown report source %q", name)
+		}
+
+		alias := fmt.Sprintf("s%d", i)
+		fmt.Fprintf(&counts, ", COALESCE(SUM(%s.total), 0)", alias)
+		fmt.Fprintf(&joins, "\n\tLEFT JOIN (%s) %s ON %s.locality_id = l.id", source, alias, alias)
+	}
+
+	query := `SELECT ` + level.columns + counts.String() + `
+	FROM locality l
+	INNER JOIN province p ON p.id = l.province_id
+	INNER JOIN country c ON c.id = p.country_id` + joins.String() + `
+	GROUP BY ` + level.groupBy + `
+	ORDER BY ` + level.orderBy
+
+	rows, err := r.db.Query(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	reports := []domain.CountReport{}
+
+	for rows.Next() {
+		report := domain.CountReport{Counts: map[string]int{}}
+		totals := make([]int, len(include))
+		dest := []interface{}{&report.Id, &report.Name, &report.ProvinceName, &report.CountryName}
+
+		for i := range totals {
+			dest = append(dest, &totals[i])
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		for i, name := range include {
+			report.Counts[name] = totals[i]
+		}
+
+		reports = append(reports, report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
 func CreateMySQLRepository(db *sql.DB) LocalityRepository {
 	return &mySqlRepository{
 		db: db,
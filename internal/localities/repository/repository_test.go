@@ -152,3 +152,155 @@ func TestReportById(t *testing.T) {
 	})
 }
 
+func TestGetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	localityRepo := repository.CreateMySQLRepository(db)
+
+	query := `SELECT id, name, province_id FROM locality WHERE id=?`
+
+	t.Run("GetById OK", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "name", "province_id"}).AddRow(locality1.Id, locality1.Name, locality1.Province_id)
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(1).WillReturnRows(rows)
+
+		result, err := localityRepo.GetById(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, locality1, result)
+	})
+
+	t.Run("GetById Not Found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		_, err := localityRepo.GetById(2)
+
+		assert.ErrorIs(t, err, repository.ErrLocalityNotFound)
+	})
+
+	t.Run("GetById Error", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(3).WillReturnError(fmt.Errorf("error"))
+
+		_, err := localityRepo.GetById(3)
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestUpdateCoordinates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	localityRepo := repository.CreateMySQLRepository(db)
+
+	query := `UPDATE locality SET latitude=?, longitude=? WHERE id=?`
+	getQuery := `SELECT id, name, province_id FROM locality WHERE id=?`
+
+	t.Run("UpdateCoordinates OK", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(-23.55, -46.63, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := localityRepo.UpdateCoordinates(1, -23.55, -46.63)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Coordinates{LocalityId: 1, Latitude: -23.55, Longitude: -46.63}, result)
+	})
+
+	t.Run("UpdateCoordinates unchanged values", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(-23.55, -46.63, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+		rows := sqlmock.NewRows([]string{"id", "name", "province_id"}).AddRow(locality1.Id, locality1.Name, locality1.Province_id)
+		mock.ExpectQuery(regexp.QuoteMeta(getQuery)).WithArgs(1).WillReturnRows(rows)
+
+		_, err := localityRepo.UpdateCoordinates(1, -23.55, -46.63)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("UpdateCoordinates Not Found", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(-23.55, -46.63, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(getQuery)).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		_, err := localityRepo.UpdateCoordinates(2, -23.55, -46.63)
+
+		assert.ErrorIs(t, err, repository.ErrLocalityNotFound)
+	})
+
+	t.Run("UpdateCoordinates Error", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(-23.55, -46.63, 3).WillReturnError(fmt.Errorf("error"))
+
+		_, err := localityRepo.UpdateCoordinates(3, -23.55, -46.63)
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestSearch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	localityRepo := repository.CreateMySQLRepository(db)
+
+	t.Run("Search escapes LIKE wildcards and matches by prefix", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "name", "province", "country"}).AddRow(1, "São Paulo", "SP", "Brasil")
+		mock.ExpectQuery("LIKE CONVERT").WithArgs(`sa\_o%`, 20).WillReturnRows(rows)
+
+		result, err := localityRepo.Search("sa_o", 20)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.FullLocality{{Id: 1, Name: "São Paulo", ProvinceName: "SP", CountryName: "Brasil"}}, result)
+	})
+
+	t.Run("Search Error", func(t *testing.T) {
+		mock.ExpectQuery("LIKE CONVERT").WillReturnError(fmt.Errorf("error"))
+
+		_, err := localityRepo.Search("sao", 20)
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestGetByProvinceId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	localityRepo := repository.CreateMySQLRepository(db)
+
+	t.Run("GetByProvinceId OK", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "name", "province", "country"}).AddRow(1, "Itabaiana", "Sergipe", "Brasil").AddRow(2, "Lagarto", "Sergipe", "Brasil")
+		mock.ExpectQuery(regexp.QuoteMeta("WHERE l.province_id=?")).WithArgs(3).WillReturnRows(rows)
+
+		result, err := localityRepo.GetByProvinceId(3)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestReport(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	localityRepo := repository.CreateMySQLRepository(db)
+
+	t.Run("Report counts the included sources in one query", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "name", "province", "country", "sellers", "buyers"}).
+			AddRow(1, "Sergipe", "", "Brasil", 3, 0).
+			AddRow(2, "São Paulo", "", "Brasil", 10, 4)
+		mock.ExpectQuery(`SELECT p.id, p.name, '', c.name, COALESCE\(SUM\(s0.total\), 0\), COALESCE\(SUM\(s1.total\), 0\)
+	FROM locality l(.|\n)*LEFT JOIN \(SELECT locality_id, COUNT\(\*\) AS total FROM seller(.|\n)*LEFT JOIN \(SELECT ba.locality_id(.|\n)*GROUP BY p.id, p.name, c.name`).WillReturnRows(rows)
+
+		result, err := localityRepo.Report([]string{"sellers", "buyers"}, "province")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.CountReport{
+			{Id: 1, Name: "Sergipe", CountryName: "Brasil", Counts: map[string]int{"sellers": 3, "buyers": 0}},
+			{Id: 2, Name: "São Paulo", CountryName: "Brasil", Counts: map[string]int{"sellers": 10, "buyers": 4}},
+		}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Report rejects unknown sources", func(t *testing.T) {
+		_, err := localityRepo.Report([]string{"employees"}, "locality")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+)
+
+var (
+	ErrInvalidName         = errors.New("name can't be empty")
+	ErrCountryNameInUse    = errors.New("a country with this name already exists")
+	ErrCountryHasProvinces = errors.New("country has provinces and can't be deleted")
+)
+
+type CountryService interface {
+	GetAll() (domain.Countries, error)
+	GetById(id int) (domain.Country, error)
+	Create(name string) (domain.Country, error)
+	Update(id int, name string) (domain.Country, error)
+	Delete(id int) error
+}
+
+type countryService struct {
+	countryRepository repository.CountryRepository
+}
+
+func NewCountryService(c repository.CountryRepository) CountryService {
+	return &countryService{
+		countryRepository: c,
+	}
+}
+
+func (s *countryService) GetAll() (domain.Countries, error) {
+	return s.countryRepository.GetAll()
+}
+
+func (s *countryService) GetById(id int) (domain.Country, error) {
+	return s.countryRepository.GetById(id)
+}
+
+func (s *countryService) Create(name string) (domain.Country, error) {
+	name, err := s.checkName(name, 0)
+
+	if err != nil {
+		return domain.Country{}, err
+	}
+
+	return s.countryRepository.Create(name)
+}
+
+func (s *countryService) Update(id int, name string) (domain.Country, error) {
+	if _, err := s.countryRepository.GetById(id); err != nil {
+		return domain.Country{}, err
+	}
+
+	name, err := s.checkName(name, id)
+
+	if err != nil {
+		return domain.Country{}, err
+	}
+
+	return s.countryRepository.Update(id, name)
+}
+
+func (s *countryService) Delete(id int) error {
+	if _, err := s.countryRepository.GetById(id); err != nil {
+		return err
+	}
+
+	hasProvinces, err := s.countryRepository.HasProvinces(id)
+
+	if err != nil {
+		return err
+	}
+
+	if hasProvinces {
+		return ErrCountryHasProvinces
+	}
+
+	return s.countryRepository.Delete(id)
+}
+
+func (s *countryService) checkName(name string, id int) (string, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return "", ErrInvalidName
+	}
+
+	inUse, err := s.countryRepository.ExistsByName(name, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	if inUse {
+		return "", ErrCountryNameInUse
+	}
+
+	return name, nil
+}
@@ -0,0 +1,91 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountryCreate(t *testing.T) {
+	t.Run("Should trim the name and create the country", func(t *testing.T) {
+		countryRepository := mocks.NewCountryRepository(t)
+		countryRepository.On("ExistsByName", "Brasil", 0).Return(false, nil).Once()
+		countryRepository.On("Create", "Brasil").Return(domain.Country{Id: 1, Name: "Brasil"}, nil).Once()
+		sut := services.NewCountryService(countryRepository)
+
+		result, err := sut.Create("  Brasil ")
+
+		assert.Nil(t, err)
+		assert.Equal(t, domain.Country{Id: 1, Name: "Brasil"}, result)
+	})
+
+	t.Run("Should return ErrInvalidName if the name is blank", func(t *testing.T) {
+		sut := services.NewCountryService(mocks.NewCountryRepository(t))
+
+		_, err := sut.Create("   ")
+
+		assert.ErrorIs(t, err, services.ErrInvalidName)
+	})
+
+	t.Run("Should return ErrCountryNameInUse if the name exists", func(t *testing.T) {
+		countryRepository := mocks.NewCountryRepository(t)
+		countryRepository.On("ExistsByName", "Brasil", 0).Return(true, nil).Once()
+		sut := services.NewCountryService(countryRepository)
+
+		_, err := sut.Create("Brasil")
+
+		assert.ErrorIs(t, err, services.ErrCountryNameInUse)
+	})
+}
+
+func TestCountryUpdate(t *testing.T) {
+	t.Run("Should return ErrCountryNotFound if the country doesn't exist", func(t *testing.T) {
+		countryRepository := mocks.NewCountryRepository(t)
+		countryRepository.On("GetById", 1).Return(domain.Country{}, repository.ErrCountryNotFound).Once()
+		sut := services.NewCountryService(countryRepository)
+
+		_, err := sut.Update(1, "Brasil")
+
+		assert.ErrorIs(t, err, repository.ErrCountryNotFound)
+	})
+
+	t.Run("Should ignore the country itself when checking the name", func(t *testing.T) {
+		countryRepository := mocks.NewCountryRepository(t)
+		countryRepository.On("GetById", 1).Return(domain.Country{Id: 1, Name: "brasil"}, nil).Once()
+		countryRepository.On("ExistsByName", "Brasil", 1).Return(false, nil).Once()
+		countryRepository.On("Update", 1, "Brasil").Return(domain.Country{Id: 1, Name: "Brasil"}, nil).Once()
+		sut := services.NewCountryService(countryRepository)
+
+		result, err := sut.Update(1, "Brasil")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Brasil", result.Name)
+	})
+}
+
+func TestCountryDelete(t *testing.T) {
+	t.Run("Should return ErrCountryHasProvinces if the country has provinces", func(t *testing.T) {
+		countryRepository := mocks.NewCountryRepository(t)
+		countryRepository.On("GetById", 1).Return(domain.Country{Id: 1}, nil).Once()
+		countryRepository.On("HasProvinces", 1).Return(true, nil).Once()
+		sut := services.NewCountryService(countryRepository)
+
+		err := sut.Delete(1)
+
+		assert.ErrorIs(t, err, services.ErrCountryHasProvinces)
+	})
+
+	t.Run("Should delete the country", func(t *testing.T) {
+		countryRepository := mocks.NewCountryRepository(t)
+		countryRepository.On("GetById", 1).Return(domain.Country{Id: 1}, nil).Once()
+		countryRepository.On("HasProvinces", 1).Return(false, nil).Once()
+		countryRepository.On("Delete", 1).Return(nil).Once()
+		sut := services.NewCountryService(countryRepository)
+
+		assert.Nil(t, sut.Delete(1))
+	})
+}
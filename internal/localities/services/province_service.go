@@ -0,0 +1,120 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+)
+
+var (
+	ErrProvinceNameInUse     = errors.New("this country already has a province with this name")
+	ErrProvinceHasLocalities = errors.New("province has localities and can't be deleted")
+	ErrProvinceNotInCountry  = errors.New("province doesn't belong to this country")
+)
+
+type ProvinceService interface {
+	GetAll() (domain.Provinces, error)
+	GetById(id int) (domain.Provincy, error)
+	GetByCountryId(countryId int) (domain.Provinces, error)
+	Create(name string, countryId int) (domain.Provincy, error)
+	Update(id int, name string, countryId int) (domain.Provincy, error)
+	Delete(id int) error
+}
+
+type provinceService struct {
+	provinceRepository repository.ProvincyRepository
+	countryRepository  repository.CountryRepository
+}
+
+func NewProvinceService(p repository.ProvincyRepository, c repository.CountryRepository) ProvinceService {
+	return &provinceService{
+		provinceRepository: p,
+		countryRepository:  c,
+	}
+}
+
+func (s *provinceService) GetAll() (domain.Provinces, error) {
+	return s.provinceRepository.GetAll()
+}
+
+func (s *provinceService) GetById(id int) (domain.Provincy, error) {
+	return s.provinceRepository.GetById(id)
+}
+
+func (s *provinceService) GetByCountryId(countryId int) (domain.Provinces, error) {
+	if _, err := s.countryRepository.GetById(countryId); err != nil {
+		return domain.Provinces{}, err
+	}
+
+	return s.provinceRepository.GetByCountryId(countryId)
+}
+
+func (s *provinceService) Create(name string, countryId int) (domain.Provincy, error) {
+	name, err := s.checkName(name, countryId, 0)
+
+	if err != nil {
+		return domain.Provincy{}, err
+	}
+
+	return s.provinceRepository.Create(name, countryId)
+}
+
+func (s *provinceService) Update(id int, name string, countryId int) (domain.Provincy, error) {
+	if _, err := s.provinceRepository.GetById(id); err != nil {
+		return domain.Provincy{}, err
+	}
+
+	name, err := s.checkName(name, countryId, id)
+
+	if err != nil {
+		return domain.Provincy{}, err
+	}
+
+	return s.provinceRepository.Update(id, name, countryId)
+}
+
+func (s *provinceService) Delete(id int) error {
+	if _, err := s.provinceRepository.GetById(id); err != nil {
+		return err
+	}
+
+	hasLocalities, err := s.provinceRepository.HasLocalities(id)
+
+	if err != nil {
+		return err
+	}
+
+	if hasLocalities {
+		return ErrProvinceHasLocalities
+	}
+
+	return s.provinceRepository.Delete(id)
+}
+
+// checkName trims the name and makes sure the country exists and has no
+// other province with it.
+func (s *provinceService) checkName(name string, countryId int, id int) (string, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return "", ErrInvalidName
+	}
+
+	if _, err := s.countryRepository.GetById(countryId); err != nil {
+		return "", err
+	}
+
+	inUse, err := s.provinceRepository.ExistsByName(name, countryId, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	if inUse {
+		return "", ErrProvinceNameInUse
+	}
+
+	return name, nil
+}
@@ -0,0 +1,104 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvinceCreate(t *testing.T) {
+	makeSut := func() (services.ProvinceService, *mocks.ProvincyRepository, *mocks.CountryRepository) {
+		provinceRepository := mocks.NewProvincyRepository(t)
+		countryRepository := mocks.NewCountryRepository(t)
+		return services.NewProvinceService(provinceRepository, countryRepository), provinceRepository, countryRepository
+	}
+
+	t.Run("Should return ErrCountryNotFound if the country doesn't exist", func(t *testing.T) {
+		sut, _, countryRepository := makeSut()
+		countryRepository.On("GetById", 9).Return(domain.Country{}, repository.ErrCountryNotFound).Once()
+
+		_, err := sut.Create("Sergipe", 9)
+
+		assert.ErrorIs(t, err, repository.ErrCountryNotFound)
+	})
+
+	t.Run("Should return ErrProvinceNameInUse if the country has a province with the name", func(t *testing.T) {
+		sut, provinceRepository, countryRepository := makeSut()
+		countryRepository.On("GetById", 1).Return(domain.Country{Id: 1}, nil).Once()
+		provinceRepository.On("ExistsByName", "Sergipe", 1, 0).Return(true, nil).Once()
+
+		_, err := sut.Create("Sergipe", 1)
+
+		assert.ErrorIs(t, err, services.ErrProvinceNameInUse)
+	})
+
+	t.Run("Should create the province", func(t *testing.T) {
+		sut, provinceRepository, countryRepository := makeSut()
+		countryRepository.On("GetById", 1).Return(domain.Country{Id: 1}, nil).Once()
+		provinceRepository.On("ExistsByName", "Sergipe", 1, 0).Return(false, nil).Once()
+		provinceRepository.On("Create", "Sergipe", 1).Return(domain.Provincy{Id: 2, Name: "Sergipe", Country_id: 1}, nil).Once()
+
+		result, err := sut.Create("Sergipe", 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result.Id)
+	})
+}
+
+func TestProvinceDelete(t *testing.T) {
+	t.Run("Should return ErrProvinceHasLocalities if the province has localities", func(t *testing.T) {
+		provinceRepository := mocks.NewProvincyRepository(t)
+		provinceRepository.On("GetById", 1).Return(domain.Provincy{Id: 1}, nil).Once()
+		provinceRepository.On("HasLocalities", 1).Return(true, nil).Once()
+		sut := services.NewProvinceService(provinceRepository, mocks.NewCountryRepository(t))
+
+		err := sut.Delete(1)
+
+		assert.ErrorIs(t, err, services.ErrProvinceHasLocalities)
+	})
+}
+
+func TestProvinceGetByCountryId(t *testing.T) {
+	t.Run("Should return ErrCountryNotFound if the country doesn't exist", func(t *testing.T) {
+		countryRepository := mocks.NewCountryRepository(t)
+		countryRepository.On("GetById", 1).Return(domain.Country{}, repository.ErrCountryNotFound).Once()
+		sut := services.NewProvinceService(mocks.NewProvincyRepository(t), countryRepository)
+
+		_, err := sut.GetByCountryId(1)
+
+		assert.ErrorIs(t, err, repository.ErrCountryNotFound)
+	})
+}
+
+func TestLocalityCreate(t *testing.T) {
+	t.Run("Should return ErrProvinceNotInCountry if the province belongs to another country", func(t *testing.T) {
+		provinceRepository := mocks.NewProvincyRepository(t)
+		countryRepository := mocks.NewCountryRepository(t)
+		provinceRepository.On("GetById", 1).Return(domain.Provincy{Id: 1, Name: "Sergipe", Country_id: 1}, nil).Once()
+		countryRepository.On("GetById", 2).Return(domain.Country{Id: 2, Name: "Argentina"}, nil).Once()
+		sut := services.NewService(mocks.NewLocalityRepository(t), provinceRepository, countryRepository)
+
+		_, err := sut.Create("Itabaiana", 1, 2)
+
+		assert.ErrorIs(t, err, services.ErrProvinceNotInCountry)
+	})
+
+	t.Run("Should create the locality", func(t *testing.T) {
+		localityRepository := mocks.NewLocalityRepository(t)
+		provinceRepository := mocks.NewProvincyRepository(t)
+		countryRepository := mocks.NewCountryRepository(t)
+		provinceRepository.On("GetById", 1).Return(domain.Provincy{Id: 1, Name: "Sergipe", Country_id: 1}, nil).Once()
+		countryRepository.On("GetById", 1).Return(domain.Country{Id: 1, Name: "Brasil"}, nil).Once()
+		localityRepository.On("Create", "Itabaiana", 1).Return(domain.Locality{Id: 3, Name: "Itabaiana", Province_id: 1}, nil).Once()
+		sut := services.NewService(localityRepository, provinceRepository, countryRepository)
+
+		result, err := sut.Create("Itabaiana", 1, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, domain.FullLocality{Id: 3, Name: "Itabaiana", ProvinceName: "Sergipe", CountryName: "Brasil"}, result)
+	})
+}
@@ -1,7 +1,10 @@
 package services
 
 import (
-
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
@@ -11,8 +14,22 @@ type Service interface {
 	Create(name string, province_id, country_id int) (domain.FullLocality, error)
 	ReportById(id int) (repository.LocalityReport, error)
 	ReportAll() ([]repository.LocalityReport, error)
+	UpdateCoordinates(id int, latitude float64, longitude float64) (domain.Coordinates, error)
+	GetByProvinceId(provinceId int) ([]domain.FullLocality, error)
+	Search(q string) ([]domain.FullLocality, error)
+	Report(include []string, groupBy string) ([]domain.CountReport, error)
 }
 
+var ErrInvalidCoordinates = errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+
+var ErrInvalidSearch = errors.New("q can't be empty")
+
+const searchLimit = 20
+
+var ErrInvalidReportInclude = errors.New("invalid include")
+
+var ErrInvalidReportLevel = errors.New("by must be locality, province or country")
+
 type service struct {
 	localityrepository repository.LocalityRepository
 	provinceRepository repository.ProvincyRepository
@@ -30,13 +47,13 @@ func (s *service) Create(name string, province_id, country_id int) (domain.FullL
 		return domain.FullLocality{}, err
 	}
 
-	country, a:= s.countryRepository.GetById(country_id)
-	if a != nil{
+	country, err := s.countryRepository.GetById(country_id)
+	if err != nil{
 		return domain.FullLocality{}, err
 	}
 
-	if err != nil{
-		return domain.FullLocality{}, err
+	if province.Country_id != country.Id {
+		return domain.FullLocality{}, ErrProvinceNotInCountry
 	}
 	locality, err := s.localityrepository.Create(name, province_id)
 
@@ -75,6 +92,78 @@ func (s *service) ReportById(id int) (repository.LocalityReport, error) {
 	return report, nil
 }
 
+func (s *service) UpdateCoordinates(id int, latitude float64, longitude float64) (domain.Coordinates, error) {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return domain.Coordinates{}, ErrInvalidCoordinates
+	}
+
+	return s.localityrepository.UpdateCoordinates(id, latitude, longitude)
+}
+
+func (s *service) GetByProvinceId(provinceId int) ([]domain.FullLocality, error) {
+	if _, err := s.provinceRepository.GetById(provinceId); err != nil {
+		return []domain.FullLocality{}, err
+	}
+
+	return s.localityrepository.GetByProvinceId(provinceId)
+}
+
+func (s *service) Search(q string) ([]domain.FullLocality, error) {
+	q = strings.TrimSpace(q)
+
+	if q == "" {
+		return []domain.FullLocality{}, ErrInvalidSearch
+	}
+
+	return s.localityrepository.Search(q, searchLimit)
+}
+
+// Report counts the included entities, all of them when include is empty, per
+// locality, province or country.
+func (s *service) Report(include []string, groupBy string) ([]domain.CountReport, error) {
+	if groupBy == "" {
+		groupBy = "locality"
+	}
+
+	if groupBy != "locality" && groupBy != "province" && groupBy != "country" {
+		return []domain.CountReport{}, ErrInvalidReportLevel
+	}
+
+	sources := []string{}
+	seen := map[string]bool{}
+
+	for _, name := range include {
+		name = strings.TrimSpace(name)
+
+		if _, ok := repository.ReportSources[name]; !ok {
+			return []domain.CountReport{}, fmt.Errorf("%w: %q, use %s", ErrInvalidReportInclude, name, strings.Join(reportSourceNames(), ", "))
+		}
+
+		if !seen[name] {
+			seen[name] = true
+			sources = append(sources, name)
+		}
+	}
+
+	if len(sources) == 0 {
+		sources = reportSourceNames()
+	}
+
+	return s.localityrepository.Report(sources, groupBy)
+}
+
+func reportSourceNames() []string {
+	names := make([]string, 0, len(repository.ReportSources))
+
+	for name := range repository.ReportSources {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
 func NewService(l repository.LocalityRepository, p repository.ProvincyRepository, c repository.CountryRepository ) Service {
 	return &service{
 		localityrepository: l,
@@ -0,0 +1,82 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	t.Run("Should return ErrInvalidSearch if q is blank", func(t *testing.T) {
+		sut := services.NewService(mocks.NewLocalityRepository(t), mocks.NewProvincyRepository(t), mocks.NewCountryRepository(t))
+
+		_, err := sut.Search("  ")
+
+		assert.ErrorIs(t, err, services.ErrInvalidSearch)
+	})
+
+	t.Run("Should search the trimmed prefix", func(t *testing.T) {
+		localityRepository := mocks.NewLocalityRepository(t)
+		localityRepository.On("Search", "sao", 20).Return([]domain.FullLocality{{Id: 1, Name: "São Paulo"}}, nil).Once()
+		sut := services.NewService(localityRepository, mocks.NewProvincyRepository(t), mocks.NewCountryRepository(t))
+
+		result, err := sut.Search(" sao ")
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestGetByProvinceId(t *testing.T) {
+	t.Run("Should return ErrProvinceNotFound if the province doesn't exist", func(t *testing.T) {
+		provinceRepository := mocks.NewProvincyRepository(t)
+		provinceRepository.On("GetById", 1).Return(domain.Provincy{}, repository.ErrProvinceNotFound).Once()
+		sut := services.NewService(mocks.NewLocalityRepository(t), provinceRepository, mocks.NewCountryRepository(t))
+
+		_, err := sut.GetByProvinceId(1)
+
+		assert.ErrorIs(t, err, repository.ErrProvinceNotFound)
+	})
+}
+
+func TestReport(t *testing.T) {
+	t.Run("Should return ErrInvalidReportLevel for unknown levels", func(t *testing.T) {
+		sut := services.NewService(mocks.NewLocalityRepository(t), mocks.NewProvincyRepository(t), mocks.NewCountryRepository(t))
+
+		_, err := sut.Report(nil, "city")
+
+		assert.ErrorIs(t, err, services.ErrInvalidReportLevel)
+	})
+
+	t.Run("Should return ErrInvalidReportInclude for unknown sources", func(t *testing.T) {
+		sut := services.NewService(mocks.NewLocalityRepository(t), mocks.NewProvincyRepository(t), mocks.NewCountryRepository(t))
+
+		_, err := sut.Report([]string{"sellers", "employees"}, "")
+
+		assert.ErrorIs(t, err, services.ErrInvalidReportInclude)
+	})
+
+	t.Run("Should count every source by locality by default", func(t *testing.T) {
+		localityRepository := mocks.NewLocalityRepository(t)
+		localityRepository.On("Report", []string{"buyers", "carriers", "sellers", "warehouses"}, "locality").Return([]domain.CountReport{}, nil).Once()
+		sut := services.NewService(localityRepository, mocks.NewProvincyRepository(t), mocks.NewCountryRepository(t))
+
+		_, err := sut.Report(nil, "")
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should drop repeated sources", func(t *testing.T) {
+		localityRepository := mocks.NewLocalityRepository(t)
+		localityRepository.On("Report", []string{"sellers", "carriers"}, "country").Return([]domain.CountReport{}, nil).Once()
+		sut := services.NewService(localityRepository, mocks.NewProvincyRepository(t), mocks.NewCountryRepository(t))
+
+		_, err := sut.Report([]string{"sellers", " carriers", "sellers"}, "country")
+
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,37 @@
+package adapters
+
+import (
+	"database/sql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+)
+
+type buyerMySQLRepositoryAdapter struct {
+	db *sql.DB
+}
+
+func CreateBuyerMySQLRepository(db *sql.DB) usecases.BuyerRepository {
+	return &buyerMySQLRepositoryAdapter{
+		db: db,
+	}
+}
+
+func (r *buyerMySQLRepositoryAdapter) Exists(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM buyer WHERE id=? AND deleted_at IS NULL`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *buyerMySQLRepositoryAdapter) SetPriceList(buyerId int, priceListId *int) error {
+	const query = `UPDATE buyer SET price_list_id=? WHERE id=?`
+
+	_, err := r.db.Exec(query, priceListId, buyerId)
+
+	return err
+}
@@ -0,0 +1,217 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+)
+
+type PriceListController struct {
+	service usecases.PriceListService
+}
+
+func CreatePriceListController(pls usecases.PriceListService) *PriceListController {
+	return &PriceListController{
+		service: pls,
+	}
+}
+
+func (plc *PriceListController) CreatePriceList(ctx *gin.Context) {
+	var req priceListRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "name can't be empty",
+		})
+		return
+	}
+
+	pl, err := plc.service.Create(req.Name)
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"data": pl,
+	})
+}
+
+func (plc *PriceListController) GetAllPriceLists(ctx *gin.Context) {
+	pls, err := plc.service.GetAll()
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": pls,
+	})
+}
+
+func (plc *PriceListController) GetPriceListById(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	pl, err := plc.service.GetById(id)
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": pl,
+	})
+}
+
+func (plc *PriceListController) CreatePriceListItem(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req priceListItemRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, date := range []*string{req.ValidFrom, req.ValidTo} {
+		if date == nil {
+			continue
+		}
+
+		if _, err := time.Parse("2006-01-02", *date); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "dates must be in the format yyyy-mm-dd",
+			})
+			return
+		}
+	}
+
+	item, err := plc.service.CreateItem(id, req.ProductId, req.Price, req.DiscountPercent, req.ValidFrom, req.ValidTo)
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"data": item,
+	})
+}
+
+func (plc *PriceListController) DeletePriceListItem(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("item_id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid item_id",
+		})
+		return
+	}
+
+	if err := plc.service.DeleteItem(id, itemId); err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, gin.H{})
+}
+
+func (plc *PriceListController) AssignPriceListToBuyer(ctx *gin.Context) {
+	buyerId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req assignRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if err := plc.service.AssignToBuyer(buyerId, req.PriceListId); err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": gin.H{
+			"buyer_id":      buyerId,
+			"price_list_id": req.PriceListId,
+		},
+	})
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrPriceListNotFound), errors.Is(err, usecases.ErrItemNotFound), errors.Is(err, usecases.ErrBuyerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrNameInUse):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrInvalidProductId), errors.Is(err, usecases.ErrInvalidPriceRule), errors.Is(err, usecases.ErrInvalidPrice),
+		errors.Is(err, usecases.ErrInvalidDiscount), errors.Is(err, usecases.ErrInvalidValidity):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
+
+type priceListRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type priceListItemRequest struct {
+	ProductId       int            `json:"product_id" binding:"required"`
+	Price           *money.Money   `json:"price"`
+	DiscountPercent *money.Percent `json:"discount_percent"`
+	ValidFrom       *string        `json:"valid_from"`
+	ValidTo         *string        `json:"valid_to"`
+}
+
+type assignRequest struct {
+	PriceListId *int `json:"price_list_id"`
+}
@@ -0,0 +1,166 @@
+package adapters_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeSut(t *testing.T) (*gin.Engine, *mocks.PriceListService) {
+	gin.SetMode(gin.TestMode)
+	mockPriceListService := mocks.NewPriceListService(t)
+	sut := adapters.CreatePriceListController(mockPriceListService)
+	r := gin.Default()
+	r.POST("/priceLists", sut.CreatePriceList)
+	r.GET("/priceLists/:id", sut.GetPriceListById)
+	r.POST("/priceLists/:id/items", sut.CreatePriceListItem)
+	r.DELETE("/priceLists/:id/items/:item_id", sut.DeletePriceListItem)
+	r.PATCH("/buyers/:id/priceList", sut.AssignPriceListToBuyer)
+	return r, mockPriceListService
+}
+
+func TestCreatePriceList(t *testing.T) {
+	t.Run("Should return 400 if name is blank", func(t *testing.T) {
+		r, _ := makeSut(t)
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/priceLists", bytes.NewBufferString(`{"name": "  "}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"name can't be empty\"}", rr.Body.String())
+	})
+
+	t.Run("Should return 409 if name is in use", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("Create", "wholesale").Return(domain.PriceList{}, usecases.ErrNameInUse).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/priceLists", bytes.NewBufferString(`{"name": "wholesale"}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Should return 201 and the price list on success", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("Create", "wholesale").Return(domain.PriceList{Id: 1, Name: "wholesale"}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/priceLists", bytes.NewBufferString(`{"name": "wholesale"}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"name\":\"wholesale\"}}", rr.Body.String())
+	})
+}
+
+func TestGetPriceListById(t *testing.T) {
+	t.Run("Should return 404 if the price list does not exist", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("GetById", 1).Return(domain.PriceList{}, usecases.ErrPriceListNotFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/priceLists/1", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return the price list with its items", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		price := money.FromCents(850)
+		pl := domain.PriceList{Id: 1, Name: "wholesale", Items: domain.PriceListItems{{Id: 2, PriceListId: 1, ProductId: 3, Price: &price}}}
+		mockPriceListService.On("GetById", 1).Return(pl, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/priceLists/1", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"name\":\"wholesale\",\"items\":[{\"id\":2,\"price_list_id\":1,\"product_id\":3,\"price\":8.50,\"discount_percent\":null,\"valid_from\":null,\"valid_to\":null}]}}", rr.Body.String())
+	})
+}
+
+func TestCreatePriceListItem(t *testing.T) {
+	t.Run("Should return 400 if a date is malformed", func(t *testing.T) {
+		r, _ := makeSut(t)
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/priceLists/1/items", bytes.NewBufferString(`{"product_id": 1, "price": "8.50", "valid_from": "01/01/2022"}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should return 422 if the service rejects the price rule", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("CreateItem", 1, 1, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(domain.PriceListItem{}, usecases.ErrInvalidPriceRule).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/priceLists/1/items", bytes.NewBufferString(`{"product_id": 1}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "{\"error\":\"either price or discount_percent must be informed\"}", rr.Body.String())
+	})
+
+	t.Run("Should return 201 on success", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		discount := money.FromBasisPoints(1250)
+		mockPriceListService.On("CreateItem", 1, 1, (*money.Money)(nil), &discount, mock.Anything, mock.Anything).Return(domain.PriceListItem{Id: 1, PriceListId: 1, ProductId: 1, DiscountPercent: &discount}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/priceLists/1/items", bytes.NewBufferString(`{"product_id": 1, "discount_percent": 12.5}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+}
+
+func TestDeletePriceListItem(t *testing.T) {
+	t.Run("Should return 404 if the item does not exist", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("DeleteItem", 1, 2).Return(usecases.ErrItemNotFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/priceLists/1/items/2", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return 204 on success", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("DeleteItem", 1, 2).Return(nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/priceLists/1/items/2", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+}
+
+func TestAssignPriceListToBuyer(t *testing.T) {
+	t.Run("Should return 404 if the buyer does not exist", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("AssignToBuyer", 1, mock.Anything).Return(usecases.ErrBuyerNotFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/buyers/1/priceList", bytes.NewBufferString(`{"price_list_id": 2}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should unassign the price list when price_list_id is null", func(t *testing.T) {
+		r, mockPriceListService := makeSut(t)
+		mockPriceListService.On("AssignToBuyer", 1, (*int)(nil)).Return(nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/buyers/1/priceList", bytes.NewBufferString(`{"price_list_id": null}`))
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":{\"buyer_id\":1,\"price_list_id\":null}}", rr.Body.String())
+	})
+}
@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+)
+
+type priceListMySQLRepositoryAdapter struct {
+	db *sql.DB
+}
+
+func CreatePriceListMySQLRepository(db *sql.DB) usecases.PriceListRepository {
+	return &priceListMySQLRepositoryAdapter{
+		db: db,
+	}
+}
+
+func (r *priceListMySQLRepositoryAdapter) Create(name string) (domain.PriceList, error) {
+	const query = `INSERT INTO price_list (name) VALUES (?)`
+
+	res, err := r.db.Exec(query, name)
+
+	if err != nil {
+		return domain.PriceList{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return domain.PriceList{}, err
+	}
+
+	return domain.PriceList{
+		Id:   int(id),
+		Name: name,
+	}, nil
+}
+
+func (r *priceListMySQLRepositoryAdapter) GetAll() (domain.PriceLists, error) {
+	const query = `SELECT id, name FROM price_list ORDER BY id`
+
+	rows, err := r.db.Query(query)
+
+	if err != nil {
+		return domain.PriceLists{}, err
+	}
+
+	defer rows.Close()
+
+	pls := domain.PriceLists{}
+
+	for rows.Next() {
+		pl := domain.PriceList{}
+
+		if err := rows.Scan(&pl.Id, &pl.Name); err != nil {
+			return domain.PriceLists{}, err
+		}
+
+		pls = append(pls, pl)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.PriceLists{}, err
+	}
+
+	return pls, nil
+}
+
+func (r *priceListMySQLRepositoryAdapter) GetById(id int) (domain.PriceList, error) {
+	const query = `SELECT id, name FROM price_list WHERE id=?`
+
+	pl := domain.PriceList{}
+	err := r.db.QueryRow(query, id).Scan(&pl.Id, &pl.Name)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PriceList{}, usecases.ErrPriceListNotFound
+	}
+
+	if err != nil {
+		return domain.PriceList{}, err
+	}
+
+	const itemsQuery = `SELECT id, price_list_id, product_id, price, discount_percent, DATE_FORMAT(valid_from, '%Y-%m-%d'), DATE_FORMAT(valid_to, '%Y-%m-%d') FROM price_list_item
+	WHERE price_list_id=? ORDER BY product_id, valid_from, id`
+
+	rows, err := r.db.Query(itemsQuery, id)
+
+	if err != nil {
+		return domain.PriceList{}, err
+	}
+
+	defer rows.Close()
+
+	pl.Items = domain.PriceListItems{}
+
+	for rows.Next() {
+		i := domain.PriceListItem{}
+
+		if err := rows.Scan(&i.Id, &i.PriceListId, &i.ProductId, &i.Price, &i.DiscountPercent, &i.ValidFrom, &i.ValidTo); err != nil {
+			return domain.PriceList{}, err
+		}
+
+		pl.Items = append(pl.Items, i)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.PriceList{}, err
+	}
+
+	return pl, nil
+}
+
+func (r *priceListMySQLRepositoryAdapter) GetByName(name string) (domain.PriceList, error) {
+	const query = `SELECT id, name FROM price_list WHERE name=?`
+
+	pl := domain.PriceList{}
+	err := r.db.QueryRow(query, name).Scan(&pl.Id, &pl.Name)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.PriceList{}, usecases.ErrPriceListNotFound
+	}
+
+	if err != nil {
+		return domain.PriceList{}, err
+	}
+
+	return pl, nil
+}
+
+func (r *priceListMySQLRepositoryAdapter) CreateItem(item domain.PriceListItem) (domain.PriceListItem, error) {
+	const query = `INSERT INTO price_list_item (price_list_id, product_id, price, discount_percent, valid_from, valid_to) VALUES (?, ?, ?, ?, ?, ?)`
+
+	res, err := r.db.Exec(query, item.PriceListId, item.ProductId, item.Price, item.DiscountPercent, item.ValidFrom, item.ValidTo)
+
+	if err != nil {
+		return domain.PriceListItem{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		return domain.PriceListItem{}, err
+	}
+
+	item.Id = int(id)
+
+	return item, nil
+}
+
+func (r *priceListMySQLRepositoryAdapter) DeleteItem(priceListId int, id int) error {
+	const query = `DELETE FROM price_list_item WHERE id=? AND price_list_id=?`
+
+	res, err := r.db.Exec(query, id, priceListId)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return usecases.ErrItemNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package adapters_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeRepositorySut(t *testing.T) (usecases.PriceListRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	return adapters.CreatePriceListMySQLRepository(db), mock
+}
+
+func TestRepositoryGetById(t *testing.T) {
+	t.Run("Should return ErrPriceListNotFound if there is no price list", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectQuery("SELECT id, name FROM price_list WHERE id").WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetById(1)
+
+		assert.ErrorIs(t, err, usecases.ErrPriceListNotFound)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return the price list with its items", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectQuery("SELECT id, name FROM price_list WHERE id").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "wholesale"))
+		items := sqlmock.NewRows([]string{"id", "price_list_id", "product_id", "price", "discount_percent", "valid_from", "valid_to"})
+		items.AddRow(1, 1, 1, "8.50", nil, nil, nil)
+		items.AddRow(2, 1, 2, nil, 10.0, "2022-01-01", "2022-12-31")
+		mock.ExpectQuery("FROM price_list_item").WithArgs(1).WillReturnRows(items)
+
+		result, err := sut.GetById(1)
+
+		price := money.FromCents(850)
+		discount := money.FromBasisPoints(1000)
+		from, to := "2022-01-01", "2022-12-31"
+		expected := domain.PriceList{Id: 1, Name: "wholesale", Items: domain.PriceListItems{
+			{Id: 1, PriceListId: 1, ProductId: 1, Price: &price},
+			{Id: 2, PriceListId: 1, ProductId: 2, DiscountPercent: &discount, ValidFrom: &from, ValidTo: &to},
+		}}
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryDeleteItem(t *testing.T) {
+	t.Run("Should return ErrItemNotFound if no item was deleted", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectExec("DELETE FROM price_list_item").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := sut.DeleteItem(1, 2)
+
+		assert.ErrorIs(t, err, usecases.ErrItemNotFound)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return nil on success", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectExec("DELETE FROM price_list_item").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := sut.DeleteItem(1, 2)
+
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"database/sql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+)
+
+type productMySQLRepositoryAdapter struct {
+	db *sql.DB
+}
+
+func CreateProductMySQLRepository(db *sql.DB) usecases.ProductRepository {
+	return &productMySQLRepositoryAdapter{
+		db: db,
+	}
+}
+
+func (r *productMySQLRepositoryAdapter) Exists(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM product WHERE id=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
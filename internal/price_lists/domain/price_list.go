@@ -0,0 +1,37 @@
+package domain
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
+type PriceList struct {
+	Id    int            `json:"id"`
+	Name  string         `json:"name"`
+	Items PriceListItems `json:"items,omitempty"`
+}
+
+type PriceLists []PriceList
+
+// PriceListItem sets either a fixed price or a percentage discount over the
+// product's sale price, optionally limited to a validity window.
+type PriceListItem struct {
+	Id              int            `json:"id"`
+	PriceListId     int            `json:"price_list_id"`
+	ProductId       int            `json:"product_id"`
+	Price           *money.Money   `json:"price"`
+	DiscountPercent *money.Percent `json:"discount_percent"`
+	ValidFrom       *string        `json:"valid_from"`
+	ValidTo         *string        `json:"valid_to"`
+}
+
+type PriceListItems []PriceListItem
+
+func (i PriceListItem) Apply(salePrice money.Money) money.Money {
+	if i.Price != nil {
+		return *i.Price
+	}
+
+	if i.DiscountPercent != nil {
+		return salePrice.Discount(*i.DiscountPercent)
+	}
+
+	return salePrice
+}
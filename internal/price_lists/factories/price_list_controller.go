@@ -0,0 +1,17 @@
+package factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+)
+
+func MakePriceListController() *adapters.PriceListController {
+	plr := adapters.CreatePriceListMySQLRepository(db.GetInstance())
+	pr := adapters.CreateProductMySQLRepository(db.GetInstance())
+	br := adapters.CreateBuyerMySQLRepository(db.GetInstance())
+	pls := usecases.CreatePriceListService(plr, pr, br)
+	plc := adapters.CreatePriceListController(pls)
+
+	return plc
+}
@@ -0,0 +1,6 @@
+package usecases
+
+type BuyerRepository interface {
+	Exists(id int) (bool, error)
+	SetPriceList(buyerId int, priceListId *int) error
+}
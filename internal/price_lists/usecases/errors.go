@@ -0,0 +1,21 @@
+package usecases
+
+import "errors"
+
+var ErrPriceListNotFound = errors.New("price list not found")
+
+var ErrItemNotFound = errors.New("price list item not found")
+
+var ErrBuyerNotFound = errors.New("buyer not found")
+
+var ErrNameInUse = errors.New("this name is in use")
+
+var ErrInvalidProductId = errors.New("this product_id is invalid")
+
+var ErrInvalidPriceRule = errors.New("either price or discount_percent must be informed")
+
+var ErrInvalidPrice = errors.New("price can't be negative")
+
+var ErrInvalidDiscount = errors.New("discount_percent must be greater than 0 and at most 100")
+
+var ErrInvalidValidity = errors.New("valid_from must be before or equal to valid_to")
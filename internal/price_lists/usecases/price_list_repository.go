@@ -0,0 +1,12 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
+
+type PriceListRepository interface {
+	Create(name string) (domain.PriceList, error)
+	GetAll() (domain.PriceLists, error)
+	GetById(id int) (domain.PriceList, error)
+	GetByName(name string) (domain.PriceList, error)
+	CreateItem(item domain.PriceListItem) (domain.PriceListItem, error)
+	DeleteItem(priceListId int, id int) error
+}
@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+)
+
+type PriceListService interface {
+	Create(name string) (domain.PriceList, error)
+	GetAll() (domain.PriceLists, error)
+	GetById(id int) (domain.PriceList, error)
+	CreateItem(priceListId int, productId int, price *money.Money, discountPercent *money.Percent, validFrom *string, validTo *string) (domain.PriceListItem, error)
+	DeleteItem(priceListId int, id int) error
+	AssignToBuyer(buyerId int, priceListId *int) error
+}
+
+type priceListService struct {
+	priceListRepository PriceListRepository
+	productRepository   ProductRepository
+	buyerRepository     BuyerRepository
+}
+
+func CreatePriceListService(pr PriceListRepository, prr ProductRepository, br BuyerRepository) PriceListService {
+	return &priceListService{
+		priceListRepository: pr,
+		productRepository:   prr,
+		buyerRepository:     br,
+	}
+}
+
+func (s *priceListService) Create(name string) (domain.PriceList, error) {
+	_, err := s.priceListRepository.GetByName(name)
+
+	if err == nil {
+		return domain.PriceList{}, ErrNameInUse
+	}
+
+	if !errors.Is(err, ErrPriceListNotFound) {
+		return domain.PriceList{}, err
+	}
+
+	return s.priceListRepository.Create(name)
+}
+
+func (s *priceListService) GetAll() (domain.PriceLists, error) {
+	return s.priceListRepository.GetAll()
+}
+
+func (s *priceListService) GetById(id int) (domain.PriceList, error) {
+	return s.priceListRepository.GetById(id)
+}
+
+func (s *priceListService) CreateItem(priceListId int, productId int, price *money.Money, discountPercent *money.Percent, validFrom *string, validTo *string) (domain.PriceListItem, error) {
+	if (price == nil) == (discountPercent == nil) {
+		return domain.PriceListItem{}, ErrInvalidPriceRule
+	}
+
+	if price != nil && price.IsNegative() {
+		return domain.PriceListItem{}, ErrInvalidPrice
+	}
+
+	if discountPercent != nil && (discountPercent.BasisPoints() <= 0 || discountPercent.BasisPoints() > 10000) {
+		return domain.PriceListItem{}, ErrInvalidDiscount
+	}
+
+	if validFrom != nil && validTo != nil && *validFrom > *validTo {
+		return domain.PriceListItem{}, ErrInvalidValidity
+	}
+
+	if _, err := s.priceListRepository.GetById(priceListId); err != nil {
+		return domain.PriceListItem{}, err
+	}
+
+	exists, err := s.productRepository.Exists(productId)
+
+	if err != nil {
+		return domain.PriceListItem{}, err
+	}
+
+	if !exists {
+		return domain.PriceListItem{}, ErrInvalidProductId
+	}
+
+	return s.priceListRepository.CreateItem(domain.PriceListItem{
+		PriceListId:     priceListId,
+		ProductId:       productId,
+		Price:           price,
+		DiscountPercent: discountPercent,
+		ValidFrom:       validFrom,
+		ValidTo:         validTo,
+	})
+}
+
+func (s *priceListService) DeleteItem(priceListId int, id int) error {
+	return s.priceListRepository.DeleteItem(priceListId, id)
+}
+
+// AssignToBuyer sets the buyer's price list, or removes it when priceListId
+// is nil so the buyer goes back to the products' sale prices.
+func (s *priceListService) AssignToBuyer(buyerId int, priceListId *int) error {
+	exists, err := s.buyerRepository.Exists(buyerId)
+
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrBuyerNotFound
+	}
+
+	if priceListId != nil {
+		if _, err := s.priceListRepository.GetById(*priceListId); err != nil {
+			return err
+		}
+	}
+
+	return s.buyerRepository.SetPriceList(buyerId, priceListId)
+}
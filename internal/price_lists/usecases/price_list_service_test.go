@@ -0,0 +1,155 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeSut(t *testing.T) (usecases.PriceListService, *mocks.PriceListRepository, *mocks.ProductRepository, *mocks.BuyerRepository) {
+	mockPriceListRepository := mocks.NewPriceListRepository(t)
+	mockProductRepository := mocks.NewProductRepository(t)
+	mockBuyerRepository := mocks.NewBuyerRepository(t)
+	sut := usecases.CreatePriceListService(mockPriceListRepository, mockProductRepository, mockBuyerRepository)
+	return sut, mockPriceListRepository, mockProductRepository, mockBuyerRepository
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should return ErrNameInUse if there is a price list with the same name", func(t *testing.T) {
+		sut, mockPriceListRepository, _, _ := makeSut(t)
+		mockPriceListRepository.On("GetByName", "wholesale").Return(domain.PriceList{Id: 1, Name: "wholesale"}, nil).Once()
+
+		_, err := sut.Create("wholesale")
+
+		assert.ErrorIs(t, err, usecases.ErrNameInUse)
+	})
+
+	t.Run("Should return an error if GetByName fails", func(t *testing.T) {
+		sut, mockPriceListRepository, _, _ := makeSut(t)
+		mockPriceListRepository.On("GetByName", "wholesale").Return(domain.PriceList{}, errors.New("any_error")).Once()
+
+		_, err := sut.Create("wholesale")
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should create the price list on success", func(t *testing.T) {
+		sut, mockPriceListRepository, _, _ := makeSut(t)
+		mockPriceListRepository.On("GetByName", "wholesale").Return(domain.PriceList{}, usecases.ErrPriceListNotFound).Once()
+		mockPriceListRepository.On("Create", "wholesale").Return(domain.PriceList{Id: 1, Name: "wholesale"}, nil).Once()
+
+		pl, err := sut.Create("wholesale")
+
+		assert.Nil(t, err)
+		assert.Equal(t, domain.PriceList{Id: 1, Name: "wholesale"}, pl)
+	})
+}
+
+func TestCreateItem(t *testing.T) {
+	price := money.FromCents(850)
+	discount := money.FromBasisPoints(1000)
+	from, to := "2022-02-01", "2022-01-01"
+
+	t.Run("Should require exactly one of price and discount_percent", func(t *testing.T) {
+		sut, _, _, _ := makeSut(t)
+
+		_, err := sut.CreateItem(1, 1, nil, nil, nil, nil)
+		assert.ErrorIs(t, err, usecases.ErrInvalidPriceRule)
+
+		_, err = sut.CreateItem(1, 1, &price, &discount, nil, nil)
+		assert.ErrorIs(t, err, usecases.ErrInvalidPriceRule)
+	})
+
+	t.Run("Should validate the discount and the validity window", func(t *testing.T) {
+		sut, _, _, _ := makeSut(t)
+		invalidDiscount := money.FromBasisPoints(12000)
+
+		_, err := sut.CreateItem(1, 1, nil, &invalidDiscount, nil, nil)
+		assert.ErrorIs(t, err, usecases.ErrInvalidDiscount)
+
+		_, err = sut.CreateItem(1, 1, &price, nil, &from, &to)
+		assert.ErrorIs(t, err, usecases.ErrInvalidValidity)
+	})
+
+	t.Run("Should return ErrPriceListNotFound if the price list does not exist", func(t *testing.T) {
+		sut, mockPriceListRepository, _, _ := makeSut(t)
+		mockPriceListRepository.On("GetById", 1).Return(domain.PriceList{}, usecases.ErrPriceListNotFound).Once()
+
+		_, err := sut.CreateItem(1, 1, &price, nil, nil, nil)
+
+		assert.ErrorIs(t, err, usecases.ErrPriceListNotFound)
+	})
+
+	t.Run("Should return ErrInvalidProductId if the product does not exist", func(t *testing.T) {
+		sut, mockPriceListRepository, mockProductRepository, _ := makeSut(t)
+		mockPriceListRepository.On("GetById", 1).Return(domain.PriceList{Id: 1}, nil).Once()
+		mockProductRepository.On("Exists", 9).Return(false, nil).Once()
+
+		_, err := sut.CreateItem(1, 9, &price, nil, nil, nil)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidProductId)
+	})
+
+	t.Run("Should create the item on success", func(t *testing.T) {
+		sut, mockPriceListRepository, mockProductRepository, _ := makeSut(t)
+		mockPriceListRepository.On("GetById", 1).Return(domain.PriceList{Id: 1}, nil).Once()
+		mockProductRepository.On("Exists", 1).Return(true, nil).Once()
+		mockPriceListRepository.On("CreateItem", mock.AnythingOfType("domain.PriceListItem")).Return(domain.PriceListItem{Id: 3, PriceListId: 1, ProductId: 1, DiscountPercent: &discount}, nil).Once()
+
+		item, err := sut.CreateItem(1, 1, nil, &discount, nil, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, item.Id)
+		mockPriceListRepository.AssertCalled(t, "CreateItem", domain.PriceListItem{PriceListId: 1, ProductId: 1, DiscountPercent: &discount})
+	})
+}
+
+func TestAssignToBuyer(t *testing.T) {
+	priceListId := 2
+
+	t.Run("Should return ErrBuyerNotFound if the buyer does not exist", func(t *testing.T) {
+		sut, _, _, mockBuyerRepository := makeSut(t)
+		mockBuyerRepository.On("Exists", 1).Return(false, nil).Once()
+
+		err := sut.AssignToBuyer(1, &priceListId)
+
+		assert.ErrorIs(t, err, usecases.ErrBuyerNotFound)
+	})
+
+	t.Run("Should return ErrPriceListNotFound if the price list does not exist", func(t *testing.T) {
+		sut, mockPriceListRepository, _, mockBuyerRepository := makeSut(t)
+		mockBuyerRepository.On("Exists", 1).Return(true, nil).Once()
+		mockPriceListRepository.On("GetById", 2).Return(domain.PriceList{}, usecases.ErrPriceListNotFound).Once()
+
+		err := sut.AssignToBuyer(1, &priceListId)
+
+		assert.ErrorIs(t, err, usecases.ErrPriceListNotFound)
+	})
+
+	t.Run("Should remove the price list without looking it up", func(t *testing.T) {
+		sut, _, _, mockBuyerRepository := makeSut(t)
+		mockBuyerRepository.On("Exists", 1).Return(true, nil).Once()
+		mockBuyerRepository.On("SetPriceList", 1, (*int)(nil)).Return(nil).Once()
+
+		err := sut.AssignToBuyer(1, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should assign the price list on success", func(t *testing.T) {
+		sut, mockPriceListRepository, _, mockBuyerRepository := makeSut(t)
+		mockBuyerRepository.On("Exists", 1).Return(true, nil).Once()
+		mockPriceListRepository.On("GetById", 2).Return(domain.PriceList{Id: 2}, nil).Once()
+		mockBuyerRepository.On("SetPriceList", 1, &priceListId).Return(nil).Once()
+
+		err := sut.AssignToBuyer(1, &priceListId)
+
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,5 @@
+package usecases
+
+type ProductRepository interface {
+	Exists(id int) (bool, error)
+}
@@ -14,20 +14,64 @@ type Repository struct {
 	mock.Mock
 }
 
-// Add provides a mock function with given fields: ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID
-func (_m *Repository) Add(ctx context.Context, id int, batchNumber int, currentQuantity int, currentTemperature int, dueDate string, initialQuantity int, manufacturingDate string, manufacturingHour int, minimumTemperature int, productID int, sectionID int) (product_batch.ProductBatch, error) {
-	ret := _m.Called(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID)
+// Add provides a mock function with given fields: ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID, status
+func (_m *Repository) Add(ctx context.Context, id int, batchNumber int, currentQuantity int, currentTemperature int, dueDate string, initialQuantity int, manufacturingDate string, manufacturingHour int, minimumTemperature int, productID int, sectionID int, status string) (product_batch.ProductBatch, error) {
+	ret := _m.Called(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID, status)
 
 	var r0 product_batch.ProductBatch
-	if rf, ok := ret.Get(0).(func(context.Context, int, int, int, int, string, int, string, int, int, int, int) product_batch.ProductBatch); ok {
-		r0 = rf(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int, int, string, int, string, int, int, int, int, string) product_batch.ProductBatch); ok {
+		r0 = rf(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID, status)
 	} else {
 		r0 = ret.Get(0).(product_batch.ProductBatch)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, int, int, int, int, string, int, string, int, int, int, int) error); ok {
-		r1 = rf(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID)
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int, int, string, int, string, int, int, int, int, string) error); ok {
+		r1 = rf(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: ctx, id
+func (_m *Repository) FindById(ctx context.Context, id int) (product_batch.ProductBatch, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 product_batch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, int) product_batch.ProductBatch); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(product_batch.ProductBatch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllocatable provides a mock function with given fields: ctx, productID, warehouseID
+func (_m *Repository) GetAllocatable(ctx context.Context, productID int, warehouseID int) ([]product_batch.ProductBatch, error) {
+	ret := _m.Called(ctx, productID, warehouseID)
+
+	var r0 []product_batch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []product_batch.ProductBatch); ok {
+		r0 = rf(ctx, productID, warehouseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]product_batch.ProductBatch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, productID, warehouseID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,6 +102,29 @@ func (_m *Repository) GetById(ctx context.Context, id int) ([]product_batch.Prod
 	return r0, r1
 }
 
+// GetHolds provides a mock function with given fields: ctx, id
+func (_m *Repository) GetHolds(ctx context.Context, id int) ([]product_batch.BatchHold, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []product_batch.BatchHold
+	if rf, ok := ret.Get(0).(func(context.Context, int) []product_batch.BatchHold); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]product_batch.BatchHold)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HasBatchNumber provides a mock function with given fields: ctx, number
 func (_m *Repository) HasBatchNumber(ctx context.Context, number int) (bool, error) {
 	ret := _m.Called(ctx, number)
@@ -79,6 +146,27 @@ func (_m *Repository) HasBatchNumber(ctx context.Context, number int) (bool, err
 	return r0, r1
 }
 
+// IsQuarantineSection provides a mock function with given fields: ctx, sectionID
+func (_m *Repository) IsQuarantineSection(ctx context.Context, sectionID int) (bool, error) {
+	ret := _m.Called(ctx, sectionID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, sectionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LastID provides a mock function with given fields: ctx
 func (_m *Repository) LastID(ctx context.Context) (int, error) {
 	ret := _m.Called(ctx)
@@ -100,6 +188,48 @@ func (_m *Repository) LastID(ctx context.Context) (int, error) {
 	return r0, r1
 }
 
+// PlaceHold provides a mock function with given fields: ctx, id, reason, at
+func (_m *Repository) PlaceHold(ctx context.Context, id int, reason string, at string) (product_batch.BatchHold, error) {
+	ret := _m.Called(ctx, id, reason, at)
+
+	var r0 product_batch.BatchHold
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) product_batch.BatchHold); ok {
+		r0 = rf(ctx, id, reason, at)
+	} else {
+		r0 = ret.Get(0).(product_batch.BatchHold)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
+		r1 = rf(ctx, id, reason, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseHold provides a mock function with given fields: ctx, id, reason, at, status
+func (_m *Repository) ReleaseHold(ctx context.Context, id int, reason string, at string, status string) (product_batch.BatchHold, error) {
+	ret := _m.Called(ctx, id, reason, at, status)
+
+	var r0 product_batch.BatchHold
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, string) product_batch.BatchHold); ok {
+		r0 = rf(ctx, id, reason, at, status)
+	} else {
+		r0 = ret.Get(0).(product_batch.BatchHold)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string, string) error); ok {
+		r1 = rf(ctx, id, reason, at, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -35,6 +35,27 @@ func (_m *Service) Add(ctx context.Context, batchNumber int, currentQuantity int
 	return r0, r1
 }
 
+// Allocate provides a mock function with given fields: ctx, productID, warehouseID, quantity
+func (_m *Service) Allocate(ctx context.Context, productID int, warehouseID int, quantity int) (product_batch.Allocation, error) {
+	ret := _m.Called(ctx, productID, warehouseID, quantity)
+
+	var r0 product_batch.Allocation
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) product_batch.Allocation); ok {
+		r0 = rf(ctx, productID, warehouseID, quantity)
+	} else {
+		r0 = ret.Get(0).(product_batch.Allocation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, productID, warehouseID, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetById provides a mock function with given fields: ctx, id
 func (_m *Service) GetById(ctx context.Context, id int) ([]product_batch.ProductsReport, error) {
 	ret := _m.Called(ctx, id)
@@ -58,6 +79,29 @@ func (_m *Service) GetById(ctx context.Context, id int) ([]product_batch.Product
 	return r0, r1
 }
 
+// GetHolds provides a mock function with given fields: ctx, id
+func (_m *Service) GetHolds(ctx context.Context, id int) ([]product_batch.BatchHold, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []product_batch.BatchHold
+	if rf, ok := ret.Get(0).(func(context.Context, int) []product_batch.BatchHold); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]product_batch.BatchHold)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HasBatchNumber provides a mock function with given fields: ctx, number
 func (_m *Service) HasBatchNumber(ctx context.Context, number int) (bool, error) {
 	ret := _m.Called(ctx, number)
@@ -79,6 +123,27 @@ func (_m *Service) HasBatchNumber(ctx context.Context, number int) (bool, error)
 	return r0, r1
 }
 
+// Hold provides a mock function with given fields: ctx, id, reason
+func (_m *Service) Hold(ctx context.Context, id int, reason string) (product_batch.BatchHold, error) {
+	ret := _m.Called(ctx, id, reason)
+
+	var r0 product_batch.BatchHold
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) product_batch.BatchHold); ok {
+		r0 = rf(ctx, id, reason)
+	} else {
+		r0 = ret.Get(0).(product_batch.BatchHold)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LastID provides a mock function with given fields: ctx
 func (_m *Service) LastID(ctx context.Context) (int, error) {
 	ret := _m.Called(ctx)
@@ -100,6 +165,27 @@ func (_m *Service) LastID(ctx context.Context) (int, error) {
 	return r0, r1
 }
 
+// Release provides a mock function with given fields: ctx, id, reason
+func (_m *Service) Release(ctx context.Context, id int, reason string) (product_batch.BatchHold, error) {
+	ret := _m.Called(ctx, id, reason)
+
+	var r0 product_batch.BatchHold
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) product_batch.BatchHold); ok {
+		r0 = rf(ctx, id, reason)
+	} else {
+		r0 = ret.Get(0).(product_batch.BatchHold)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,6 +12,39 @@ type ProductBatch struct {
 	MinimumTemperature int    `json:"minimum_temperature"`
 	ProductID          int    `json:"product_id"`
 	SectionID          int    `json:"section_id"`
+	Status             string `json:"status"`
+}
+
+const (
+	StatusAvailable   = "available"
+	StatusOnHold      = "on_hold"
+	StatusQuarantined = "quarantined"
+	StatusExpired     = "expired"
+)
+
+type BatchHold struct {
+	ID             int     `json:"id"`
+	ProductBatchID int     `json:"product_batch_id"`
+	Reason         string  `json:"reason"`
+	PlacedAt       string  `json:"placed_at"`
+	ReleaseReason  *string `json:"release_reason"`
+	ReleasedAt     *string `json:"released_at"`
+}
+
+type AllocatedBatch struct {
+	ProductBatchID int    `json:"product_batch_id"`
+	BatchNumber    int    `json:"batch_number"`
+	SectionID      int    `json:"section_id"`
+	DueDate        string `json:"due_date"`
+	Quantity       int    `json:"quantity"`
+}
+
+type Allocation struct {
+	ProductID int              `json:"product_id"`
+	Requested int              `json:"requested"`
+	Allocated int              `json:"allocated"`
+	Missing   int              `json:"missing"`
+	Batches   []AllocatedBatch `json:"batches"`
 }
 
 type ProductsReport struct {
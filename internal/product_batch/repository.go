@@ -6,7 +6,13 @@ import (
 
 type Repository interface {
 	GetById(ctx context.Context, id int) ([]ProductsReport, error)
-	Add(ctx context.Context, id int, batchNumber int, currentQuantity int, currentTemperature int, dueDate string, initialQuantity int, manufacturingDate string, manufacturingHour int, minimumTemperature int, productID int, sectionID int) (ProductBatch, error)
+	Add(ctx context.Context, id int, batchNumber int, currentQuantity int, currentTemperature int, dueDate string, initialQuantity int, manufacturingDate string, manufacturingHour int, minimumTemperature int, productID int, sectionID int, status string) (ProductBatch, error)
 	LastID(ctx context.Context) (int, error)
 	HasBatchNumber(ctx context.Context, number int) (bool, error)
+	FindById(ctx context.Context, id int) (ProductBatch, error)
+	IsQuarantineSection(ctx context.Context, sectionID int) (bool, error)
+	PlaceHold(ctx context.Context, id int, reason string, at string) (BatchHold, error)
+	ReleaseHold(ctx context.Context, id int, reason string, at string, status string) (BatchHold, error)
+	GetHolds(ctx context.Context, id int) ([]BatchHold, error)
+	GetAllocatable(ctx context.Context, productID int, warehouseID int) ([]ProductBatch, error)
 }
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/product_batch"
 )
 
@@ -45,7 +46,7 @@ func (m mySQLRepository) GetById(ctx context.Context, id int) ([]product_batch.P
 	return prl, nil
 }
 
-func (m mySQLRepository) Add(ctx context.Context, id int, batchNumber int, currentQuantity int, currentTemperature int, dueDate string, initialQuantity int, manufacturingDate string, manufacturingHour int, minimumTemperature int, productID int, sectionID int) (product_batch.ProductBatch, error) {
+func (m mySQLRepository) Add(ctx context.Context, id int, batchNumber int, currentQuantity int, currentTemperature int, dueDate string, initialQuantity int, manufacturingDate string, manufacturingHour int, minimumTemperature int, productID int, sectionID int, status string) (product_batch.ProductBatch, error) {
 	pb := product_batch.ProductBatch{
 		ID:                 id,
 		BatchNumber:        batchNumber,
@@ -58,11 +59,12 @@ func (m mySQLRepository) Add(ctx context.Context, id int, batchNumber int, curre
 		MinimumTemperature: minimumTemperature,
 		ProductID:          productID,
 		SectionID:          sectionID,
+		Status:             status,
 	}
 
 	_, err := m.db.ExecContext(
 		ctx,
-		"INSERT INTO product_batch (id, batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		"INSERT INTO product_batch (id, batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id, status) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 		id,
 		batchNumber,
 		currentQuantity,
@@ -74,6 +76,7 @@ func (m mySQLRepository) Add(ctx context.Context, id int, batchNumber int, curre
 		minimumTemperature,
 		productID,
 		sectionID,
+		status,
 	)
 
 	if err != nil {
@@ -116,3 +119,195 @@ func (m mySQLRepository) HasBatchNumber(ctx context.Context, number int) (bool,
 
 	return batchNumber.Valid, nil
 }
+
+func (m mySQLRepository) FindById(ctx context.Context, id int) (product_batch.ProductBatch, error) {
+	var pb product_batch.ProductBatch
+
+	row := m.db.QueryRowContext(
+		ctx,
+		"SELECT id, batch_number, current_quantity, current_temperature, DATE_FORMAT(due_date, '%Y-%m-%d'), initial_quantity, DATE_FORMAT(manufacturing_date, '%Y-%m-%d'), manufacturing_hour, minimum_temperature, product_id, section_id, status FROM product_batch WHERE id=?",
+		id,
+	)
+
+	err := row.Scan(&pb.ID, &pb.BatchNumber, &pb.CurrentQuantity, &pb.CurrentTemperature, &pb.DueDate, &pb.InitialQuantity, &pb.ManufacturingDate, &pb.ManufacturingHour, &pb.MinimumTemperature, &pb.ProductID, &pb.SectionID, &pb.Status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return product_batch.ProductBatch{}, product_batch.ErrBatchNotFound
+	}
+
+	if err != nil {
+		return product_batch.ProductBatch{}, err
+	}
+
+	return pb, nil
+}
+
+func (m mySQLRepository) IsQuarantineSection(ctx context.Context, sectionID int) (bool, error) {
+	var quarantine bool
+
+	row := m.db.QueryRowContext(ctx, "SELECT quarantine FROM section WHERE id=?", sectionID)
+
+	err := row.Scan(&quarantine)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return quarantine, nil
+}
+
+func (m mySQLRepository) PlaceHold(ctx context.Context, id int, reason string, at string) (product_batch.BatchHold, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, "UPDATE product_batch SET status=? WHERE id=? AND status<>?", product_batch.StatusOnHold, id, product_batch.StatusOnHold)
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	if affected == 0 {
+		return product_batch.BatchHold{}, product_batch.ErrAlreadyOnHold
+	}
+
+	res, err = tx.ExecContext(ctx, "INSERT INTO batch_hold (product_batch_id, reason, placed_at) VALUES (?, ?, ?)", id, reason, at)
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	holdID, err := res.LastInsertId()
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	return product_batch.BatchHold{
+		ID:             int(holdID),
+		ProductBatchID: id,
+		Reason:         reason,
+		PlacedAt:       at,
+	}, nil
+}
+
+func (m mySQLRepository) ReleaseHold(ctx context.Context, id int, reason string, at string, status string) (product_batch.BatchHold, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	defer tx.Rollback()
+
+	var hold product_batch.BatchHold
+
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT id, product_batch_id, reason, DATE_FORMAT(placed_at, '%Y-%m-%d %H:%i:%s') FROM batch_hold WHERE product_batch_id=? AND released_at IS NULL ORDER BY id DESC LIMIT 1",
+		id,
+	).Scan(&hold.ID, &hold.ProductBatchID, &hold.Reason, &hold.PlacedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return product_batch.BatchHold{}, product_batch.ErrNotOnHold
+	}
+
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE batch_hold SET release_reason=?, released_at=? WHERE id=?", reason, at, hold.ID)
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE product_batch SET status=? WHERE id=?", status, id)
+	if err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return product_batch.BatchHold{}, err
+	}
+
+	hold.ReleaseReason = &reason
+	hold.ReleasedAt = &at
+
+	return hold, nil
+}
+
+func (m mySQLRepository) GetHolds(ctx context.Context, id int) ([]product_batch.BatchHold, error) {
+	rows, err := m.db.QueryContext(
+		ctx,
+		"SELECT id, product_batch_id, reason, DATE_FORMAT(placed_at, '%Y-%m-%d %H:%i:%s'), release_reason, DATE_FORMAT(released_at, '%Y-%m-%d %H:%i:%s') FROM batch_hold WHERE product_batch_id=? ORDER BY placed_at, id",
+		id,
+	)
+	if err != nil {
+		return []product_batch.BatchHold{}, err
+	}
+
+	defer rows.Close()
+
+	holds := []product_batch.BatchHold{}
+
+	for rows.Next() {
+		var hold product_batch.BatchHold
+
+		if err := rows.Scan(&hold.ID, &hold.ProductBatchID, &hold.Reason, &hold.PlacedAt, &hold.ReleaseReason, &hold.ReleasedAt); err != nil {
+			return []product_batch.BatchHold{}, err
+		}
+
+		holds = append(holds, hold)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []product_batch.BatchHold{}, err
+	}
+
+	return holds, nil
+}
+
+// GetAllocatable returns the batches that may be picked for a product, earliest due date first.
+// A warehouseID of 0 matches every warehouse.
+func (m mySQLRepository) GetAllocatable(ctx context.Context, productID int, warehouseID int) ([]product_batch.ProductBatch, error) {
+	rows, err := m.db.QueryContext(
+		ctx,
+		"SELECT pb.id, pb.batch_number, pb.current_quantity, DATE_FORMAT(pb.due_date, '%Y-%m-%d'), pb.section_id FROM product_batch pb JOIN section s ON s.id = pb.section_id WHERE pb.product_id=? AND (?=0 OR s.warehouse_id=?) AND pb.status=? AND s.quarantine = 0 AND pb.due_date >= CURDATE() AND pb.current_quantity > 0 ORDER BY pb.due_date, pb.id",
+		productID,
+		warehouseID,
+		warehouseID,
+		product_batch.StatusAvailable,
+	)
+	if err != nil {
+		return []product_batch.ProductBatch{}, err
+	}
+
+	defer rows.Close()
+
+	batches := []product_batch.ProductBatch{}
+
+	for rows.Next() {
+		pb := product_batch.ProductBatch{ProductID: productID, Status: product_batch.StatusAvailable}
+
+		if err := rows.Scan(&pb.ID, &pb.BatchNumber, &pb.CurrentQuantity, &pb.DueDate, &pb.SectionID); err != nil {
+			return []product_batch.ProductBatch{}, err
+		}
+
+		batches = append(batches, pb)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []product_batch.ProductBatch{}, err
+	}
+
+	return batches, nil
+}
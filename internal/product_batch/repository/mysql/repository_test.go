@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/product_batch"
@@ -21,10 +22,10 @@ func TestAdd(t *testing.T) {
 	t.Run("create_ok", func(t *testing.T) {
 		mock.
 			ExpectExec("INSERT INTO product_batch").
-			WithArgs(1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1).
+			WithArgs(1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1, product_batch.StatusAvailable).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		pb, err := repo.Add(context.Background(), 1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1)
+		pb, err := repo.Add(context.Background(), 1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1, product_batch.StatusAvailable)
 
 		epb := product_batch.ProductBatch{
 			ID:                 1,
@@ -38,6 +39,7 @@ func TestAdd(t *testing.T) {
 			MinimumTemperature: 5,
 			ProductID:          1,
 			SectionID:          1,
+			Status:             product_batch.StatusAvailable,
 		}
 
 		assert.Nil(t, err)
@@ -50,10 +52,10 @@ func TestAdd(t *testing.T) {
 	t.Run("create_fail", func(t *testing.T) {
 		mock.
 			ExpectExec("INSERT INTO product_batch").
-			WithArgs(1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1).
+			WithArgs(1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1, product_batch.StatusAvailable).
 			WillReturnError(fmt.Errorf("error"))
 
-		pb, err := repo.Add(context.Background(), 1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1)
+		pb, err := repo.Add(context.Background(), 1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1, product_batch.StatusAvailable)
 
 		assert.Error(t, err)
 		assert.Equal(t, product_batch.ProductBatch{}, pb)
@@ -62,3 +64,202 @@ func TestAdd(t *testing.T) {
 	})
 
 }
+
+func TestFindById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+	repo := NewMySQLRepository(db)
+
+	columns := []string{"id", "batch_number", "current_quantity", "current_temperature", "due_date", "initial_quantity", "manufacturing_date", "manufacturing_hour", "minimum_temperature", "product_id", "section_id", "status"}
+
+	t.Run("find_by_id_ok", func(t *testing.T) {
+		mock.
+			ExpectQuery("SELECT (.+) FROM product_batch WHERE id=?").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 111, 200, 20, "2022-04-04", 20, "2022-04-04", 10, 5, 1, 1, "on_hold"))
+
+		pb, err := repo.FindById(context.Background(), 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, product_batch.StatusOnHold, pb.Status)
+		assert.Equal(t, "2022-04-04", pb.DueDate)
+	})
+
+	t.Run("find_by_id_not_found", func(t *testing.T) {
+		mock.
+			ExpectQuery("SELECT (.+) FROM product_batch WHERE id=?").
+			WithArgs(9).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.FindById(context.Background(), 9)
+
+		assert.ErrorIs(t, err, product_batch.ErrBatchNotFound)
+	})
+}
+
+func TestPlaceHold(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+	repo := NewMySQLRepository(db)
+
+	t.Run("place_hold_ok", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectExec("UPDATE product_batch SET status=\\? WHERE id=\\? AND status<>\\?").
+			WithArgs(product_batch.StatusOnHold, 1, product_batch.StatusOnHold).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.
+			ExpectExec("INSERT INTO batch_hold").
+			WithArgs(1, "recall", "2022-04-04 10:00:00").
+			WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectCommit()
+
+		hold, err := repo.PlaceHold(context.Background(), 1, "recall", "2022-04-04 10:00:00")
+
+		assert.Nil(t, err)
+		assert.Equal(t, product_batch.BatchHold{ID: 3, ProductBatchID: 1, Reason: "recall", PlacedAt: "2022-04-04 10:00:00"}, hold)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("place_hold_fail", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectExec("UPDATE product_batch SET status").
+			WithArgs(product_batch.StatusOnHold, 1, product_batch.StatusOnHold).
+			WillReturnError(fmt.Errorf("error"))
+		mock.ExpectRollback()
+
+		_, err := repo.PlaceHold(context.Background(), 1, "recall", "2022-04-04 10:00:00")
+
+		assert.Error(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("place_hold_already_on_hold", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectExec("UPDATE product_batch SET status").
+			WithArgs(product_batch.StatusOnHold, 1, product_batch.StatusOnHold).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.PlaceHold(context.Background(), 1, "recall", "2022-04-04 10:00:00")
+
+		assert.ErrorIs(t, err, product_batch.ErrAlreadyOnHold)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReleaseHold(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+	repo := NewMySQLRepository(db)
+
+	t.Run("release_hold_ok", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectQuery("SELECT (.+) FROM batch_hold WHERE product_batch_id=\\? AND released_at IS NULL").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_batch_id", "reason", "placed_at"}).AddRow(3, 1, "recall", "2022-04-04 10:00:00"))
+		mock.
+			ExpectExec("UPDATE batch_hold SET release_reason").
+			WithArgs("cleared", "2022-04-05 10:00:00", 3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.
+			ExpectExec("UPDATE product_batch SET status").
+			WithArgs(product_batch.StatusAvailable, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		hold, err := repo.ReleaseHold(context.Background(), 1, "cleared", "2022-04-05 10:00:00", product_batch.StatusAvailable)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "cleared", *hold.ReleaseReason)
+		assert.Equal(t, "2022-04-05 10:00:00", *hold.ReleasedAt)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("release_hold_not_on_hold", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectQuery("SELECT (.+) FROM batch_hold WHERE product_batch_id=\\? AND released_at IS NULL").
+			WithArgs(1).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err := repo.ReleaseHold(context.Background(), 1, "cleared", "2022-04-05 10:00:00", product_batch.StatusAvailable)
+
+		assert.ErrorIs(t, err, product_batch.ErrNotOnHold)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetHolds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+	repo := NewMySQLRepository(db)
+
+	t.Run("get_holds_ok", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "product_batch_id", "reason", "placed_at", "release_reason", "released_at"}).
+			AddRow(1, 1, "recall", "2022-04-04 10:00:00", "cleared", "2022-04-05 10:00:00").
+			AddRow(2, 1, "excursion", "2022-04-06 10:00:00", nil, nil)
+		mock.
+			ExpectQuery("SELECT (.+) DATE_FORMAT\\(released_at, '%Y-%m-%d %H:%i:%s'\\) FROM batch_hold WHERE product_batch_id=?").
+			WithArgs(1).
+			WillReturnRows(rows)
+
+		holds, err := repo.GetHolds(context.Background(), 1)
+
+		assert.Nil(t, err)
+		assert.Len(t, holds, 2)
+		assert.Nil(t, holds[1].ReleasedAt)
+	})
+}
+
+func TestGetAllocatable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+	repo := NewMySQLRepository(db)
+
+	t.Run("get_allocatable_ok", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "batch_number", "current_quantity", "due_date", "section_id"}).
+			AddRow(2, 222, 30, "2022-04-04", 1).
+			AddRow(1, 111, 50, "2022-05-04", 2)
+		mock.
+			ExpectQuery("SELECT (.+) FROM product_batch pb JOIN section s (.+) ORDER BY pb.due_date, pb.id").
+			WithArgs(1, 0, 0, product_batch.StatusAvailable).
+			WillReturnRows(rows)
+
+		batches, err := repo.GetAllocatable(context.Background(), 1, 0)
+
+		assert.Nil(t, err)
+		assert.Len(t, batches, 2)
+		assert.Equal(t, 2, batches[0].ID)
+	})
+
+	t.Run("get_allocatable_fail", func(t *testing.T) {
+		mock.
+			ExpectQuery("SELECT (.+) FROM product_batch pb JOIN section s").
+			WithArgs(1, 3, 3, product_batch.StatusAvailable).
+			WillReturnError(fmt.Errorf("error"))
+
+		_, err := repo.GetAllocatable(context.Background(), 1, 3)
+
+		assert.Error(t, err)
+	})
+}
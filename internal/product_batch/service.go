@@ -3,6 +3,13 @@ package product_batch
 import (
 	"context"
 	"errors"
+	"time"
+)
+
+var (
+	ErrBatchNotFound = errors.New("product batch not found")
+	ErrAlreadyOnHold = errors.New("product batch is already on hold")
+	ErrNotOnHold     = errors.New("product batch is not on hold")
 )
 
 type Service interface {
@@ -10,6 +17,10 @@ type Service interface {
 	Add(ctx context.Context, batchNumber int, currentQuantity int, currentTemperature int, dueDate string, initialQuantity int, manufacturingDate string, manufacturingHour int, minumumTemperature int, productID int, sectionID int) (ProductBatch, error)
 	LastID(ctx context.Context) (int, error)
 	HasBatchNumber(ctx context.Context, number int) (bool, error)
+	Hold(ctx context.Context, id int, reason string) (BatchHold, error)
+	Release(ctx context.Context, id int, reason string) (BatchHold, error)
+	GetHolds(ctx context.Context, id int) ([]BatchHold, error)
+	Allocate(ctx context.Context, productID int, warehouseID int, quantity int) (Allocation, error)
 }
 
 type service struct {
@@ -50,7 +61,17 @@ func (s *service) Add(ctx context.Context, batchNumber int, currentQuantity int,
 
 	id++
 
-	return s.repository.Add(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID)
+	quarantine, err := s.repository.IsQuarantineSection(ctx, sectionID)
+	if err != nil {
+		return ProductBatch{}, err
+	}
+
+	status := StatusAvailable
+	if quarantine {
+		status = StatusQuarantined
+	}
+
+	return s.repository.Add(ctx, id, batchNumber, currentQuantity, currentTemperature, dueDate, initialQuantity, manufacturingDate, manufacturingHour, minimumTemperature, productID, sectionID, status)
 }
 
 func (s *service) LastID(ctx context.Context) (int, error) {
@@ -64,3 +85,101 @@ func (s *service) LastID(ctx context.Context) (int, error) {
 func (s *service) HasBatchNumber(ctx context.Context, number int) (bool, error) {
 	return s.repository.HasBatchNumber(ctx, number)
 }
+
+func (s *service) Hold(ctx context.Context, id int, reason string) (BatchHold, error) {
+	pb, err := s.repository.FindById(ctx, id)
+	if err != nil {
+		return BatchHold{}, err
+	}
+
+	if pb.Status == StatusOnHold {
+		return BatchHold{}, ErrAlreadyOnHold
+	}
+
+	return s.repository.PlaceHold(ctx, id, reason, now())
+}
+
+func (s *service) Release(ctx context.Context, id int, reason string) (BatchHold, error) {
+	pb, err := s.repository.FindById(ctx, id)
+	if err != nil {
+		return BatchHold{}, err
+	}
+
+	if pb.Status != StatusOnHold {
+		return BatchHold{}, ErrNotOnHold
+	}
+
+	status, err := s.releasedStatus(ctx, pb)
+	if err != nil {
+		return BatchHold{}, err
+	}
+
+	return s.repository.ReleaseHold(ctx, id, reason, now(), status)
+}
+
+// releasedStatus picks the status a batch falls back to once its hold is lifted.
+func (s *service) releasedStatus(ctx context.Context, pb ProductBatch) (string, error) {
+	if pb.DueDate < time.Now().Format("2006-01-02") {
+		return StatusExpired, nil
+	}
+
+	quarantine, err := s.repository.IsQuarantineSection(ctx, pb.SectionID)
+	if err != nil {
+		return "", err
+	}
+
+	if quarantine {
+		return StatusQuarantined, nil
+	}
+
+	return StatusAvailable, nil
+}
+
+func (s *service) GetHolds(ctx context.Context, id int) ([]BatchHold, error) {
+	if _, err := s.repository.FindById(ctx, id); err != nil {
+		return []BatchHold{}, err
+	}
+
+	return s.repository.GetHolds(ctx, id)
+}
+
+func (s *service) Allocate(ctx context.Context, productID int, warehouseID int, quantity int) (Allocation, error) {
+	batches, err := s.repository.GetAllocatable(ctx, productID, warehouseID)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	allocation := Allocation{
+		ProductID: productID,
+		Requested: quantity,
+		Batches:   []AllocatedBatch{},
+	}
+
+	for _, pb := range batches {
+		if allocation.Allocated == quantity {
+			break
+		}
+
+		take := pb.CurrentQuantity
+		if remaining := quantity - allocation.Allocated; take > remaining {
+			take = remaining
+		}
+
+		allocation.Batches = append(allocation.Batches, AllocatedBatch{
+			ProductBatchID: pb.ID,
+			BatchNumber:    pb.BatchNumber,
+			SectionID:      pb.SectionID,
+			DueDate:        pb.DueDate,
+			Quantity:       take,
+		})
+		allocation.Allocated += take
+	}
+
+	allocation.Missing = quantity - allocation.Allocated
+
+	return allocation, nil
+}
+
+func now() string {
+	return time.Now().Format("2006-01-02 15:04:05")
+}
@@ -94,13 +94,18 @@ func TestAdd(t *testing.T) {
 			Once()
 
 		repo.
-			On("Add", mock.Anything, mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).
+			On("IsQuarantineSection", mock.Anything, 1).
+			Return(false, nil).
+			Once()
+
+		repo.
+			On("Add", mock.Anything, mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), product_batch.StatusAvailable).
 			Return(createProductBatchWithId(1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1), nil).
 			Once()
 
 		pb, err := serv.Add(ctx, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1)
 		assert.NoError(t, err)
-		assert.Equal(t, product_batch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}, pb)
+		assert.Equal(t, product_batch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1, ""}, pb)
 	})
 
 	t.Run("add_fail", func(t *testing.T) {
@@ -168,3 +173,174 @@ func TestHasBatchNumber(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestHold(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("hold_ok", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		pb := createProductBatchWithId(1, 111, 200, 20, "2999-04-04", 10, "2020-04-04", 10, 5, 1, 1)
+		pb.Status = product_batch.StatusAvailable
+
+		repo.On("FindById", mock.Anything, 1).Return(pb, nil).Once()
+		repo.
+			On("PlaceHold", mock.Anything, 1, "recall", mock.AnythingOfType("string")).
+			Return(product_batch.BatchHold{ID: 1, ProductBatchID: 1, Reason: "recall"}, nil).
+			Once()
+
+		hold, err := serv.Hold(ctx, 1, "recall")
+		assert.NoError(t, err)
+		assert.Equal(t, "recall", hold.Reason)
+	})
+
+	t.Run("hold_already_on_hold", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		pb := createProductBatchWithId(1, 111, 200, 20, "2999-04-04", 10, "2020-04-04", 10, 5, 1, 1)
+		pb.Status = product_batch.StatusOnHold
+
+		repo.On("FindById", mock.Anything, 1).Return(pb, nil).Once()
+
+		_, err := serv.Hold(ctx, 1, "recall")
+		assert.ErrorIs(t, err, product_batch.ErrAlreadyOnHold)
+	})
+
+	t.Run("hold_not_found", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		repo.On("FindById", mock.Anything, 9).Return(product_batch.ProductBatch{}, product_batch.ErrBatchNotFound).Once()
+
+		_, err := serv.Hold(ctx, 9, "recall")
+		assert.ErrorIs(t, err, product_batch.ErrBatchNotFound)
+	})
+}
+
+func TestRelease(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("release_to_available", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		pb := createProductBatchWithId(1, 111, 200, 20, "2999-04-04", 10, "2020-04-04", 10, 5, 1, 1)
+		pb.Status = product_batch.StatusOnHold
+
+		repo.On("FindById", mock.Anything, 1).Return(pb, nil).Once()
+		repo.On("IsQuarantineSection", mock.Anything, 1).Return(false, nil).Once()
+		repo.
+			On("ReleaseHold", mock.Anything, 1, "cleared", mock.AnythingOfType("string"), product_batch.StatusAvailable).
+			Return(product_batch.BatchHold{ID: 1, ProductBatchID: 1, Reason: "recall"}, nil).
+			Once()
+
+		_, err := serv.Release(ctx, 1, "cleared")
+		assert.NoError(t, err)
+	})
+
+	t.Run("release_to_quarantined", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		pb := createProductBatchWithId(1, 111, 200, 20, "2999-04-04", 10, "2020-04-04", 10, 5, 1, 2)
+		pb.Status = product_batch.StatusOnHold
+
+		repo.On("FindById", mock.Anything, 1).Return(pb, nil).Once()
+		repo.On("IsQuarantineSection", mock.Anything, 2).Return(true, nil).Once()
+		repo.
+			On("ReleaseHold", mock.Anything, 1, "cleared", mock.AnythingOfType("string"), product_batch.StatusQuarantined).
+			Return(product_batch.BatchHold{ID: 1, ProductBatchID: 1, Reason: "recall"}, nil).
+			Once()
+
+		_, err := serv.Release(ctx, 1, "cleared")
+		assert.NoError(t, err)
+	})
+
+	t.Run("release_to_expired", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		pb := createProductBatchWithId(1, 111, 200, 20, "2020-04-04", 10, "2020-01-04", 10, 5, 1, 1)
+		pb.Status = product_batch.StatusOnHold
+
+		repo.On("FindById", mock.Anything, 1).Return(pb, nil).Once()
+		repo.
+			On("ReleaseHold", mock.Anything, 1, "cleared", mock.AnythingOfType("string"), product_batch.StatusExpired).
+			Return(product_batch.BatchHold{ID: 1, ProductBatchID: 1, Reason: "recall"}, nil).
+			Once()
+
+		_, err := serv.Release(ctx, 1, "cleared")
+		assert.NoError(t, err)
+	})
+
+	t.Run("release_not_on_hold", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		pb := createProductBatchWithId(1, 111, 200, 20, "2999-04-04", 10, "2020-04-04", 10, 5, 1, 1)
+		pb.Status = product_batch.StatusAvailable
+
+		repo.On("FindById", mock.Anything, 1).Return(pb, nil).Once()
+
+		_, err := serv.Release(ctx, 1, "cleared")
+		assert.ErrorIs(t, err, product_batch.ErrNotOnHold)
+	})
+}
+
+func TestAllocate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("allocate_fefo", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		repo.
+			On("GetAllocatable", mock.Anything, 1, 0).
+			Return([]product_batch.ProductBatch{
+				createProductBatchWithId(2, 222, 30, 20, "2999-01-01", 30, "2020-04-04", 10, 5, 1, 1),
+				createProductBatchWithId(1, 111, 50, 20, "2999-02-01", 50, "2020-04-04", 10, 5, 1, 2),
+			}, nil).
+			Once()
+
+		allocation, err := serv.Allocate(ctx, 1, 0, 40)
+		assert.NoError(t, err)
+		assert.Equal(t, 40, allocation.Allocated)
+		assert.Equal(t, 0, allocation.Missing)
+		assert.Equal(t, []product_batch.AllocatedBatch{
+			{ProductBatchID: 2, BatchNumber: 222, SectionID: 1, DueDate: "2999-01-01", Quantity: 30},
+			{ProductBatchID: 1, BatchNumber: 111, SectionID: 2, DueDate: "2999-02-01", Quantity: 10},
+		}, allocation.Batches)
+	})
+
+	t.Run("allocate_short", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		repo.
+			On("GetAllocatable", mock.Anything, 1, 3).
+			Return([]product_batch.ProductBatch{
+				createProductBatchWithId(2, 222, 30, 20, "2999-01-01", 30, "2020-04-04", 10, 5, 1, 1),
+			}, nil).
+			Once()
+
+		allocation, err := serv.Allocate(ctx, 1, 3, 40)
+		assert.NoError(t, err)
+		assert.Equal(t, 30, allocation.Allocated)
+		assert.Equal(t, 10, allocation.Missing)
+	})
+
+	t.Run("allocate_fail", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := product_batch.NewService(repo)
+
+		repo.
+			On("GetAllocatable", mock.Anything, 1, 0).
+			Return([]product_batch.ProductBatch{}, errors.New("error")).
+			Once()
+
+		_, err := serv.Allocate(ctx, 1, 0, 40)
+		assert.Error(t, err)
+	})
+}
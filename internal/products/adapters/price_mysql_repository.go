@@ -0,0 +1,49 @@
+package adapters
+
+import (
+	"database/sql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+)
+
+type priceMysqlRepository struct {
+	db *sql.DB
+}
+
+func NewPriceMysqlRepository(db *sql.DB) usecases.PriceRepository {
+	return &priceMysqlRepository{
+		db: db,
+	}
+}
+
+func (r *priceMysqlRepository) GetCurrentSalePrices(date string) (map[int]money.Money, error) {
+	const query = `SELECT pr.product_id, pr.sale_price FROM product_record pr WHERE pr.id = (SELECT latest.id FROM product_record latest WHERE latest.product_id = pr.product_id AND DATE(latest.last_update_date) <= ? ORDER BY latest.last_update_date DESC, latest.id DESC LIMIT 1)`
+
+	rows, err := r.db.Query(query, date)
+
+	if err != nil {
+		return map[int]money.Money{}, err
+	}
+
+	defer rows.Close()
+
+	prices := map[int]money.Money{}
+
+	for rows.Next() {
+		var productId int
+		var salePrice money.Money
+
+		if err := rows.Scan(&productId, &salePrice); err != nil {
+			return map[int]money.Money{}, err
+		}
+
+		prices[productId] = salePrice
+	}
+
+	if err = rows.Err(); err != nil {
+		return map[int]money.Money{}, err
+	}
+
+	return prices, nil
+}
@@ -23,6 +23,18 @@ func NewProductController(p usecases.ServiceProduct) *ProductController {
 
 func (c *ProductController) GetAllProduct() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		if ctx.Query("with_price") == "true" {
+			p, err := c.service.GetAllWithCurrentPrice()
+			if err != nil {
+				ctx.JSON(http.StatusInternalServerError, gin.H{
+					"error": "internal server error",
+				})
+				return
+			}
+			ctx.JSON(http.StatusOK, p)
+			return
+		}
+
 		p, err := c.service.GetAll()
 		if err != nil {
 			ctx.JSON(http.StatusInternalServerError, gin.H{
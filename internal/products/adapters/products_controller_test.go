@@ -12,6 +12,7 @@ import (
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/usecases/mocks"
 
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -186,6 +187,17 @@ func TestGetAllProduct(t *testing.T) {
 		assert.Equal(t, "[{\"id\":1,\"product_code\":\"valid_code\",\"description\":\"valid_description\",\"width\":1,\"height\":1,\"length\":1,\"net_weight\":1,\"expiration_rate\":1,\"recommended_freezing_temperature\":1,\"freezing_rate\":1,\"product_type_id\":1,\"seller_id\":1}]", res.Body.String())
 	})
 
+	t.Run("Should embed the current sale price if with_price is true", func(t *testing.T) {
+		price := money.FromCents(1050)
+		products := domain.ProductsWithPrice{{Product: makeProducts()[0], Current_Sale_Price: &price}}
+		mockProductService.On("GetAllWithCurrentPrice").Return(products, nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/products?with_price=true", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "[{\"id\":1,\"product_code\":\"valid_code\",\"description\":\"valid_description\",\"width\":1,\"height\":1,\"length\":1,\"net_weight\":1,\"expiration_rate\":1,\"recommended_freezing_temperature\":1,\"freezing_rate\":1,\"product_type_id\":1,\"seller_id\":1,\"current_sale_price\":10.50}]", res.Body.String())
+	})
 }
 
 func TestGetByIdProduct(t *testing.T) {
@@ -1,5 +1,7 @@
 package domain
 
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
 type Product struct {
 	Id                               int     `json:"id"`
 	Product_Code                     string  `json:"product_code"`
@@ -16,3 +18,10 @@ type Product struct {
 }
 
 type Products []Product
+
+type ProductWithPrice struct {
+	Product
+	Current_Sale_Price *money.Money `json:"current_sale_price"`
+}
+
+type ProductsWithPrice []ProductWithPrice
@@ -8,7 +8,8 @@ import (
 
 func MakeProductController() *adapters.ProductController {
 	pr := adapters.NewProductMysqlRepository(db.GetInstance())
-	ps := usecases.NewProductService(pr)
+	ppr := adapters.NewPriceMysqlRepository(db.GetInstance())
+	ps := usecases.NewProductService(pr, ppr)
 	pc := adapters.NewProductController(ps)
 
 	return pc
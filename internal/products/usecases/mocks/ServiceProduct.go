@@ -70,6 +70,29 @@ func (_m *ServiceProduct) GetAll() (domain.Products, error) {
 	return r0, r1
 }
 
+// GetAllWithCurrentPrice provides a mock function with given fields:
+func (_m *ServiceProduct) GetAllWithCurrentPrice() (domain.ProductsWithPrice, error) {
+	ret := _m.Called()
+
+	var r0 domain.ProductsWithPrice
+	if rf, ok := ret.Get(0).(func() domain.ProductsWithPrice); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.ProductsWithPrice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetById provides a mock function with given fields: id
 func (_m *ServiceProduct) GetById(id int) (domain.Product, error) {
 	ret := _m.Called(id)
@@ -0,0 +1,7 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
+type PriceRepository interface {
+	GetCurrentSalePrices(date string) (map[int]money.Money, error)
+}
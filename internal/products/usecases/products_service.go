@@ -2,12 +2,14 @@ package usecases
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/domain"
 )
 
 type ServiceProduct interface {
 	GetAll() (domain.Products, error)
+	GetAllWithCurrentPrice() (domain.ProductsWithPrice, error)
 	GetById(id int) (domain.Product, error)
 	Create(product_code string, description string, width float64, height float64, length float64, net_weight float64, expiration_rate int, recommended_freezing_temperature float64, freezing_rate int, product_type_id int, seller_id int) (domain.Product, error)
 	Update(id int, product_code string, description string, width float64, height float64, length float64, net_weight float64, expiration_rate int, recommended_freezing_temperature float64, freezing_rate int, product_type_id int, seller_id int) (domain.Product, error)
@@ -16,11 +18,13 @@ type ServiceProduct interface {
 
 type serviceProduct struct {
 	repositoryProduct RepositoryProduct
+	priceRepository   PriceRepository
 }
 
-func NewProductService(r RepositoryProduct) ServiceProduct {
+func NewProductService(r RepositoryProduct, p PriceRepository) ServiceProduct {
 	return &serviceProduct{
 		repositoryProduct: r,
+		priceRepository:   p,
 	}
 }
 
@@ -34,6 +38,34 @@ func (s *serviceProduct) GetAll() (domain.Products, error) {
 	return ps, nil
 }
 
+func (s *serviceProduct) GetAllWithCurrentPrice() (domain.ProductsWithPrice, error) {
+	ps, err := s.repositoryProduct.GetAll()
+
+	if err != nil {
+		return domain.ProductsWithPrice{}, err
+	}
+
+	prices, err := s.priceRepository.GetCurrentSalePrices(time.Now().Format("2006-01-02"))
+
+	if err != nil {
+		return domain.ProductsWithPrice{}, err
+	}
+
+	result := domain.ProductsWithPrice{}
+
+	for _, p := range ps {
+		pp := domain.ProductWithPrice{Product: p}
+
+		if price, ok := prices[p.Id]; ok {
+			pp.Current_Sale_Price = &price
+		}
+
+		result = append(result, pp)
+	}
+
+	return result, nil
+}
+
 func (s *serviceProduct) GetById(id int) (domain.Product, error) {
 	ps, err := s.repositoryProduct.GetById(id)
 
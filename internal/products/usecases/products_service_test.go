@@ -7,6 +7,7 @@ import (
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/usecases"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/products/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -55,7 +56,7 @@ func makeUpdateProduct() domain.Product {
 
 func TestGetAll(t *testing.T) {
 	mockProductRepository := mocks.NewRepositoryProduct(t)
-	service := usecases.NewProductService(mockProductRepository)
+	service := usecases.NewProductService(mockProductRepository, mocks.NewPriceRepository(t))
 
 	t.Run("Should call GetAll from Product Repository", func(t *testing.T) {
 		mockProductRepository.On("GetAll").Return(domain.Products{makeProduct()}, nil).Once()
@@ -86,9 +87,53 @@ func TestGetAll(t *testing.T) {
 	})
 }
 
+func TestGetAllWithCurrentPrice(t *testing.T) {
+	makeSut := func() (usecases.ServiceProduct, *mocks.RepositoryProduct, *mocks.PriceRepository) {
+		mockProductRepository := mocks.NewRepositoryProduct(t)
+		mockPriceRepository := mocks.NewPriceRepository(t)
+		service := usecases.NewProductService(mockProductRepository, mockPriceRepository)
+		return service, mockProductRepository, mockPriceRepository
+	}
+
+	t.Run("Should return an error if GetAll from Product Repository returns an error", func(t *testing.T) {
+		service, mockProductRepository, _ := makeSut()
+		mockProductRepository.On("GetAll").Return(domain.Products{}, errors.New("any_error")).Once()
+
+		_, err := service.GetAllWithCurrentPrice()
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should return an error if GetCurrentSalePrices from Price Repository returns an error", func(t *testing.T) {
+		service, mockProductRepository, mockPriceRepository := makeSut()
+		mockProductRepository.On("GetAll").Return(domain.Products{makeProduct()}, nil).Once()
+		mockPriceRepository.On("GetCurrentSalePrices", mock.AnythingOfType("string")).Return(map[int]money.Money{}, errors.New("any_error")).Once()
+
+		_, err := service.GetAllWithCurrentPrice()
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should embed the current sale price of each product on success", func(t *testing.T) {
+		service, mockProductRepository, mockPriceRepository := makeSut()
+		mockProductRepository.On("GetAll").Return(domain.Products{makeProduct(), makeUpdateProduct()}, nil).Once()
+		mockPriceRepository.On("GetCurrentSalePrices", mock.AnythingOfType("string")).Return(map[int]money.Money{1: money.FromCents(1050)}, nil).Once()
+
+		ps, err := service.GetAllWithCurrentPrice()
+
+		price := money.FromCents(1050)
+		expected := domain.ProductsWithPrice{
+			{Product: makeProduct(), Current_Sale_Price: &price},
+			{Product: makeUpdateProduct(), Current_Sale_Price: nil},
+		}
+		assert.Equal(t, expected, ps)
+		assert.Nil(t, err)
+	})
+}
+
 func TestGetById(t *testing.T) {
 	mockProductRepository := mocks.NewRepositoryProduct(t)
-	service := usecases.NewProductService(mockProductRepository)
+	service := usecases.NewProductService(mockProductRepository, mocks.NewPriceRepository(t))
 
 	t.Run("Should call GetById from Product Repository with correct ID", func(t *testing.T) {
 		mockProductRepository.On("GetById", mock.AnythingOfType("int")).Return(makeProduct(), nil).Once()
@@ -118,7 +163,7 @@ func TestGetById(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	mockProductRepository := mocks.NewRepositoryProduct(t)
-	service := usecases.NewProductService(mockProductRepository)
+	service := usecases.NewProductService(mockProductRepository, mocks.NewPriceRepository(t))
 
 	t.Run("Should Call GetByCode from Product Repository with correct code", func(t *testing.T) {
 		mockProductRepository.On("GetByCode", mock.AnythingOfType("string")).Return(domain.Product{}, errors.New("any_error")).Once()
@@ -181,7 +226,7 @@ func TestCreate(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	mockProductRepository := mocks.NewRepositoryProduct(t)
-	service := usecases.NewProductService(mockProductRepository)
+	service := usecases.NewProductService(mockProductRepository, mocks.NewPriceRepository(t))
 
 	t.Run("Should call GetByCode from Product Repository with correct code", func(t *testing.T) {
 		mockProductRepository.On("GetByCode", mock.AnythingOfType("string")).Return(domain.Product{}, errors.New("any_error")).Once()
@@ -256,7 +301,7 @@ func TestUpdate(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	mockProductRepository := mocks.NewRepositoryProduct(t)
-	service := usecases.NewProductService(mockProductRepository)
+	service := usecases.NewProductService(mockProductRepository, mocks.NewPriceRepository(t))
 
 	t.Run("Should call Delete from Product Repository with correct ID", func(t *testing.T) {
 		mockProductRepository.On("Delete", mock.AnythingOfType("int")).Return(nil).Once()
@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+)
+
+type PickingController struct {
+	service usecases.PickingService
+}
+
+func CreatePickingController(ps usecases.PickingService) *PickingController {
+	return &PickingController{
+		service: ps,
+	}
+}
+
+type pickingRequest struct {
+	EmployeeId int `json:"employee_id" binding:"required"`
+}
+
+func (pc *PickingController) PickPurchaseOrder(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	var req pickingRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	p, err := pc.service.Pick(id, req.EmployeeId)
+	if err != nil {
+		var be *usecases.BusinessRuleError
+		var fe *usecases.NoElementInFileError
+
+		switch {
+		case errors.As(err, &fe):
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		case errors.Is(err, usecases.ErrOrderAlreadyPicked):
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		case errors.As(err, &be):
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error": err.Error(),
+			})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": p,
+	})
+}
@@ -39,7 +39,11 @@ func (poc *PurchaseOrderController) CreatePurchaseOrder(ctx *gin.Context) {
 		return
 	}
 
-	b, err := poc.service.Create(req.OrderNumber, req.OrderDate, req.TrackingCode, req.BuyerId, req.ProductRecordId, req.OrderStatusId)
+	if req.Quantity == 0 {
+		req.Quantity = 1
+	}
+
+	b, err := poc.service.Create(req.OrderNumber, req.OrderDate, req.BuyerId, req.BuyerAddressId, req.WarehouseId, req.CarrierId, req.ProductRecordId, req.Quantity, req.OrderStatusId)
 	if err != nil {
 		if CustomError(err) {
 			ctx.JSON(http.StatusBadRequest, gin.H{
@@ -96,9 +100,12 @@ func (poc *PurchaseOrderController) CreatePurchaseOrder(ctx *gin.Context) {
 type purchaseOrdersRequest struct {
 	OrderNumber     string `json:"order_number" binding:"required"`
 	OrderDate       string `json:"order_date" binding:"required"`
-	TrackingCode    string `json:"tracking_code" binding:"required"`
 	BuyerId         int    `json:"buyer_id" binding:"required"`
+	BuyerAddressId  int    `json:"buyer_address_id"`
+	WarehouseId     int    `json:"warehouse_id"`
+	CarrierId       int    `json:"carrier_id"`
 	ProductRecordId int    `json:"product_record_id" binding:"required"`
+	Quantity        int    `json:"quantity"`
 	OrderStatusId   int    `json:"order_status_id" binding:"required"`
 }
 
@@ -111,20 +118,32 @@ func (por *purchaseOrdersRequest) Validate() error {
 		return errors.New("order date can't be empty")
 	}
 
-	if strings.TrimSpace(por.TrackingCode) == "" {
-		return errors.New("tracking code can't be empty")
-	}
-
 	if por.BuyerId < 1 {
 		return errors.New("buyer id can't be empty or smaller than 1")
 	}
 
+	if por.BuyerAddressId < 0 {
+		return errors.New("buyer address id can't be smaller than 0")
+	}
+
+	if por.WarehouseId < 0 {
+		return errors.New("warehouse id can't be smaller than 0")
+	}
+
+	if por.CarrierId < 0 {
+		return errors.New("carrier id can't be smaller than 0")
+	}
+
 	if por.ProductRecordId < 1 {
-		return errors.New("product record id can't be empty  or smaller than 1")
+		return errors.New("product record id can't be empty or smaller than 1")
+	}
+
+	if por.Quantity < 0 {
+		return errors.New("quantity can't be smaller than 0")
 	}
 
 	if por.OrderStatusId < 1 {
-		return errors.New("order status can't be empty  or smaller than 1")
+		return errors.New("order status can't be empty or smaller than 1")
 	}
 
 
@@ -0,0 +1,74 @@
+package adapters_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickPurchaseOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockPickingService := mocks.NewPickingService(t)
+	pc := adapters.CreatePickingController(mockPickingService)
+
+	r := gin.Default()
+	r.POST("/purchaseOrders/:id/pick", pc.PickPurchaseOrder)
+
+	pick := func(body string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/purchaseOrders/1/pick", bytes.NewBufferString(body))
+		r.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	t.Run("Should return 422 if employee_id is missing", func(t *testing.T) {
+		rr := pick(`{}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Should return 404 if the purchase order does not exist", func(t *testing.T) {
+		mockPickingService.On("Pick", 1, 2).Return(domain.Picking{}, &usecases.NoElementInFileError{Err: errors.New("purchase order not found")}).Once()
+
+		rr := pick(`{"employee_id": 2}`)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return 409 if the order is already picked", func(t *testing.T) {
+		mockPickingService.On("Pick", 1, 2).Return(domain.Picking{}, usecases.ErrOrderAlreadyPicked).Once()
+
+		rr := pick(`{"employee_id": 2}`)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Should return 500 without the error details if the service fails", func(t *testing.T) {
+		mockPickingService.On("Pick", 1, 2).Return(domain.Picking{}, errors.New("connection refused")).Once()
+
+		rr := pick(`{"employee_id": 2}`)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, `{"error":"internal server error"}`, rr.Body.String())
+	})
+
+	t.Run("Should return 200 with the picking", func(t *testing.T) {
+		mockPickingService.On("Pick", 1, 2).Return(domain.Picking{PurchaseOrderId: 1, EmployeeId: 2, LinesPicked: 3, PickedAt: "2022-07-02 10:00:00"}, nil).Once()
+
+		rr := pick(`{"employee_id": 2}`)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, `{"data":{"purchase_order_id":1,"employee_id":2,"lines_picked":3,"picked_at":"2022-07-02 10:00:00"}}`, rr.Body.String())
+	})
+}
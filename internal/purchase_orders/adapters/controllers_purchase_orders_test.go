@@ -12,6 +12,7 @@ import (
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
 	_ "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -20,7 +21,7 @@ func makeUnprocessableCreateAndUpdateBody() *bytes.Buffer {
 	return bytes.NewBuffer([]byte(`
 		{
 			"order_number": "123",
-			"order_date": "01-01-2022",
+			"order_date": "2022-01-01",
 			"product_record_id": 1,
 			"order_status_id": 1
 		}
@@ -31,9 +32,9 @@ func makeValidCreateBody() *bytes.Buffer {
 	return bytes.NewBuffer([]byte(`
 	{
 	"order_number": "123",
-	"order_date": "01-01-2022",
-	"tracking_code": "123",
+	"order_date": "2022-01-01",
 	"buyer_id": 1,
+	"warehouse_id": 1,
 	"product_record_id": 1,
 	"order_status_id": 1
 	}
@@ -51,8 +52,8 @@ func makeInvalidCreateAndUpdateBodiesTestCases() []TestCase {
 			RequestBody: `
 			{
 				"order_number": " ",
-				"order_date": "01-01-2022",
-				"tracking_code": "123",
+				"order_date": "2022-01-01",
+				"warehouse_id": 1,
 				"buyer_id": 1,
 				"product_record_id": 1,
 				"order_status_id": 1
@@ -65,7 +66,7 @@ func makeInvalidCreateAndUpdateBodiesTestCases() []TestCase {
 			{
 				"order_number": "123",
 				"order_date": " ",
-				"tracking_code": "123",
+				"warehouse_id": 1,
 				"buyer_id": 1,
 				"product_record_id": 1,
 				"order_status_id": 1
@@ -77,53 +78,40 @@ func makeInvalidCreateAndUpdateBodiesTestCases() []TestCase {
 			RequestBody: `
 			{
 				"order_number": "123",
-				"order_date": "01-01-2022",
-				"tracking_code": " ",
-				"buyer_id": 1,
-				"product_record_id": 1,
-				"order_status_id": 1
-			}
-			`,
-			ExpectedResponseBody: "{\"error\":\"tracking code can't be empty\"}",
-		},
-		{
-			RequestBody: `
-			{
-				"order_number": "123",
-				"order_date": "01-01-2022",
-				"tracking_code": "123",
-				"buyer_id": ,
+				"order_date": "2022-01-01",
+				"warehouse_id": 1,
+				"buyer_id": -1,
 				"product_record_id": 1,
 				"order_status_id": 1
 			}
 			`,
-			ExpectedResponseBody: "{\"error\":\"buyer id can't be empty\"}",
+			ExpectedResponseBody: "{\"error\":\"buyer id can't be empty or smaller than 1\"}",
 		},
 		{
 			RequestBody: `
 			{
 				"order_number": "123",
-				"order_date": "01-01-2022",
-				"tracking_code": "123",
+				"order_date": "2022-01-01",
+				"warehouse_id": 1,
 				"buyer_id": 1,
-				"product_record_id": ,
+				"product_record_id": -1,
 				"order_status_id": 1
 			}
 			`,
-			ExpectedResponseBody: "{\"error\":\"product record id can't be empty\"}",
+			ExpectedResponseBody: "{\"error\":\"product record id can't be empty or smaller than 1\"}",
 		},
 		{
 			RequestBody: `
 			{
 				"order_number": "123",
-				"order_date": "01-01-2022",
-				"tracking_code": "123",
+				"order_date": "2022-01-01",
+				"warehouse_id": 1,
 				"buyer_id": 1,
 				"product_record_id": 1,
-				"order_status_id": 
+				"order_status_id": -1
 			}
 			`,
-			ExpectedResponseBody: "{\"error\":\"order status id can't be empty\"}",
+			ExpectedResponseBody: "{\"error\":\"order status can't be empty or smaller than 1\"}",
 		},
 	}
 }
@@ -132,10 +120,15 @@ func makeDBPurchaseOrder() domain.Purchase_Order {
 	return domain.Purchase_Order{
 		ID:              1,
 		OrderNumber:     "123",
-		OrderDate:       "01-01-2022",
-		TrackingCode:    "123",
+		OrderDate:       "2022-01-01",
+		TrackingCode:    "MF000000014BR",
 		BuyerId:         1,
+		BuyerAddressId:  1,
+		WarehouseId:     1,
+		CarrierId:       1,
 		ProductRecordId: 1,
+		Quantity:        1,
+		UnitPrice:       money.FromCents(850),
 		OrderStatusId:   1,
 	}
 }
@@ -170,16 +163,16 @@ func TestCreatePurchaseOrder(t *testing.T) {
 	})
 
 	t.Run("Should call Create from Purchase Orders Service with correct values", func(t *testing.T) {
-		mockPurchaseOrderService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(makeDBPurchaseOrder(), nil).Once()
+		mockPurchaseOrderService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(makeDBPurchaseOrder(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/purchaseOrders", makeValidCreateBody())
 		r.ServeHTTP(rr, req)
 
-		mockPurchaseOrderService.AssertCalled(t, "Create", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "order_status_id")
+		mockPurchaseOrderService.AssertCalled(t, "Create", "123", "2022-01-01", 1, 0, 1, 0, 1, 1, 1)
 	})
 
 	t.Run("Should return an error and 500 status if Create from Purchase Orders Service did not returns an custom error", func(t *testing.T) {
-		mockPurchaseOrderService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(domain.Purchase_Order{}, errors.New("any_message")).Once()
+		mockPurchaseOrderService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(domain.Purchase_Order{}, errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/purchaseOrders", makeValidCreateBody())
 		r.ServeHTTP(rr, req)
@@ -189,12 +182,13 @@ func TestCreatePurchaseOrder(t *testing.T) {
 	})
 
 	t.Run("Should 201 status and data on success", func(t *testing.T) {
-		mockPurchaseOrderService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(makeDBPurchaseOrder(), nil).Once()
+		mockPurchaseOrderService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).Return(makeDBPurchaseOrder(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/purchaseOrders", makeValidCreateBody())
 		r.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusCreated, rr.Code)
-		assert.Equal(t, "{\"data\":{\"id\":1,\"order_number\":\"123\",\"order_date\":\"01-01-2022\",\"tracking_code\":\"123\",\"buyer_id\":1,\"product_record_id\":1,\"order_status_id\":1}}", rr.Body.String())
+		mockPurchaseOrderService.AssertCalled(t, "Create", "123", "2022-01-01", 1, 0, 1, 0, 1, 1, 1)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"order_number\":\"123\",\"order_date\":\"2022-01-01\",\"tracking_code\":\"MF000000014BR\",\"buyer_id\":1,\"buyer_address_id\":1,\"warehouse_id\":1,\"carrier_id\":1,\"product_record_id\":1,\"quantity\":1,\"unit_price\":8.50,\"order_status_id\":1}}", rr.Body.String())
 	})
 }
@@ -0,0 +1,59 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+)
+
+type deliveryAddressMySQLRepository struct {
+	db *sql.DB
+}
+
+func CreateDeliveryAddressMySQLRepository(db *sql.DB) usecases.DeliveryAddressRepository {
+	return &deliveryAddressMySQLRepository{
+		db: db,
+	}
+}
+
+func (r *deliveryAddressMySQLRepository) GetDefaultId(buyerId int) (int, error) {
+	const query = `SELECT id FROM buyer_address WHERE buyer_id=? AND is_default=1`
+
+	id := 0
+	err := r.db.QueryRow(query, buyerId).Scan(&id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *deliveryAddressMySQLRepository) BelongsToBuyer(addressId int, buyerId int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM buyer_address WHERE id=? AND buyer_id=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, addressId, buyerId).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *deliveryAddressMySQLRepository) GetLocalityId(addressId int) (int, error) {
+	const query = `SELECT locality_id FROM buyer_address WHERE id=?`
+
+	localityId := 0
+
+	if err := r.db.QueryRow(query, addressId).Scan(&localityId); err != nil {
+		return 0, err
+	}
+
+	return localityId, nil
+}
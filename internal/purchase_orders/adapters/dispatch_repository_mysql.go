@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+)
+
+type dispatchMySQLRepository struct {
+	db *sql.DB
+}
+
+func CreateDispatchMySQLRepository(db *sql.DB) usecases.DispatchRepository {
+	return &dispatchMySQLRepository{
+		db: db,
+	}
+}
+
+func (r *dispatchMySQLRepository) WarehouseExists(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM warehouse WHERE id=? AND deleted_at IS NULL`
+
+	return r.exists(query, id)
+}
+
+func (r *dispatchMySQLRepository) CarrierExists(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM carrier WHERE id=? AND deleted_at IS NULL`
+
+	return r.exists(query, id)
+}
+
+// GetDefaultWarehouseId returns 0 when there is no warehouse. Warehouses in
+// the locality come first, then those in the same province, then the rest.
+func (r *dispatchMySQLRepository) GetDefaultWarehouseId(localityId int) (int, error) {
+	const query = `SELECT w.id FROM warehouse w
+	LEFT JOIN locality wl ON wl.id = w.locality_id
+	INNER JOIN locality dl ON dl.id = ?
+	WHERE w.deleted_at IS NULL
+	ORDER BY w.locality_id = dl.id DESC, wl.province_id = dl.province_id DESC, w.id LIMIT 1`
+
+	return r.firstId(query, localityId)
+}
+
+// GetDefaultCarrierId returns 0 when no carrier serves the locality. Delivered
+// orders don't count towards a carrier's load.
+func (r *dispatchMySQLRepository) GetDefaultCarrierId(localityId int) (int, error) {
+	const query = `SELECT c.id FROM carrier c
+	LEFT JOIN purchase_order po ON po.carrier_id = c.id AND po.order_status_id <> ?
+	WHERE c.locality_id=? AND c.deleted_at IS NULL
+	GROUP BY c.id
+	ORDER BY COUNT(po.id), c.id LIMIT 1`
+
+	return r.firstId(query, domain.StatusDelivered, localityId)
+}
+
+func (r *dispatchMySQLRepository) firstId(query string, args ...interface{}) (int, error) {
+	id := 0
+	err := r.db.QueryRow(query, args...).Scan(&id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *dispatchMySQLRepository) exists(query string, id int) (bool, error) {
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
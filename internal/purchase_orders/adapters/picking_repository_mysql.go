@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+)
+
+type pickingMySQLRepository struct {
+	db *sql.DB
+}
+
+func CreatePickingMySQLRepository(db *sql.DB) usecases.PickingRepository {
+	return &pickingMySQLRepository{
+		db: db,
+	}
+}
+
+func (r *pickingMySQLRepository) GetOrderWarehouseId(purchaseOrderId int) (int, error) {
+	const query = `SELECT warehouse_id FROM purchase_order WHERE id=?`
+
+	return r.warehouseId(query, purchaseOrderId, "purchase order not found")
+}
+
+func (r *pickingMySQLRepository) GetEmployeeWarehouseId(employeeId int) (int, error) {
+	const query = `SELECT warehouse_id FROM employee WHERE id=?`
+
+	return r.warehouseId(query, employeeId, "employee not found")
+}
+
+// PickLines returns how many lines were picked; lines already picked keep
+// their picker.
+func (r *pickingMySQLRepository) PickLines(purchaseOrderId int, employeeId int, pickedAt string) (int, error) {
+	const query = `UPDATE order_details SET picked_by=?, picked_at=? WHERE purchase_order_id=? AND picked_at IS NULL`
+
+	res, err := r.db.Exec(query, employeeId, pickedAt, purchaseOrderId)
+
+	if err != nil {
+		return 0, err
+	}
+
+	picked, err := res.RowsAffected()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(picked), nil
+}
+
+func (r *pickingMySQLRepository) warehouseId(query string, id int, notFound string) (int, error) {
+	warehouseId := 0
+	err := r.db.QueryRow(query, id).Scan(&warehouseId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, &usecases.NoElementInFileError{Err: errors.New(notFound)}
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return warehouseId, nil
+}
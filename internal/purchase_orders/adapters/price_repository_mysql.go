@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	prices "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+)
+
+type priceMySQLRepository struct {
+	db *sql.DB
+}
+
+func CreatePriceMySQLRepository(db *sql.DB) usecases.PriceRepository {
+	return &priceMySQLRepository{
+		db: db,
+	}
+}
+
+// GetSalePrice returns the sale price of the latest record of the product up
+// to the date, or the informed record's own price when there is none.
+func (r *priceMySQLRepository) GetSalePrice(productRecordId int, date string) (money.Money, error) {
+	const query = `SELECT COALESCE((SELECT latest.sale_price FROM product_record latest
+	WHERE latest.product_id = pr.product_id AND DATE(latest.last_update_date) <= DATE(?)
+	ORDER BY latest.last_update_date DESC, latest.id DESC LIMIT 1), pr.sale_price)
+	FROM product_record pr WHERE pr.id=?`
+
+	price := money.Money{}
+	err := r.db.QueryRow(query, date, productRecordId).Scan(&price)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return money.Money{}, &usecases.NoElementInFileError{Err: errors.New("product record not found")}
+	}
+
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return price, nil
+}
+
+// GetBuyerPriceListItem returns nil when the buyer has no price list or the
+// list has no item for the product valid at the date. Among valid items the
+// one with the latest start wins.
+func (r *priceMySQLRepository) GetBuyerPriceListItem(buyerId int, productRecordId int, date string) (*prices.PriceListItem, error) {
+	const query = `SELECT pli.id, pli.price_list_id, pli.product_id, pli.price, pli.discount_percent, DATE_FORMAT(pli.valid_from, '%Y-%m-%d'), DATE_FORMAT(pli.valid_to, '%Y-%m-%d') FROM buyer b
+	INNER JOIN price_list_item pli ON pli.price_list_id = b.price_list_id
+	INNER JOIN product_record pr ON pr.product_id = pli.product_id
+	WHERE b.id=? AND pr.id=?
+	AND (pli.valid_from IS NULL OR pli.valid_from <= DATE(?))
+	AND (pli.valid_to IS NULL OR pli.valid_to >= DATE(?))
+	ORDER BY pli.valid_from IS NULL, pli.valid_from DESC, pli.id DESC LIMIT 1`
+
+	i := prices.PriceListItem{}
+	err := r.db.QueryRow(query, buyerId, productRecordId, date, date).Scan(&i.Id, &i.PriceListId, &i.ProductId, &i.Price, &i.DiscountPercent, &i.ValidFrom, &i.ValidTo)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
@@ -2,9 +2,14 @@ package adapters
 
 import (
 	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 )
 
 type purchaseOrderMySQLRepository struct {
@@ -17,28 +22,41 @@ func CreatePurchaseOrderMySQLRepository(db *sql.DB) usecases.PurchaseOrderReposi
 	}
 }
 
-func (r *purchaseOrderMySQLRepository) Create(orderNumber string, orderDate string, trackingCode string, buyerId int, productRecordId int, orderStatusId int) (domain.Purchase_Order, error) {
+func (r *purchaseOrderMySQLRepository) Create(orderNumber string, orderDate string, trackingCode string, buyerId int, buyerAddressId int, warehouseId int, carrierId int, productRecordId int, quantity int, unitPrice money.Money, orderStatusId int) (domain.Purchase_Order, error) {
 	tx, err := r.db.Begin()
 
 	if err != nil {
 		return domain.Purchase_Order{}, err
 	}
 
-	const query = `INSERT INTO purchase_order (order_number, order_date, tracking_code, buyer_id, product_record_id, order_status_id) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	const query = `INSERT INTO purchase_order (order_number, order_date, tracking_code, buyer_id, buyer_address_id, warehouse_id, carrier_id, order_status_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 
-	res, err := tx.Exec(query, orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+	res, err := tx.Exec(query, orderNumber, orderDate, trackingCode, buyerId, buyerAddressId, warehouseId, carrierId, orderStatusId)
 
 	if err != nil {
 		_ = tx.Rollback()
-		return domain.Purchase_Order{}, err
-	}
 
-	if err = tx.Commit(); err != nil {
+		if isDuplicateTrackingCode(err) {
+			return domain.Purchase_Order{}, usecases.ErrTrackingCodeTaken
+		}
+
 		return domain.Purchase_Order{}, err
 	}
 
 	id, err := res.LastInsertId()
 	if err != nil {
+		_ = tx.Rollback()
+		return domain.Purchase_Order{}, err
+	}
+
+	const lineQuery = `INSERT INTO order_details (purchase_order_id, product_record_id, quantity, unit_price) VALUES (?, ?, ?, ?)`
+
+	if _, err = tx.Exec(lineQuery, id, productRecordId, quantity, unitPrice); err != nil {
+		_ = tx.Rollback()
+		return domain.Purchase_Order{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
 		return domain.Purchase_Order{}, err
 	}
 
@@ -48,7 +66,44 @@ func (r *purchaseOrderMySQLRepository) Create(orderNumber string, orderDate stri
 		OrderDate:       orderDate,
 		TrackingCode:    trackingCode,
 		BuyerId:         buyerId,
+		BuyerAddressId:  buyerAddressId,
+		WarehouseId:     warehouseId,
+		CarrierId:       carrierId,
 		ProductRecordId: productRecordId,
+		Quantity:        quantity,
+		UnitPrice:       unitPrice,
 		OrderStatusId:   orderStatusId,
 	}, nil
 }
+
+func (r *purchaseOrderMySQLRepository) NextTrackingSerial() (int, error) {
+	const query = `SELECT COALESCE(MAX(id), 0) + 1 FROM purchase_order`
+
+	serial := 0
+
+	if err := r.db.QueryRow(query).Scan(&serial); err != nil {
+		return 0, err
+	}
+
+	return serial, nil
+}
+
+func (r *purchaseOrderMySQLRepository) TrackingCodeExists(trackingCode string) (bool, error) {
+	const query = `SELECT COUNT(*) FROM purchase_order WHERE tracking_code=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, trackingCode).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// isDuplicateTrackingCode reports whether the insert lost the race for its
+// tracking code to an order created concurrently.
+func isDuplicateTrackingCode(err error) bool {
+	var mysqlErr *mysql.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 && strings.Contains(mysqlErr.Message, "tracking_code_UNIQUE")
+}
@@ -1,13 +1,35 @@
 package domain
 
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
+// Order status ids as seeded in order_status.
+const (
+	StatusPending   = 1
+	StatusShipped   = 2
+	StatusDelivered = 3
+)
+
 type Purchase_Order struct {
 	ID int `json:"id"`
 	OrderNumber string `json:"order_number"`
 	OrderDate string `json:"order_date"`
 	TrackingCode string `json:"tracking_code"`
 	BuyerId int `json:"buyer_id"`
+	BuyerAddressId int `json:"buyer_address_id"`
+	WarehouseId int `json:"warehouse_id"`
+	CarrierId int `json:"carrier_id"`
 	ProductRecordId int `json:"product_record_id"`
+	Quantity int `json:"quantity"`
+	UnitPrice money.Money `json:"unit_price"`
 	OrderStatusId int `json:"order_status_id"`
 }
 
-type Purchase_Orders []Purchase_Order
\ No newline at end of file
+type Purchase_Orders []Purchase_Order
+
+// Picking is the result of an employee picking the open lines of an order.
+type Picking struct {
+	PurchaseOrderId int    `json:"purchase_order_id"`
+	EmployeeId      int    `json:"employee_id"`
+	LinesPicked     int    `json:"lines_picked"`
+	PickedAt        string `json:"picked_at"`
+}
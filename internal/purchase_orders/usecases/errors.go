@@ -1,5 +1,13 @@
 package usecases
 
+import "errors"
+
+var ErrTrackingCodeTaken = errors.New("tracking code already taken")
+
+var ErrInvalidOrderDate = errors.New("order date must be in the format yyyy-mm-dd")
+
+var ErrOrderAlreadyPicked = errors.New("all lines of the purchase order are already picked")
+
 type BusinessRuleError struct {
 	Err error
 }
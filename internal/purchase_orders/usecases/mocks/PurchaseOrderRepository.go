@@ -4,6 +4,7 @@ package mocks
 
 import (
 	domain "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	money "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -12,20 +13,62 @@ type PurchaseOrderRepository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId
-func (_m *PurchaseOrderRepository) Create(orderNumber string, orderDate string, trackingCode string, buyerId int, productRecordId int, orderStatusId int) (domain.Purchase_Order, error) {
-	ret := _m.Called(orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+// Create provides a mock function with given fields: orderNumber, orderDate, trackingCode, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, unitPrice, orderStatusId
+func (_m *PurchaseOrderRepository) Create(orderNumber string, orderDate string, trackingCode string, buyerId int, buyerAddressId int, warehouseId int, carrierId int, productRecordId int, quantity int, unitPrice money.Money, orderStatusId int) (domain.Purchase_Order, error) {
+	ret := _m.Called(orderNumber, orderDate, trackingCode, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, unitPrice, orderStatusId)
 
 	var r0 domain.Purchase_Order
-	if rf, ok := ret.Get(0).(func(string, string, string, int, int, int) domain.Purchase_Order); ok {
-		r0 = rf(orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int, int, int, int, int, money.Money, int) domain.Purchase_Order); ok {
+		r0 = rf(orderNumber, orderDate, trackingCode, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, unitPrice, orderStatusId)
 	} else {
 		r0 = ret.Get(0).(domain.Purchase_Order)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, int, int, int) error); ok {
-		r1 = rf(orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+	if rf, ok := ret.Get(1).(func(string, string, string, int, int, int, int, int, int, money.Money, int) error); ok {
+		r1 = rf(orderNumber, orderDate, trackingCode, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, unitPrice, orderStatusId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NextTrackingSerial provides a mock function with given fields:
+func (_m *PurchaseOrderRepository) NextTrackingSerial() (int, error) {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrackingCodeExists provides a mock function with given fields: trackingCode
+func (_m *PurchaseOrderRepository) TrackingCodeExists(trackingCode string) (bool, error) {
+	ret := _m.Called(trackingCode)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(trackingCode)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(trackingCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -12,20 +12,20 @@ type PurchaseOrderService struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId
-func (_m *PurchaseOrderService) Create(orderNumber string, orderDate string, trackingCode string, buyerId int, productRecordId int, orderStatusId int) (domain.Purchase_Order, error) {
-	ret := _m.Called(orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+// Create provides a mock function with given fields: orderNumber, orderDate, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, orderStatusId
+func (_m *PurchaseOrderService) Create(orderNumber string, orderDate string, buyerId int, buyerAddressId int, warehouseId int, carrierId int, productRecordId int, quantity int, orderStatusId int) (domain.Purchase_Order, error) {
+	ret := _m.Called(orderNumber, orderDate, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, orderStatusId)
 
 	var r0 domain.Purchase_Order
-	if rf, ok := ret.Get(0).(func(string, string, string, int, int, int) domain.Purchase_Order); ok {
-		r0 = rf(orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+	if rf, ok := ret.Get(0).(func(string, string, int, int, int, int, int, int, int) domain.Purchase_Order); ok {
+		r0 = rf(orderNumber, orderDate, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, orderStatusId)
 	} else {
 		r0 = ret.Get(0).(domain.Purchase_Order)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, int, int, int) error); ok {
-		r1 = rf(orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+	if rf, ok := ret.Get(1).(func(string, string, int, int, int, int, int, int, int) error); ok {
+		r1 = rf(orderNumber, orderDate, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, orderStatusId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+)
+
+type PickingService interface {
+	Pick(purchaseOrderId int, employeeId int) (domain.Picking, error)
+}
+
+type pickingService struct {
+	pickingRepository PickingRepository
+}
+
+func CreatePickingService(r PickingRepository) PickingService {
+	return &pickingService{
+		pickingRepository: r,
+	}
+}
+
+// Pick records the employee as the picker of the order lines not picked yet.
+// The employee must work at the warehouse the order ships from.
+func (s *pickingService) Pick(purchaseOrderId int, employeeId int) (domain.Picking, error) {
+	orderWarehouseId, err := s.pickingRepository.GetOrderWarehouseId(purchaseOrderId)
+
+	if err != nil {
+		return domain.Picking{}, err
+	}
+
+	employeeWarehouseId, err := s.pickingRepository.GetEmployeeWarehouseId(employeeId)
+
+	if err != nil {
+		return domain.Picking{}, err
+	}
+
+	if employeeWarehouseId != orderWarehouseId {
+		return domain.Picking{}, &BusinessRuleError{Err: errors.New("employee must work at the purchase order warehouse")}
+	}
+
+	pickedAt := time.Now().Format("2006-01-02 15:04:05")
+
+	picked, err := s.pickingRepository.PickLines(purchaseOrderId, employeeId, pickedAt)
+
+	if err != nil {
+		return domain.Picking{}, err
+	}
+
+	if picked == 0 {
+		return domain.Picking{}, ErrOrderAlreadyPicked
+	}
+
+	return domain.Picking{
+		PurchaseOrderId: purchaseOrderId,
+		EmployeeId:      employeeId,
+		LinesPicked:     picked,
+		PickedAt:        pickedAt,
+	}, nil
+}
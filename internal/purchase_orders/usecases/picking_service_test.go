@@ -0,0 +1,63 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPick(t *testing.T) {
+	t.Run("Should return error if the purchase order does not exist", func(t *testing.T) {
+		mockPickingRepository := mocks.NewPickingRepository(t)
+		sut := usecases.CreatePickingService(mockPickingRepository)
+		mockPickingRepository.On("GetOrderWarehouseId", 1).Return(0, &usecases.NoElementInFileError{Err: errors.New("purchase order not found")}).Once()
+
+		_, err := sut.Pick(1, 2)
+
+		var fe *usecases.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+	})
+
+	t.Run("Should return error if the employee works at another warehouse", func(t *testing.T) {
+		mockPickingRepository := mocks.NewPickingRepository(t)
+		sut := usecases.CreatePickingService(mockPickingRepository)
+		mockPickingRepository.On("GetOrderWarehouseId", 1).Return(1, nil).Once()
+		mockPickingRepository.On("GetEmployeeWarehouseId", 2).Return(3, nil).Once()
+
+		_, err := sut.Pick(1, 2)
+
+		assert.EqualError(t, err, "employee must work at the purchase order warehouse")
+	})
+
+	t.Run("Should return ErrOrderAlreadyPicked if no line is left to pick", func(t *testing.T) {
+		mockPickingRepository := mocks.NewPickingRepository(t)
+		sut := usecases.CreatePickingService(mockPickingRepository)
+		mockPickingRepository.On("GetOrderWarehouseId", 1).Return(1, nil).Once()
+		mockPickingRepository.On("GetEmployeeWarehouseId", 2).Return(1, nil).Once()
+		mockPickingRepository.On("PickLines", 1, 2, mock.AnythingOfType("string")).Return(0, nil).Once()
+
+		_, err := sut.Pick(1, 2)
+
+		assert.ErrorIs(t, err, usecases.ErrOrderAlreadyPicked)
+	})
+
+	t.Run("Should record the picker and the picking time", func(t *testing.T) {
+		mockPickingRepository := mocks.NewPickingRepository(t)
+		sut := usecases.CreatePickingService(mockPickingRepository)
+		mockPickingRepository.On("GetOrderWarehouseId", 1).Return(1, nil).Once()
+		mockPickingRepository.On("GetEmployeeWarehouseId", 2).Return(1, nil).Once()
+		mockPickingRepository.On("PickLines", 1, 2, mock.AnythingOfType("string")).Return(3, nil).Once()
+
+		p, err := sut.Pick(1, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, p.PurchaseOrderId)
+		assert.Equal(t, 2, p.EmployeeId)
+		assert.Equal(t, 3, p.LinesPicked)
+		assert.NotEmpty(t, p.PickedAt)
+	})
+}
@@ -1,9 +1,37 @@
 package usecases
 
 import (
+	prices "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 )
 
 type PurchaseOrderRepository interface {
-	Create(orderNumber string, orderDate string, trackingCode string, buyerId int, productRecordId int, orderStatusId int) (domain.Purchase_Order, error)
+	Create(orderNumber string, orderDate string, trackingCode string, buyerId int, buyerAddressId int, warehouseId int, carrierId int, productRecordId int, quantity int, unitPrice money.Money, orderStatusId int) (domain.Purchase_Order, error)
+	NextTrackingSerial() (int, error)
+	TrackingCodeExists(trackingCode string) (bool, error)
+}
+
+type DeliveryAddressRepository interface {
+	GetDefaultId(buyerId int) (int, error)
+	BelongsToBuyer(addressId int, buyerId int) (bool, error)
+	GetLocalityId(addressId int) (int, error)
+}
+
+type DispatchRepository interface {
+	WarehouseExists(id int) (bool, error)
+	GetDefaultWarehouseId(localityId int) (int, error)
+	CarrierExists(id int) (bool, error)
+	GetDefaultCarrierId(localityId int) (int, error)
+}
+
+type PriceRepository interface {
+	GetSalePrice(productRecordId int, date string) (money.Money, error)
+	GetBuyerPriceListItem(buyerId int, productRecordId int, date string) (*prices.PriceListItem, error)
+}
+
+type PickingRepository interface {
+	GetOrderWarehouseId(purchaseOrderId int) (int, error)
+	GetEmployeeWarehouseId(employeeId int) (int, error)
+	PickLines(purchaseOrderId int, employeeId int, pickedAt string) (int, error)
 }
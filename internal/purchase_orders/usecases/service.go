@@ -1,29 +1,242 @@
 package usecases
 
-import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+import (
+	"errors"
+	"time"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/tracking"
+)
 
 type PurchaseOrderService interface {
-	Create(orderNumber string, orderDate string, trackingCode string, buyerId int, productRecordId int, orderStatusId int) (domain.Purchase_Order, error)
+	Create(orderNumber string, orderDate string, buyerId int, buyerAddressId int, warehouseId int, carrierId int, productRecordId int, quantity int, orderStatusId int) (domain.Purchase_Order, error)
 }
 
+// maxTrackingAttempts bounds both the serials tried past the next one when a
+// code is already taken and the inserts retried when a concurrent order takes
+// the code first.
+const maxTrackingAttempts = 5
+
 type purchaseOrderService struct {
-	purchaseOrderRepository PurchaseOrderRepository
+	purchaseOrderRepository   PurchaseOrderRepository
+	deliveryAddressRepository DeliveryAddressRepository
+	priceRepository           PriceRepository
+	dispatchRepository        DispatchRepository
 }
 
-func CreatePurchaseOrderService(r PurchaseOrderRepository) PurchaseOrderService {
+func CreatePurchaseOrderService(r PurchaseOrderRepository, dr DeliveryAddressRepository, pr PriceRepository, dpr DispatchRepository) PurchaseOrderService {
 	return &purchaseOrderService{
-		purchaseOrderRepository: r,
+		purchaseOrderRepository:   r,
+		deliveryAddressRepository: dr,
+		priceRepository:           pr,
+		dispatchRepository:        dpr,
+	}
+}
+
+// deliveryAddress returns the informed address when it belongs to the buyer,
+// or the buyer's default address when none is informed.
+func (s *purchaseOrderService) deliveryAddress(buyerId int, buyerAddressId int) (int, error) {
+	if buyerAddressId == 0 {
+		id, err := s.deliveryAddressRepository.GetDefaultId(buyerId)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if id == 0 {
+			return 0, &BusinessRuleError{Err: errors.New("buyer has no delivery address")}
+		}
+
+		return id, nil
+	}
+
+	belongs, err := s.deliveryAddressRepository.BelongsToBuyer(buyerAddressId, buyerId)
+
+	if err != nil {
+		return 0, err
 	}
+
+	if !belongs {
+		return 0, &BusinessRuleError{Err: errors.New("delivery address does not belong to the buyer")}
+	}
+
+	return buyerAddressId, nil
 }
 
-func (s *purchaseOrderService) Create(orderNumber string, orderDate string, trackingCode string, buyerId int, productRecordId int, orderStatusId int) (domain.Purchase_Order, error) {
-	order, err := s.purchaseOrderRepository.Create(orderNumber, orderDate, trackingCode, buyerId, productRecordId, orderStatusId)
+// warehouse returns the informed warehouse when it exists, or the warehouse
+// closest to the delivery address' locality, preferring the same locality and
+// then the same province.
+func (s *purchaseOrderService) warehouse(warehouseId int, localityId int) (int, error) {
+	if warehouseId != 0 {
+		exists, err := s.dispatchRepository.WarehouseExists(warehouseId)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if !exists {
+			return 0, &BusinessRuleError{Err: errors.New("warehouse not found")}
+		}
+
+		return warehouseId, nil
+	}
+
+	id, err := s.dispatchRepository.GetDefaultWarehouseId(localityId)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if id == 0 {
+		return 0, &BusinessRuleError{Err: errors.New("no warehouse available")}
+	}
+
+	return id, nil
+}
+
+// carrier returns the informed carrier when it exists, or the carrier serving
+// the delivery address' locality with the fewest undelivered orders.
+func (s *purchaseOrderService) carrier(carrierId int, localityId int) (int, error) {
+	if carrierId != 0 {
+		exists, err := s.dispatchRepository.CarrierExists(carrierId)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if !exists {
+			return 0, &BusinessRuleError{Err: errors.New("carrier not found")}
+		}
+
+		return carrierId, nil
+	}
+
+	id, err := s.dispatchRepository.GetDefaultCarrierId(localityId)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if id == 0 {
+		return 0, &BusinessRuleError{Err: errors.New("no carrier serves the delivery address locality")}
+	}
+
+	return id, nil
+}
+
+func (s *purchaseOrderService) trackingCode() (string, error) {
+	serial, err := s.purchaseOrderRepository.NextTrackingSerial()
+
+	if err != nil {
+		return "", err
+	}
+
+	for attempt := 0; attempt < maxTrackingAttempts; attempt++ {
+		code, err := tracking.Generate(serial + attempt)
+
+		if err != nil {
+			return "", err
+		}
+
+		exists, err := s.purchaseOrderRepository.TrackingCodeExists(code)
+
+		if err != nil {
+			return "", err
+		}
+
+		if !exists {
+			return code, nil
+		}
+	}
+
+	return "", errors.New("can't generate an unused tracking code")
+}
+
+// unitPrice applies the buyer's price list item valid at the order date, if
+// any, over the product's current sale price.
+func (s *purchaseOrderService) unitPrice(buyerId int, productRecordId int, orderDate string) (money.Money, error) {
+	salePrice, err := s.priceRepository.GetSalePrice(productRecordId, orderDate)
+
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	item, err := s.priceRepository.GetBuyerPriceListItem(buyerId, productRecordId, orderDate)
+
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	if item == nil {
+		return salePrice, nil
+	}
+
+	return item.Apply(salePrice), nil
+}
+
+func (s *purchaseOrderService) Create(orderNumber string, orderDate string, buyerId int, buyerAddressId int, warehouseId int, carrierId int, productRecordId int, quantity int, orderStatusId int) (domain.Purchase_Order, error) {
+	// the price lookups compare DATE(order_date), which MySQL turns into NULL
+	// instead of failing when the date can't be parsed
+	if _, err := time.Parse("2006-01-02", orderDate); err != nil {
+		return domain.Purchase_Order{}, &BusinessRuleError{ErrInvalidOrderDate}
+	}
+
+	buyerAddressId, err := s.deliveryAddress(buyerId, buyerAddressId)
+
+	if err != nil {
+		return domain.Purchase_Order{}, err
+	}
+
+	localityId := 0
+
+	if warehouseId == 0 || carrierId == 0 {
+		localityId, err = s.deliveryAddressRepository.GetLocalityId(buyerAddressId)
+
+		if err != nil {
+			return domain.Purchase_Order{}, err
+		}
+	}
+
+	warehouseId, err = s.warehouse(warehouseId, localityId)
 
 	if err != nil {
 		return domain.Purchase_Order{}, err
 	}
 
-	return order, nil
+	carrierId, err = s.carrier(carrierId, localityId)
+
+	if err != nil {
+		return domain.Purchase_Order{}, err
+	}
+
+	unitPrice, err := s.unitPrice(buyerId, productRecordId, orderDate)
+
+	if err != nil {
+		return domain.Purchase_Order{}, err
+	}
+
+	for attempt := 0; attempt < maxTrackingAttempts; attempt++ {
+		trackingCode, err := s.trackingCode()
+
+		if err != nil {
+			return domain.Purchase_Order{}, err
+		}
+
+		order, err := s.purchaseOrderRepository.Create(orderNumber, orderDate, trackingCode, buyerId, buyerAddressId, warehouseId, carrierId, productRecordId, quantity, unitPrice, orderStatusId)
+
+		if errors.Is(err, ErrTrackingCodeTaken) {
+			continue
+		}
+
+		if err != nil {
+			return domain.Purchase_Order{}, err
+		}
+
+		return order, nil
+	}
+
+	return domain.Purchase_Order{}, errors.New("can't generate an unused tracking code")
 }
 
 // func (s *purchaseOrderService) GetPurchaseOrderById()
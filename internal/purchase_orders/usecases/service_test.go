@@ -1,38 +1,69 @@
 package usecases_test
 
 import (
+	"errors"
 	"testing"
 
+	prices "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/price_lists/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
-func makeCreateParams() (string, string, string, int, int, int) {
-	return "123", "01-01-2022", "123", 1, 1, 1
+func makeCreateParams() (string, string, int, int, int, int, int, int, int) {
+	return "123", "2022-01-01", 1, 0, 1, 0, 1, 2, 1
 }
 
 func makePurchaseOrder() domain.Purchase_Order {
 	return domain.Purchase_Order{
 		ID:              1,
 		OrderNumber:     "123",
-		OrderDate:       "01-01-2022",
-		TrackingCode:    "123",
+		OrderDate:       "2022-01-01",
+		TrackingCode:    "MF123456785BR",
 		BuyerId:         1,
+		BuyerAddressId:  1,
+		WarehouseId:     1,
+		CarrierId:       3,
 		ProductRecordId: 1,
+		Quantity:        2,
+		UnitPrice:       money.FromCents(1000),
 		OrderStatusId:   1,
 	}
 }
 
 func TestCreate(t *testing.T) {
 	mockPurchaseOrderRepository := mocks.NewPurchaseOrderRepository(t)
-	service := usecases.CreatePurchaseOrderService(mockPurchaseOrderRepository)
+	mockDeliveryAddressRepository := mocks.NewDeliveryAddressRepository(t)
+	mockPriceRepository := mocks.NewPriceRepository(t)
+	mockDispatchRepository := mocks.NewDispatchRepository(t)
+	service := usecases.CreatePurchaseOrderService(mockPurchaseOrderRepository, mockDeliveryAddressRepository, mockPriceRepository, mockDispatchRepository)
+
+	expectDispatch := func() {
+		mockDispatchRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockDeliveryAddressRepository.On("GetLocalityId", 1).Return(5, nil).Once()
+		mockDispatchRepository.On("GetDefaultCarrierId", 5).Return(3, nil).Once()
+	}
+
+	expectPrice := func(item *prices.PriceListItem) {
+		mockPriceRepository.On("GetSalePrice", 1, "2022-01-01").Return(money.FromCents(1000), nil).Once()
+		mockPriceRepository.On("GetBuyerPriceListItem", 1, 1, "2022-01-01").Return(item, nil).Once()
+	}
+
+	expectTrackingCode := func() {
+		mockPurchaseOrderRepository.On("NextTrackingSerial").Return(12345678, nil).Once()
+		mockPurchaseOrderRepository.On("TrackingCodeExists", "MF123456785BR").Return(false, nil).Once()
+	}
 
 	t.Run("create_ok", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		expectPrice(nil)
+		expectTrackingCode()
 		mockPurchaseOrderRepository.
-			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("int")).
+			On("Create", "123", "2022-01-01", "MF123456785BR", 1, 1, 1, 3, 1, 2, money.FromCents(1000), 1).
 			Return(makePurchaseOrder(), nil).
 			Once()
 
@@ -41,4 +72,228 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, makePurchaseOrder(), p)
 		assert.Nil(t, err)
 	})
+
+	t.Run("create_without_delivery_address", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(0, nil).Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		var be *usecases.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, "buyer has no delivery address")
+	})
+
+	t.Run("create_with_address_of_another_buyer", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("BelongsToBuyer", 5, 1).Return(false, nil).Once()
+
+		_, err := service.Create("123", "2022-01-01", 1, 5, 1, 0, 1, 1, 1)
+
+		var be *usecases.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, "delivery address does not belong to the buyer")
+	})
+
+	t.Run("create_with_informed_address_and_carrier", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("BelongsToBuyer", 2, 1).Return(true, nil).Once()
+		mockDispatchRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockDispatchRepository.On("CarrierExists", 4).Return(true, nil).Once()
+		expectPrice(nil)
+		expectTrackingCode()
+		mockPurchaseOrderRepository.
+			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), "MF123456785BR", 1, 2, 1, 4, 1, 1, money.FromCents(1000), 1).
+			Return(makePurchaseOrder(), nil).
+			Once()
+
+		_, err := service.Create("123", "2022-01-01", 1, 2, 1, 4, 1, 1, 1)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("create_address_lookup_error", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(0, errors.New("any_error")).Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("create_with_unknown_warehouse", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		mockDeliveryAddressRepository.On("GetLocalityId", 1).Return(5, nil).Once()
+		mockDispatchRepository.On("WarehouseExists", 1).Return(false, nil).Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		var be *usecases.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, "warehouse not found")
+	})
+
+	t.Run("create_without_warehouse_uses_the_closest_one", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		mockDeliveryAddressRepository.On("GetLocalityId", 1).Return(5, nil).Once()
+		mockDispatchRepository.On("GetDefaultWarehouseId", 5).Return(7, nil).Once()
+		mockDispatchRepository.On("GetDefaultCarrierId", 5).Return(3, nil).Once()
+		expectPrice(nil)
+		expectTrackingCode()
+		mockPurchaseOrderRepository.
+			On("Create", "123", "2022-01-01", "MF123456785BR", 1, 1, 7, 3, 1, 2, money.FromCents(1000), 1).
+			Return(makePurchaseOrder(), nil).
+			Once()
+
+		_, err := service.Create("123", "2022-01-01", 1, 0, 0, 0, 1, 2, 1)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("create_without_any_warehouse", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		mockDeliveryAddressRepository.On("GetLocalityId", 1).Return(5, nil).Once()
+		mockDispatchRepository.On("GetDefaultWarehouseId", 5).Return(0, nil).Once()
+
+		_, err := service.Create("123", "2022-01-01", 1, 0, 0, 0, 1, 2, 1)
+
+		var be *usecases.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, "no warehouse available")
+	})
+
+	t.Run("create_with_unknown_carrier", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		mockDispatchRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockDispatchRepository.On("CarrierExists", 9).Return(false, nil).Once()
+
+		_, err := service.Create("123", "2022-01-01", 1, 0, 1, 9, 1, 1, 1)
+
+		var be *usecases.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, "carrier not found")
+	})
+
+	t.Run("create_without_carrier_in_the_locality", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		mockDispatchRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockDeliveryAddressRepository.On("GetLocalityId", 1).Return(5, nil).Once()
+		mockDispatchRepository.On("GetDefaultCarrierId", 5).Return(0, nil).Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		var be *usecases.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, "no carrier serves the delivery address locality")
+	})
+
+	t.Run("create_skips_tracking_codes_in_use", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		expectPrice(nil)
+		mockPurchaseOrderRepository.On("NextTrackingSerial").Return(1, nil).Once()
+		mockPurchaseOrderRepository.On("TrackingCodeExists", "MF000000014BR").Return(true, nil).Once()
+		mockPurchaseOrderRepository.On("TrackingCodeExists", "MF000000028BR").Return(false, nil).Once()
+		mockPurchaseOrderRepository.
+			On("Create", "123", "2022-01-01", "MF000000028BR", 1, 1, 1, 3, 1, 2, money.FromCents(1000), 1).
+			Return(makePurchaseOrder(), nil).
+			Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("create_retries_when_a_concurrent_order_takes_the_tracking_code", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		expectPrice(nil)
+		mockPurchaseOrderRepository.On("NextTrackingSerial").Return(1, nil).Once()
+		mockPurchaseOrderRepository.On("TrackingCodeExists", "MF000000014BR").Return(false, nil).Once()
+		mockPurchaseOrderRepository.
+			On("Create", "123", "2022-01-01", "MF000000014BR", 1, 1, 1, 3, 1, 2, money.FromCents(1000), 1).
+			Return(domain.Purchase_Order{}, usecases.ErrTrackingCodeTaken).
+			Once()
+		mockPurchaseOrderRepository.On("NextTrackingSerial").Return(2, nil).Once()
+		mockPurchaseOrderRepository.On("TrackingCodeExists", "MF000000028BR").Return(false, nil).Once()
+		mockPurchaseOrderRepository.
+			On("Create", "123", "2022-01-01", "MF000000028BR", 1, 1, 1, 3, 1, 2, money.FromCents(1000), 1).
+			Return(makePurchaseOrder(), nil).
+			Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("create_gives_up_when_tracking_codes_keep_colliding", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		expectPrice(nil)
+		mockPurchaseOrderRepository.On("NextTrackingSerial").Return(1, nil).Once()
+		mockPurchaseOrderRepository.On("TrackingCodeExists", mock.AnythingOfType("string")).Return(true, nil).Times(5)
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.EqualError(t, err, "can't generate an unused tracking code")
+	})
+
+	t.Run("create_with_fixed_price_from_buyer_price_list", func(t *testing.T) {
+		price := money.FromCents(800)
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		expectPrice(&prices.PriceListItem{Id: 1, Price: &price})
+		expectTrackingCode()
+		mockPurchaseOrderRepository.
+			On("Create", "123", "2022-01-01", "MF123456785BR", 1, 1, 1, 3, 1, 2, money.FromCents(800), 1).
+			Return(makePurchaseOrder(), nil).
+			Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("create_with_discount_from_buyer_price_list", func(t *testing.T) {
+		discount := money.FromBasisPoints(1250)
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		expectPrice(&prices.PriceListItem{Id: 1, DiscountPercent: &discount})
+		expectTrackingCode()
+		mockPurchaseOrderRepository.
+			On("Create", "123", "2022-01-01", "MF123456785BR", 1, 1, 1, 3, 1, 2, money.FromCents(875), 1).
+			Return(makePurchaseOrder(), nil).
+			Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("create_with_unknown_product_record", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		mockPriceRepository.On("GetSalePrice", 1, "2022-01-01").Return(money.Money{}, &usecases.NoElementInFileError{Err: errors.New("product record not found")}).Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		var fe *usecases.NoElementInFileError
+		assert.ErrorAs(t, err, &fe)
+	})
+
+	t.Run("create_price_list_lookup_error", func(t *testing.T) {
+		mockDeliveryAddressRepository.On("GetDefaultId", 1).Return(1, nil).Once()
+		expectDispatch()
+		mockPriceRepository.On("GetSalePrice", 1, "2022-01-01").Return(money.FromCents(1000), nil).Once()
+		mockPriceRepository.On("GetBuyerPriceListItem", 1, 1, "2022-01-01").Return(nil, errors.New("any_error")).Once()
+
+		_, err := service.Create(makeCreateParams())
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("create_with_invalid_order_date", func(t *testing.T) {
+		_, err := service.Create("123", "01-01-2022", 1, 0, 1, 0, 1, 2, 1)
+
+		var be *usecases.BusinessRuleError
+		assert.ErrorAs(t, err, &be)
+		assert.EqualError(t, err, usecases.ErrInvalidOrderDate.Error())
+		mockPriceRepository.AssertNotCalled(t, "GetSalePrice", 1, "01-01-2022")
+	})
 }
@@ -1,14 +1,15 @@
 package adapters
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 )
 
 type RecordsController struct {
@@ -21,30 +22,61 @@ func NewRecordController(p usecases.RecordsService) *RecordsController {
 	}
 }
 
+// GetRecordsPerProduct serves /records and keeps its original field names.
 func (c *RecordsController) GetRecordsPerProduct() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		result, ok := c.reportRecords(ctx)
 
-		ids := ctx.QueryArray("id")
+		if ok {
+			ctx.JSON(http.StatusOK, result)
+		}
+	}
+}
 
-		result := record_domain.ReportRecords{}
+func (c *RecordsController) GetReportRecords() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		result, ok := c.reportRecords(ctx)
 
-		for _, string_id := range ids {
-			id, _ := strconv.Atoi(string_id)
-			r, err := c.service.GetRecordsPerProduct(id)
+		if !ok {
+			return
+		}
 
-			if err != nil {
-				ctx.JSON(http.StatusNotFound, gin.H{
-					"error": err.Error(),
-				})
+		response := []ReportRecordResponse{}
 
-				return
-			}
+		for _, r := range result {
+			response = append(response, ReportRecordResponse(r))
+		}
 
-			result = append(result, r)
+		ctx.JSON(http.StatusOK, response)
+	}
+}
+
+func (c *RecordsController) reportRecords(ctx *gin.Context) (record_domain.ReportRecords, bool) {
+	ids := []int{}
+
+	for _, string_id := range ctx.QueryArray("id") {
+		id, err := strconv.Atoi(string_id)
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid ID"})
+			return nil, false
 		}
 
-		ctx.JSON(http.StatusOK, result)
+		ids = append(ids, id)
+	}
+
+	result, err := c.service.GetRecordsPerProducts(ids)
+
+	if err != nil {
+		if errors.Is(err, usecases.ErrNoElementFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return nil, false
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return nil, false
 	}
+
+	return result, true
 }
 
 func (c *RecordsController) Create() gin.HandlerFunc {
@@ -58,11 +90,11 @@ func (c *RecordsController) Create() gin.HandlerFunc {
 			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "A data de atualização é obrigatória"})
 			return
 		}
-		if req.Purchase_Price == 0 {
+		if req.Purchase_Price.IsZero() {
 			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "O valor da compra é obrigatório"})
 			return
 		}
-		if req.Sale_Price == 0 {
+		if req.Sale_Price.IsZero() {
 			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "O valor de venda é obrigatório"})
 			return
 		}
@@ -71,35 +103,112 @@ func (c *RecordsController) Create() gin.HandlerFunc {
 			return
 		}
 
-		r, err := c.service.Create(req.Last_Update_Date, req.Purchase_Price, req.Sale_Price, req.Product_Id)
+		if req.Purchase_Price.IsNegative() || req.Sale_Price.IsNegative() {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Os valores de compra e venda não podem ser negativos"})
+			return
+		}
 
-		now := time.Now()
-		currentDate := now.Format("2006-01-02")
+		date, err := time.Parse("2006-01-02", req.Last_Update_Date)
 
-		if req.Last_Update_Date < currentDate {
-			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "A data de atualização deve estar no formato yyyy-mm-dd"})
+			return
 		}
 
+		if date.Before(today()) {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "A data de atualização não pode estar no passado"})
+			return
+		}
+
+		r, err := c.service.Create(req.Last_Update_Date, req.Purchase_Price, req.Sale_Price, req.Product_Id, req.Allow_Negative_Margin)
+
 		if err != nil {
-			if strings.Contains(err.Error(), "is already in use") {
-				ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			switch {
+			case errors.Is(err, usecases.ErrNegativeMargin):
+				ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			case errors.Is(err, usecases.ErrNoElementFound):
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			default:
+				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			}
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, r)
+	}
+}
+
+func (c *RecordsController) GetPriceHistory() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid ID"})
+			return
+		}
+
+		h, err := c.service.GetPriceHistory(id)
+
+		if err != nil {
+			if errors.Is(err, usecases.ErrNoElementFound) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 				return
 			}
-			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 			return
 		}
 
-		if err == nil {
-			ctx.JSON(http.StatusCreated, r)
+		ctx.JSON(http.StatusOK, h)
+	}
+}
+
+func (c *RecordsController) GetCurrentPrice() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid ID"})
 			return
 		}
+
+		date := ctx.DefaultQuery("date", time.Now().Format("2006-01-02"))
+
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "date must respect the pattern yyyy-mm-dd"})
+			return
+		}
+
+		r, err := c.service.GetCurrentPrice(id, date)
+
+		if err != nil {
+			if errors.Is(err, usecases.ErrNoElementFound) || errors.Is(err, usecases.ErrNoPriceFound) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, r)
 	}
 }
 
+type ReportRecordResponse struct {
+	Product_Id    int    `json:"product_id"`
+	Description   string `json:"description"`
+	Records_Count int    `json:"records_count"`
+}
+
 type Request struct {
-	Id               int    `json:"id"`
-	Last_Update_Date string `json:"last_update_date"`
-	Purchase_Price   int    `json:"purchase_price"`
-	Sale_Price       int    `json:"sale_price"`
-	Product_Id       int    `json:"product_id"`
+	Id                    int         `json:"id"`
+	Last_Update_Date      string      `json:"last_update_date"`
+	Purchase_Price        money.Money `json:"purchase_price"`
+	Sale_Price            money.Money `json:"sale_price"`
+	Product_Id            int         `json:"product_id"`
+	Allow_Negative_Margin bool        `json:"allow_negative_margin"`
+}
+
+func today() time.Time {
+	y, m, d := time.Now().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
 }
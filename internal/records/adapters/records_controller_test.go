@@ -0,0 +1,152 @@
+package adapters_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeCreateBody(date string) string {
+	return fmt.Sprintf(`{"last_update_date": "%s", "purchase_price": 10.50, "sale_price": 12.00, "product_id": 1}`, date)
+}
+
+func TestCreateRecord(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockRecordsService := &mocks.RecordsService{}
+	controller := adapters.NewRecordController(mockRecordsService)
+
+	r := gin.Default()
+	r.POST("/records", controller.Create())
+
+	today := time.Now().Format("2006-01-02")
+
+	t.Run("Should return 400 status if the date is invalid or in the past", func(t *testing.T) {
+		for _, date := range []string{"01-05-2022", "2022-05-01"} {
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/records", strings.NewReader(makeCreateBody(date)))
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code, date)
+		}
+
+		mockRecordsService.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("Should map service errors to status codes", func(t *testing.T) {
+		for err, status := range map[error]int{
+			usecases.ErrNegativeMargin: http.StatusUnprocessableEntity,
+			usecases.ErrNoElementFound: http.StatusNotFound,
+			errors.New("any_error"):    http.StatusInternalServerError,
+		} {
+			mockRecordsService.On("Create", today, money.FromCents(1050), money.FromCents(1200), 1, false).Return(record_domain.Records{}, err).Once()
+
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/records", strings.NewReader(makeCreateBody(today)))
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, status, res.Code, err.Error())
+			mockRecordsService.AssertExpectations(t)
+		}
+	})
+
+	t.Run("Should hide internal errors", func(t *testing.T) {
+		mockRecordsService.On("Create", today, money.FromCents(1050), money.FromCents(1200), 1, false).Return(record_domain.Records{}, errors.New("any_error")).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/records", strings.NewReader(makeCreateBody(today)))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.JSONEq(t, `{"error": "internal server error"}`, res.Body.String())
+	})
+
+	t.Run("Should return 201 status with the created record", func(t *testing.T) {
+		mockRecordsService.On("Create", today, money.FromCents(1050), money.FromCents(1200), 1, false).
+			Return(record_domain.Records{Id: 1, Last_Update_Date: today, Purchase_Price: money.FromCents(1050), Sale_Price: money.FromCents(1200), Product_Id: 1}, nil).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/records", strings.NewReader(makeCreateBody(today)))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.JSONEq(t, fmt.Sprintf(`{"id": 1, "last_update_date": "%s", "purchase_price": 10.50, "sale_price": 12.00, "product_id": 1}`, today), res.Body.String())
+	})
+}
+
+func TestGetReportRecordsController(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockRecordsService := &mocks.RecordsService{}
+	controller := adapters.NewRecordController(mockRecordsService)
+
+	r := gin.Default()
+	r.GET("/products/reportRecords", controller.GetReportRecords())
+	r.GET("/records", controller.GetRecordsPerProduct())
+
+	reports := record_domain.ReportRecords{{Product_Id: 1, Description: "Cafe", Records_Count: 2}}
+
+	t.Run("Should return 400 status if an id is invalid", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/products/reportRecords?id=1&id=abc", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		mockRecordsService.AssertNotCalled(t, "GetRecordsPerProducts")
+	})
+
+	t.Run("Should return 404 status if a product doesn't exist", func(t *testing.T) {
+		mockRecordsService.On("GetRecordsPerProducts", []int{1, 3}).Return(nil, usecases.ErrNoElementFound).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/products/reportRecords?id=1&id=3", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("Should return 500 status if the service fails", func(t *testing.T) {
+		mockRecordsService.On("GetRecordsPerProducts", []int{}).Return(nil, errors.New("any_error")).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/products/reportRecords", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.JSONEq(t, `{"error": "internal server error"}`, res.Body.String())
+	})
+
+	t.Run("Should return 200 status with snake case fields", func(t *testing.T) {
+		mockRecordsService.On("GetRecordsPerProducts", []int{1}).Return(reports, nil).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/products/reportRecords?id=1", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `[{"product_id": 1, "description": "Cafe", "records_count": 2}]`, res.Body.String())
+	})
+
+	t.Run("Should keep the original fields on /records", func(t *testing.T) {
+		mockRecordsService.On("GetRecordsPerProducts", []int{1}).Return(reports, nil).Once()
+
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/records?id=1", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `[{"Product_Id": 1, "Description": "Cafe", "Records_Count": 2}]`, res.Body.String())
+	})
+}
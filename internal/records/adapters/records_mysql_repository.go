@@ -2,9 +2,12 @@ package adapters
 
 import (
 	"database/sql"
+	"errors"
+	"strings"
 
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 )
 
 type mysqlRepository struct {
@@ -17,20 +20,52 @@ func NewRecordMysqlRepository(db *sql.DB) usecases.RecordsRepository {
 	}
 }
 
-func (r *mysqlRepository) GetRecordsPerProduct(product_id int) (int, error) {
-	const query = `SELECT COUNT(*) FROM product_record WHERE product_id=?`
+func (r *mysqlRepository) GetReportRecords(product_ids []int) (record_domain.ReportRecords, error) {
+	query := `SELECT p.id, p.description, COUNT(pr.id) FROM product p LEFT JOIN product_record pr ON pr.product_id = p.id`
 
-	quantity := 0
-	err := r.db.QueryRow(query, product_id).Scan(&quantity)
+	args := []interface{}{}
+
+	if len(product_ids) > 0 {
+		placeholders := make([]string, len(product_ids))
+
+		for i, id := range product_ids {
+			placeholders[i] = "?"
+			args = append(args, id)
+		}
+
+		query += ` WHERE p.id IN (` + strings.Join(placeholders, ", ") + `)`
+	}
+
+	query += ` GROUP BY p.id, p.description ORDER BY p.id`
+
+	rows, err := r.db.Query(query, args...)
 
 	if err != nil {
-		return 0, err
+		return record_domain.ReportRecords{}, err
+	}
+
+	defer rows.Close()
+
+	reports := record_domain.ReportRecords{}
+
+	for rows.Next() {
+		report := record_domain.ReportRecord{}
+
+		if err := rows.Scan(&report.Product_Id, &report.Description, &report.Records_Count); err != nil {
+			return record_domain.ReportRecords{}, err
+		}
+
+		reports = append(reports, report)
 	}
 
-	return quantity, nil
+	if err = rows.Err(); err != nil {
+		return record_domain.ReportRecords{}, err
+	}
+
+	return reports, nil
 }
 
-func (r *mysqlRepository) Create(last_update_date string, purchase_price int, sale_price int, product_id int) (record_domain.Records, error) {
+func (r *mysqlRepository) Create(last_update_date string, purchase_price money.Money, sale_price money.Money, product_id int) (record_domain.Records, error) {
 	tx, err := r.db.Begin()
 
 	if err != nil {
@@ -64,3 +99,51 @@ func (r *mysqlRepository) Create(last_update_date string, purchase_price int, sa
 		Product_Id:       product_id,
 	}, nil
 }
+
+func (r *mysqlRepository) GetByProductId(product_id int) (record_domain.RecordsList, error) {
+	const query = `SELECT id, last_update_date, purchase_price, sale_price, product_id FROM product_record WHERE product_id=? ORDER BY last_update_date, id`
+
+	rows, err := r.db.Query(query, product_id)
+
+	if err != nil {
+		return record_domain.RecordsList{}, err
+	}
+
+	defer rows.Close()
+
+	records := record_domain.RecordsList{}
+
+	for rows.Next() {
+		record := record_domain.Records{}
+
+		if err := rows.Scan(&record.Id, &record.Last_Update_Date, &record.Purchase_Price, &record.Sale_Price, &record.Product_Id); err != nil {
+			return record_domain.RecordsList{}, err
+		}
+
+		records = append(records, record)
+	}
+
+	if err = rows.Err(); err != nil {
+		return record_domain.RecordsList{}, err
+	}
+
+	return records, nil
+}
+
+func (r *mysqlRepository) GetCurrentPrice(product_id int, date string) (record_domain.Records, error) {
+	const query = `SELECT id, last_update_date, purchase_price, sale_price, product_id FROM product_record WHERE product_id=? AND DATE(last_update_date) <= ? ORDER BY last_update_date DESC, id DESC LIMIT 1`
+
+	record := record_domain.Records{}
+
+	err := r.db.QueryRow(query, product_id, date).Scan(&record.Id, &record.Last_Update_Date, &record.Purchase_Price, &record.Sale_Price, &record.Product_Id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return record_domain.Records{}, usecases.ErrNoPriceFound
+	}
+
+	if err != nil {
+		return record_domain.Records{}, err
+	}
+
+	return record, nil
+}
@@ -1,64 +1,21 @@
 package adapters_test
 
 import (
+	"database/sql"
 	"errors"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/adapters"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestGetRecordsPerProduct(t *testing.T) {
-	db, mock, err := sqlmock.New()
-
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	sut := adapters.NewRecordMysqlRepository(db)
-
-	t.Run("Should execute correct query in database", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"count"})
-		rows.AddRow(1)
-		mock.ExpectQuery("SELECT COUNT(.+) FROM product_record").WithArgs(1).WillReturnRows(rows)
-
-		sut.GetRecordsPerProduct(1)
-
-		err = mock.ExpectationsWereMet()
-		assert.Nil(t, err)
-	})
-
-	t.Run("Should return an error if query fails", func(t *testing.T) {
-		mock.ExpectQuery("SELECT COUNT(.+) FROM product_record").WithArgs(1).WillReturnError(errors.New("query_error"))
-
-		result, err := sut.GetRecordsPerProduct(1)
-
-		assert.Equal(t, result, 0)
-		assert.EqualError(t, err, "query_error")
-
-		err = mock.ExpectationsWereMet()
-		assert.Nil(t, err)
-	})
-
-	t.Run("Should return an integer on success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"count"})
-		rows.AddRow(1)
-		mock.ExpectQuery("SELECT COUNT(.+) FROM product_record").WithArgs(1).WillReturnRows(rows)
-
-		result, err := sut.GetRecordsPerProduct(1)
-
-		assert.Equal(t, result, 1)
-		assert.Nil(t, err)
-
-		err = mock.ExpectationsWereMet()
-		assert.Nil(t, err)
-	})
-}
-
 func TestCreate(t *testing.T) {
-	makeCreateParams := func() (string, int, int, int) {
-		return "2000-10-10", 5, 10, 1
+	makeCreateParams := func() (string, money.Money, money.Money, int) {
+		return "2000-10-10", money.FromCents(550), money.FromCents(1000), 1
 	}
 
 	db, mock, err := sqlmock.New()
@@ -114,8 +71,8 @@ func TestCreate(t *testing.T) {
 		expected := record_domain.Records{
 			Id:               1,
 			Last_Update_Date: "2000-10-10",
-			Purchase_Price:   5,
-			Sale_Price:       10,
+			Purchase_Price:   money.FromCents(550),
+			Sale_Price:       money.FromCents(1000),
 			Product_Id:       1,
 		}
 		assert.Equal(t, result, expected)
@@ -125,3 +82,133 @@ func TestCreate(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestGetByProductId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRecordMysqlRepository(db)
+
+	t.Run("Should return an error if query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM product_record WHERE product_id=\\? ORDER BY last_update_date").WithArgs(1).WillReturnError(errors.New("query_error"))
+
+		result, err := sut.GetByProductId(1)
+
+		assert.Equal(t, record_domain.RecordsList{}, result)
+		assert.EqualError(t, err, "query_error")
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return the price timeline on success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "last_update_date", "purchase_price", "sale_price", "product_id"})
+		rows.AddRow(1, "2022-07-01", "5.00", "10.00", 1)
+		rows.AddRow(2, "2022-07-10", "6.00", "12.00", 1)
+		mock.ExpectQuery("SELECT (.+) FROM product_record WHERE product_id=\\? ORDER BY last_update_date").WithArgs(1).WillReturnRows(rows)
+
+		result, err := sut.GetByProductId(1)
+
+		expected := record_domain.RecordsList{
+			{Id: 1, Last_Update_Date: "2022-07-01", Purchase_Price: money.FromCents(500), Sale_Price: money.FromCents(1000), Product_Id: 1},
+			{Id: 2, Last_Update_Date: "2022-07-10", Purchase_Price: money.FromCents(600), Sale_Price: money.FromCents(1200), Product_Id: 1},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetCurrentPrice(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRecordMysqlRepository(db)
+
+	t.Run("Should return ErrNoPriceFound if there is no record until the date", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM product_record WHERE product_id=\\? AND DATE\\(last_update_date\\) <= \\?").WithArgs(1, "2022-07-01").WillReturnError(sql.ErrNoRows)
+
+		result, err := sut.GetCurrentPrice(1, "2022-07-01")
+
+		assert.Equal(t, record_domain.Records{}, result)
+		assert.Equal(t, usecases.ErrNoPriceFound, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return the latest record until the date on success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "last_update_date", "purchase_price", "sale_price", "product_id"})
+		rows.AddRow(2, "2022-07-10", "6.00", "12.00", 1)
+		mock.ExpectQuery("SELECT (.+) FROM product_record WHERE product_id=\\? AND DATE\\(last_update_date\\) <= \\?").WithArgs(1, "2022-07-15").WillReturnRows(rows)
+
+		result, err := sut.GetCurrentPrice(1, "2022-07-15")
+
+		expected := record_domain.Records{Id: 2, Last_Update_Date: "2022-07-10", Purchase_Price: money.FromCents(600), Sale_Price: money.FromCents(1200), Product_Id: 1}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetReportRecords(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRecordMysqlRepository(db)
+
+	t.Run("Should query every product when no id is provided", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "description", "count"})
+		rows.AddRow(1, "Cafe", 2)
+		rows.AddRow(2, "Leite", 0)
+		mock.ExpectQuery("SELECT p.id, p.description, COUNT\\(pr.id\\) FROM product p LEFT JOIN product_record pr ON pr.product_id = p.id GROUP BY").WillReturnRows(rows)
+
+		result, err := sut.GetReportRecords([]int{})
+
+		expected := record_domain.ReportRecords{
+			{Product_Id: 1, Description: "Cafe", Records_Count: 2},
+			{Product_Id: 2, Description: "Leite", Records_Count: 0},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should filter by the provided ids in a single query", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "description", "count"})
+		rows.AddRow(1, "Cafe", 2)
+		mock.ExpectQuery("WHERE p.id IN \\(\\?, \\?\\) GROUP BY").WithArgs(1, 3).WillReturnRows(rows)
+
+		result, err := sut.GetReportRecords([]int{1, 3})
+
+		assert.Equal(t, record_domain.ReportRecords{{Product_Id: 1, Description: "Cafe", Records_Count: 2}}, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return an error if query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT p.id, p.description").WillReturnError(errors.New("query_error"))
+
+		result, err := sut.GetReportRecords([]int{})
+
+		assert.Equal(t, record_domain.ReportRecords{}, result)
+		assert.EqualError(t, err, "query_error")
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
@@ -1,13 +1,17 @@
 package record_domain
 
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
 type Records struct {
-	Id               int    `json:"id"`
-	Last_Update_Date string `json:"last_update_date"`
-	Purchase_Price   int    `json:"purchase_price"`
-	Sale_Price       int    `json:"sale_price"`
-	Product_Id       int    `json:"product_id"`
+	Id               int         `json:"id"`
+	Last_Update_Date string      `json:"last_update_date"`
+	Purchase_Price   money.Money `json:"purchase_price"`
+	Sale_Price       money.Money `json:"sale_price"`
+	Product_Id       int         `json:"product_id"`
 }
 
+type RecordsList []Records
+
 type ReportRecord struct {
 	Product_Id    int
 	Description   string
@@ -15,3 +19,9 @@ type ReportRecord struct {
 }
 
 type ReportRecords []ReportRecord
+
+type PriceHistory struct {
+	Product_Id  int         `json:"product_id"`
+	Description string      `json:"description"`
+	Prices      RecordsList `json:"prices"`
+}
@@ -0,0 +1,9 @@
+package usecases
+
+import "errors"
+
+var ErrNoElementFound = errors.New("element not found")
+
+var ErrNegativeMargin = errors.New("sale price can't be lower than purchase price")
+
+var ErrNoPriceFound = errors.New("there is no price registered for this product at this date")
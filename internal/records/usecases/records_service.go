@@ -1,14 +1,15 @@
 package usecases
 
 import (
-	"errors"
-
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 )
 
 type RecordsService interface {
-	GetRecordsPerProduct(product_id int) (record_domain.ReportRecord, error)
-	Create(last_update_date string, purchase_price int, sale_price int, product_id int) (record_domain.Records, error)
+	GetRecordsPerProducts(product_ids []int) (record_domain.ReportRecords, error)
+	Create(last_update_date string, purchase_price money.Money, sale_price money.Money, product_id int, allow_negative_margin bool) (record_domain.Records, error)
+	GetPriceHistory(product_id int) (record_domain.PriceHistory, error)
+	GetCurrentPrice(product_id int, date string) (record_domain.Records, error)
 }
 
 type recordsService struct {
@@ -23,33 +24,35 @@ func NewRecordsService(r RecordsRepository, p ProductRepository) RecordsService
 	}
 }
 
-func (s *recordsService) GetRecordsPerProduct(product_id int) (record_domain.ReportRecord, error) {
-	product, err := s.productRepository.GetById(product_id)
+func (s *recordsService) GetRecordsPerProducts(product_ids []int) (record_domain.ReportRecords, error) {
+	reports, err := s.recordsRepository.GetReportRecords(product_ids)
 
 	if err != nil {
-		return record_domain.ReportRecord{}, err
+		return record_domain.ReportRecords{}, err
 	}
 
-	rp, err := s.recordsRepository.GetRecordsPerProduct(product_id)
+	requested := map[int]bool{}
 
-	if err != nil {
-		return record_domain.ReportRecord{}, err
+	for _, id := range product_ids {
+		requested[id] = true
 	}
 
-	result := record_domain.ReportRecord{
-		Product_Id:    product_id,
-		Description:   product.Description,
-		Records_Count: rp,
+	if len(reports) < len(requested) {
+		return record_domain.ReportRecords{}, ErrNoElementFound
 	}
 
-	return result, nil
+	return reports, nil
 }
 
-func (s *recordsService) Create(last_update_date string, purchase_price int, sale_price int, product_id int) (record_domain.Records, error) {
+func (s *recordsService) Create(last_update_date string, purchase_price money.Money, sale_price money.Money, product_id int, allow_negative_margin bool) (record_domain.Records, error) {
+	if sale_price.LessThan(purchase_price) && !allow_negative_margin {
+		return record_domain.Records{}, ErrNegativeMargin
+	}
+
 	_, err := s.productRepository.GetById(product_id)
 
 	if err != nil {
-		return record_domain.Records{}, errors.New("element not found")
+		return record_domain.Records{}, ErrNoElementFound
 	}
 
 	record, err := s.recordsRepository.Create(last_update_date, purchase_price, sale_price, product_id)
@@ -60,3 +63,39 @@ func (s *recordsService) Create(last_update_date string, purchase_price int, sal
 
 	return record, nil
 }
+
+func (s *recordsService) GetPriceHistory(product_id int) (record_domain.PriceHistory, error) {
+	product, err := s.productRepository.GetById(product_id)
+
+	if err != nil {
+		return record_domain.PriceHistory{}, ErrNoElementFound
+	}
+
+	prices, err := s.recordsRepository.GetByProductId(product_id)
+
+	if err != nil {
+		return record_domain.PriceHistory{}, err
+	}
+
+	return record_domain.PriceHistory{
+		Product_Id:  product.Id,
+		Description: product.Description,
+		Prices:      prices,
+	}, nil
+}
+
+func (s *recordsService) GetCurrentPrice(product_id int, date string) (record_domain.Records, error) {
+	_, err := s.productRepository.GetById(product_id)
+
+	if err != nil {
+		return record_domain.Records{}, ErrNoElementFound
+	}
+
+	record, err := s.recordsRepository.GetCurrentPrice(product_id, date)
+
+	if err != nil {
+		return record_domain.Records{}, err
+	}
+
+	return record, nil
+}
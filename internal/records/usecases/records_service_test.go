@@ -0,0 +1,68 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeReportRecords() record_domain.ReportRecords {
+	return record_domain.ReportRecords{
+		{Product_Id: 1, Description: "Cafe", Records_Count: 2},
+		{Product_Id: 2, Description: "Leite", Records_Count: 0},
+	}
+}
+
+func TestGetRecordsPerProducts(t *testing.T) {
+	t.Run("Should return the report of every product if no id is given", func(t *testing.T) {
+		mockRecordsRepository := &mocks.RecordsRepository{}
+		sut := usecases.NewRecordsService(mockRecordsRepository, &mocks.ProductRepository{})
+
+		mockRecordsRepository.On("GetReportRecords", []int{}).Return(makeReportRecords(), nil).Once()
+
+		result, err := sut.GetRecordsPerProducts([]int{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, makeReportRecords(), result)
+		mockRecordsRepository.AssertExpectations(t)
+	})
+
+	t.Run("Should return the report of the requested products, even if an id is repeated", func(t *testing.T) {
+		mockRecordsRepository := &mocks.RecordsRepository{}
+		sut := usecases.NewRecordsService(mockRecordsRepository, &mocks.ProductRepository{})
+
+		mockRecordsRepository.On("GetReportRecords", []int{1, 2, 1}).Return(makeReportRecords(), nil).Once()
+
+		result, err := sut.GetRecordsPerProducts([]int{1, 2, 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, makeReportRecords(), result)
+	})
+
+	t.Run("Should return ErrNoElementFound if a requested product doesn't exist", func(t *testing.T) {
+		mockRecordsRepository := &mocks.RecordsRepository{}
+		sut := usecases.NewRecordsService(mockRecordsRepository, &mocks.ProductRepository{})
+
+		mockRecordsRepository.On("GetReportRecords", []int{1, 3}).Return(makeReportRecords()[:1], nil).Once()
+
+		result, err := sut.GetRecordsPerProducts([]int{1, 3})
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+		assert.Equal(t, record_domain.ReportRecords{}, result)
+	})
+
+	t.Run("Should return an error if GetReportRecords fails", func(t *testing.T) {
+		mockRecordsRepository := &mocks.RecordsRepository{}
+		sut := usecases.NewRecordsService(mockRecordsRepository, &mocks.ProductRepository{})
+
+		mockRecordsRepository.On("GetReportRecords", []int{1}).Return(nil, errors.New("any_error")).Once()
+
+		_, err := sut.GetRecordsPerProducts([]int{1})
+
+		assert.EqualError(t, err, "any_error")
+	})
+}
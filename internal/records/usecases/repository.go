@@ -1,10 +1,15 @@
 package usecases
 
-import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/records/record_domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+)
 
 type RecordsRepository interface {
-	GetRecordsPerProduct(product_id int) (int, error)
-	Create(last_update_date string, purchase_price int, sale_price int, product_id int) (record_domain.Records, error)
+	GetReportRecords(product_ids []int) (record_domain.ReportRecords, error)
+	Create(last_update_date string, purchase_price money.Money, sale_price money.Money, product_id int) (record_domain.Records, error)
+	GetByProductId(product_id int) (record_domain.RecordsList, error)
+	GetCurrentPrice(product_id int, date string) (record_domain.Records, error)
 }
 
 type ProductRepository interface {
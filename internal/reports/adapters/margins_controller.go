@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+)
+
+type MarginsController struct {
+	service usecases.MarginsService
+}
+
+func NewMarginsController(s usecases.MarginsService) *MarginsController {
+	return &MarginsController{
+		service: s,
+	}
+}
+
+func (c *MarginsController) GetMargins() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		filter := domain.MarginFilter{
+			From: ctx.Query("from"),
+			To:   ctx.Query("to"),
+		}
+
+		var err error
+
+		if filter.Seller_Id, err = optionalId(ctx.Query("seller_id")); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid seller_id"})
+			return
+		}
+
+		if filter.Product_Type_Id, err = optionalId(ctx.Query("product_type_id")); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid product_type_id"})
+			return
+		}
+
+		for _, date := range []string{filter.From, filter.To} {
+			if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "dates must be in the format yyyy-mm-dd"})
+				return
+			}
+		}
+
+		format, by := ctx.DefaultQuery("format", "json"), ctx.DefaultQuery("by", "product")
+
+		if format != "json" && format != "csv" || by != "product" && by != "seller" {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or csv and by must be product or seller"})
+			return
+		}
+
+		report, err := c.service.GetMargins(filter)
+
+		if err != nil {
+			if errors.Is(err, usecases.ErrInvalidDateRange) {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		if format == "json" {
+			ctx.JSON(http.StatusOK, report)
+			return
+		}
+
+		var rows [][]string
+
+		if by == "seller" {
+			rows = sellerMarginsCSV(report.Sellers)
+		} else {
+			rows = productMarginsCSV(report.Products)
+		}
+
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+
+		if err := w.WriteAll(rows); err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.Header("Content-Disposition", `attachment; filename="margins_by_`+by+`.csv"`)
+		ctx.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+	}
+}
+
+func optionalId(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(s)
+
+	if err != nil || id <= 0 {
+		return 0, errors.New("invalid id")
+	}
+
+	return id, nil
+}
+
+func productMarginsCSV(products []domain.ProductMargin) [][]string {
+	rows := [][]string{{
+		"product_id", "description", "seller_id", "product_type_id", "records_count",
+		"average_margin", "average_margin_amount", "price_volatility",
+		"latest_change_date", "previous_sale_price", "sale_price", "change_percent",
+	}}
+
+	for _, p := range products {
+		row := []string{
+			strconv.Itoa(p.Product_Id),
+			p.Description,
+			strconv.Itoa(p.Seller_Id),
+			strconv.Itoa(p.Product_Type_Id),
+			strconv.Itoa(p.Records_Count),
+			formatFloat(p.Average_Margin),
+			p.Average_Margin_Amount.String(),
+			formatFloat(p.Price_Volatility),
+			"", "", "", "",
+		}
+
+		if change := p.Latest_Price_Change; change != nil {
+			row[8] = change.Date
+			row[9] = change.Previous_Sale_Price.String()
+			row[10] = change.Sale_Price.String()
+			row[11] = formatFloat(change.Change_Percent)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func sellerMarginsCSV(sellers []domain.SellerMargin) [][]string {
+	rows := [][]string{{"seller_id", "products_count", "records_count", "average_margin", "average_margin_amount"}}
+
+	for _, s := range sellers {
+		rows = append(rows, []string{
+			strconv.Itoa(s.Seller_Id),
+			strconv.Itoa(s.Products_Count),
+			strconv.Itoa(s.Records_Count),
+			formatFloat(s.Average_Margin),
+			s.Average_Margin_Amount.String(),
+		})
+	}
+
+	return rows
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
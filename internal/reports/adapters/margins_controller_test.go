@@ -0,0 +1,115 @@
+package adapters_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeMarginReport() domain.MarginReport {
+	return domain.MarginReport{
+		Products: []domain.ProductMargin{
+			{
+				Product_Id:            1,
+				Description:           "Cafe",
+				Seller_Id:             1,
+				Product_Type_Id:       1,
+				Records_Count:         2,
+				Average_Margin:        50,
+				Average_Margin_Amount: money.FromCents(550),
+				Price_Volatility:      9.09,
+				Latest_Price_Change: &domain.PriceChange{
+					Date:                "2022-07-10",
+					Previous_Sale_Price: money.FromCents(1000),
+					Sale_Price:          money.FromCents(1200),
+					Change_Percent:      20,
+				},
+			},
+		},
+		Sellers: []domain.SellerMargin{
+			{Seller_Id: 1, Products_Count: 1, Records_Count: 2, Average_Margin: 50, Average_Margin_Amount: money.FromCents(550)},
+		},
+	}
+}
+
+func TestGetMargins(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockMarginsService := mocks.NewMarginsService(t)
+	controller := adapters.NewMarginsController(mockMarginsService)
+
+	r := gin.Default()
+	r.GET("/reports/margins", controller.GetMargins())
+
+	t.Run("Should return 400 status if query params are invalid", func(t *testing.T) {
+		for _, query := range []string{"seller_id=abc", "product_type_id=0", "from=01-07-2022", "to=2022-13-01", "format=xml", "format=csv&by=type"} {
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/reports/margins?"+query, nil)
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code, query)
+		}
+	})
+
+	t.Run("Should return 400 status if GetMargins from Margins Service returns ErrInvalidDateRange", func(t *testing.T) {
+		filter := domain.MarginFilter{From: "2022-08-01", To: "2022-07-01"}
+		mockMarginsService.On("GetMargins", filter).Return(domain.MarginReport{}, usecases.ErrInvalidDateRange).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/margins?from=2022-08-01&to=2022-07-01", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.Equal(t, "{\"error\":\"from date must be before or equal to to date\"}", res.Body.String())
+	})
+
+	t.Run("Should return an error and 500 status if GetMargins from Margins Service returns an error", func(t *testing.T) {
+		mockMarginsService.On("GetMargins", domain.MarginFilter{}).Return(domain.MarginReport{}, errors.New("any_error")).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/margins", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.Equal(t, "{\"error\":\"internal server error\"}", res.Body.String())
+	})
+
+	t.Run("Should return 200 status and the report as JSON on success", func(t *testing.T) {
+		filter := domain.MarginFilter{Seller_Id: 1, Product_Type_Id: 1, From: "2022-07-01", To: "2022-07-31"}
+		mockMarginsService.On("GetMargins", filter).Return(makeMarginReport(), nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/margins?seller_id=1&product_type_id=1&from=2022-07-01&to=2022-07-31", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "{\"products\":[{\"product_id\":1,\"description\":\"Cafe\",\"seller_id\":1,\"product_type_id\":1,\"records_count\":2,\"average_margin\":50,\"average_margin_amount\":5.50,\"price_volatility\":9.09,\"latest_price_change\":{\"date\":\"2022-07-10\",\"previous_sale_price\":10.00,\"sale_price\":12.00,\"change_percent\":20}}],\"sellers\":[{\"seller_id\":1,\"products_count\":1,\"records_count\":2,\"average_margin\":50,\"average_margin_amount\":5.50}]}", res.Body.String())
+	})
+
+	t.Run("Should return 200 status and the products as CSV if format is csv", func(t *testing.T) {
+		mockMarginsService.On("GetMargins", domain.MarginFilter{}).Return(makeMarginReport(), nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/margins?format=csv", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", res.Header().Get("Content-Type"))
+		assert.Equal(t, "product_id,description,seller_id,product_type_id,records_count,average_margin,average_margin_amount,price_volatility,latest_change_date,previous_sale_price,sale_price,change_percent\n1,Cafe,1,1,2,50.00,5.50,9.09,2022-07-10,10.00,12.00,20.00\n", res.Body.String())
+	})
+
+	t.Run("Should return 200 status and the sellers as CSV if by is seller", func(t *testing.T) {
+		mockMarginsService.On("GetMargins", domain.MarginFilter{}).Return(makeMarginReport(), nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/margins?format=csv&by=seller", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "seller_id,products_count,records_count,average_margin,average_margin_amount\n1,1,2,50.00,5.50\n", res.Body.String())
+	})
+}
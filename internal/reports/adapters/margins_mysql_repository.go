@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"database/sql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+)
+
+type marginsMysqlRepository struct {
+	db *sql.DB
+}
+
+func NewMarginsMysqlRepository(db *sql.DB) usecases.MarginsRepository {
+	return &marginsMysqlRepository{
+		db: db,
+	}
+}
+
+func (r *marginsMysqlRepository) GetPriceRecords(filter domain.MarginFilter) (domain.PriceRecords, error) {
+	query := `SELECT p.id, p.description, p.seller_id, p.product_type_id, pr.last_update_date, pr.purchase_price, pr.sale_price FROM product_record pr INNER JOIN product p ON p.id = pr.product_id WHERE 1=1`
+
+	args := []interface{}{}
+
+	if filter.Seller_Id != 0 {
+		query += ` AND p.seller_id = ?`
+		args = append(args, filter.Seller_Id)
+	}
+
+	if filter.Product_Type_Id != 0 {
+		query += ` AND p.product_type_id = ?`
+		args = append(args, filter.Product_Type_Id)
+	}
+
+	if filter.From != "" {
+		query += ` AND DATE(pr.last_update_date) >= ?`
+		args = append(args, filter.From)
+	}
+
+	if filter.To != "" {
+		query += ` AND DATE(pr.last_update_date) <= ?`
+		args = append(args, filter.To)
+	}
+
+	query += ` ORDER BY p.id, pr.last_update_date, pr.id`
+
+	rows, err := r.db.Query(query, args...)
+
+	if err != nil {
+		return domain.PriceRecords{}, err
+	}
+
+	defer rows.Close()
+
+	records := domain.PriceRecords{}
+
+	for rows.Next() {
+		record := domain.PriceRecord{}
+
+		if err := rows.Scan(&record.Product_Id, &record.Description, &record.Seller_Id, &record.Product_Type_Id, &record.Last_Update_Date, &record.Purchase_Price, &record.Sale_Price); err != nil {
+			return domain.PriceRecords{}, err
+		}
+
+		records = append(records, record)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.PriceRecords{}, err
+	}
+
+	return records, nil
+}
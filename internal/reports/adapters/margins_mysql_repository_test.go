@@ -0,0 +1,68 @@
+package adapters_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPriceRecords(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewMarginsMysqlRepository(db)
+
+	columns := []string{"id", "description", "seller_id", "product_type_id", "last_update_date", "purchase_price", "sale_price"}
+
+	t.Run("Should return an error if query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM product_record pr INNER JOIN product p").WillReturnError(errors.New("query_error"))
+
+		result, err := sut.GetPriceRecords(domain.MarginFilter{})
+
+		assert.Equal(t, domain.PriceRecords{}, result)
+		assert.EqualError(t, err, "query_error")
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should only filter by the informed params", func(t *testing.T) {
+		mock.ExpectQuery("WHERE 1=1 AND p.seller_id = \\? AND DATE\\(pr.last_update_date\\) <= \\? ORDER BY p.id, pr.last_update_date, pr.id").
+			WithArgs(1, "2022-07-31").
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		result, err := sut.GetPriceRecords(domain.MarginFilter{Seller_Id: 1, To: "2022-07-31"})
+
+		assert.Equal(t, domain.PriceRecords{}, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return the price records on success", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns)
+		rows.AddRow(1, "Cafe", 1, 2, "2022-07-01", "5.00", "10.50")
+		mock.ExpectQuery("WHERE 1=1 AND p.seller_id = \\? AND p.product_type_id = \\? AND DATE\\(pr.last_update_date\\) >= \\? AND DATE\\(pr.last_update_date\\) <= \\?").
+			WithArgs(1, 2, "2022-07-01", "2022-07-31").
+			WillReturnRows(rows)
+
+		result, err := sut.GetPriceRecords(domain.MarginFilter{Seller_Id: 1, Product_Type_Id: 2, From: "2022-07-01", To: "2022-07-31"})
+
+		expected := domain.PriceRecords{
+			{Product_Id: 1, Description: "Cafe", Seller_Id: 1, Product_Type_Id: 2, Last_Update_Date: "2022-07-01", Purchase_Price: money.FromCents(500), Sale_Price: money.FromCents(1050)},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+)
+
+type ProductivityController struct {
+	service usecases.ProductivityService
+}
+
+func NewProductivityController(s usecases.ProductivityService) *ProductivityController {
+	return &ProductivityController{
+		service: s,
+	}
+}
+
+func (c *ProductivityController) GetEmployeeProductivity() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		filter := domain.ProductivityFilter{
+			From: ctx.Query("from"),
+			To:   ctx.Query("to"),
+		}
+
+		var err error
+
+		if filter.Warehouse_Id, err = optionalId(ctx.Query("warehouse_id")); err != nil || filter.Warehouse_Id == 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "warehouse_id is required and must be greater than 0"})
+			return
+		}
+
+		for _, date := range []string{filter.From, filter.To} {
+			if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "dates must be in the format yyyy-mm-dd"})
+				return
+			}
+		}
+
+		report, err := c.service.GetEmployeeProductivity(filter)
+
+		if err != nil {
+			if errors.Is(err, usecases.ErrInvalidDateRange) {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			if errors.Is(err, usecases.ErrWarehouseNotFound) {
+				ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, report)
+	}
+}
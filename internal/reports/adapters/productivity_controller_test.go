@@ -0,0 +1,70 @@
+package adapters_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEmployeeProductivity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockProductivityService := mocks.NewProductivityService(t)
+	controller := adapters.NewProductivityController(mockProductivityService)
+
+	r := gin.Default()
+	r.GET("/reports/employeeProductivity", controller.GetEmployeeProductivity())
+
+	t.Run("Should return 400 status if query params are invalid", func(t *testing.T) {
+		for _, query := range []string{"", "warehouse_id=abc", "warehouse_id=0", "warehouse_id=1&from=01-07-2022", "warehouse_id=1&to=2022-13-01"} {
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/reports/employeeProductivity?"+query, nil)
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code, query)
+		}
+	})
+
+	t.Run("Should return 404 status if the warehouse does not exist", func(t *testing.T) {
+		mockProductivityService.On("GetEmployeeProductivity", domain.ProductivityFilter{Warehouse_Id: 9}).Return(domain.ProductivityReport{}, usecases.ErrWarehouseNotFound).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/employeeProductivity?warehouse_id=9", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.Equal(t, "{\"error\":\"warehouse not found\"}", res.Body.String())
+	})
+
+	t.Run("Should return an error and 500 status if GetEmployeeProductivity from Productivity Service returns an error", func(t *testing.T) {
+		mockProductivityService.On("GetEmployeeProductivity", domain.ProductivityFilter{Warehouse_Id: 1}).Return(domain.ProductivityReport{}, errors.New("any_error")).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/employeeProductivity?warehouse_id=1", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+
+	t.Run("Should return 200 status and the report on success", func(t *testing.T) {
+		filter := domain.ProductivityFilter{Warehouse_Id: 1, From: "2022-07-01", To: "2022-07-31"}
+		mockProductivityService.On("GetEmployeeProductivity", filter).Return(domain.ProductivityReport{
+			Warehouse_Id: 1,
+			Employees: []domain.EmployeeProductivity{
+				{Rank: 1, Employee_Id: 1, First_Name: "Ana", Last_Name: "Silva", Batches_Received: 2, Units_Received: 300, Lines_Picked: 1, Average_Handling_Minutes: 36.67},
+			},
+		}, nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/reports/employeeProductivity?warehouse_id=1&from=2022-07-01&to=2022-07-31", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "{\"warehouse_id\":1,\"employees\":[{\"rank\":1,\"employee_id\":1,\"first_name\":\"Ana\",\"last_name\":\"Silva\",\"batches_received\":2,\"units_received\":300,\"order_lines_picked\":1,\"average_handling_minutes\":36.67}]}", res.Body.String())
+	})
+}
@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"database/sql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+)
+
+type productivityMysqlRepository struct {
+	db *sql.DB
+}
+
+func NewProductivityMysqlRepository(db *sql.DB) usecases.ProductivityRepository {
+	return &productivityMysqlRepository{
+		db: db,
+	}
+}
+
+func (r *productivityMysqlRepository) WarehouseExists(warehouseId int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM warehouse WHERE id = ? AND deleted_at IS NULL`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, warehouseId).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *productivityMysqlRepository) GetInboundActivity(filter domain.ProductivityFilter) (domain.EmployeeActivities, error) {
+	query := `SELECT e.id, e.first_name, e.last_name, COUNT(io.id), COALESCE(SUM(pb.initial_quantity), 0), COUNT(io.received_at), COALESCE(SUM(TIMESTAMPDIFF(MINUTE, io.order_date, io.received_at)), 0) FROM inbound_order io INNER JOIN employee e ON e.id = io.employee_id INNER JOIN product_batch pb ON pb.id = io.product_batch_id WHERE io.warehouse_id = ?`
+
+	query, args := withDateRange(query, "io.order_date", filter)
+
+	rows, err := r.db.Query(query+` GROUP BY e.id, e.first_name, e.last_name`, args...)
+
+	if err != nil {
+		return domain.EmployeeActivities{}, err
+	}
+
+	defer rows.Close()
+
+	activities := domain.EmployeeActivities{}
+
+	for rows.Next() {
+		a := domain.EmployeeActivity{}
+
+		if err := rows.Scan(&a.Employee_Id, &a.First_Name, &a.Last_Name, &a.Batches_Received, &a.Units_Received, &a.Timed_Tasks, &a.Handling_Minutes); err != nil {
+			return domain.EmployeeActivities{}, err
+		}
+
+		activities = append(activities, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.EmployeeActivities{}, err
+	}
+
+	return activities, nil
+}
+
+func (r *productivityMysqlRepository) GetPickingActivity(filter domain.ProductivityFilter) (domain.EmployeeActivities, error) {
+	query := `SELECT e.id, e.first_name, e.last_name, COUNT(od.id), COALESCE(SUM(TIMESTAMPDIFF(MINUTE, po.order_date, od.picked_at)), 0) FROM order_details od INNER JOIN purchase_order po ON po.id = od.purchase_order_id INNER JOIN employee e ON e.id = od.picked_by WHERE po.warehouse_id = ? AND od.picked_at IS NOT NULL`
+
+	query, args := withDateRange(query, "od.picked_at", filter)
+
+	rows, err := r.db.Query(query+` GROUP BY e.id, e.first_name, e.last_name`, args...)
+
+	if err != nil {
+		return domain.EmployeeActivities{}, err
+	}
+
+	defer rows.Close()
+
+	activities := domain.EmployeeActivities{}
+
+	for rows.Next() {
+		a := domain.EmployeeActivity{}
+
+		if err := rows.Scan(&a.Employee_Id, &a.First_Name, &a.Last_Name, &a.Lines_Picked, &a.Handling_Minutes); err != nil {
+			return domain.EmployeeActivities{}, err
+		}
+
+		a.Timed_Tasks = a.Lines_Picked
+		activities = append(activities, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.EmployeeActivities{}, err
+	}
+
+	return activities, nil
+}
+
+func withDateRange(query string, column string, filter domain.ProductivityFilter) (string, []interface{}) {
+	args := []interface{}{filter.Warehouse_Id}
+
+	if filter.From != "" {
+		query += ` AND DATE(` + column + `) >= ?`
+		args = append(args, filter.From)
+	}
+
+	if filter.To != "" {
+		query += ` AND DATE(` + column + `) <= ?`
+		args = append(args, filter.To)
+	}
+
+	return query, args
+}
@@ -0,0 +1,140 @@
+package adapters_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarehouseExists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewProductivityMysqlRepository(db)
+
+	t.Run("Should return an error if query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM warehouse").WithArgs(1).WillReturnError(errors.New("query_error"))
+
+		_, err := sut.WarehouseExists(1)
+
+		assert.EqualError(t, err, "query_error")
+	})
+
+	t.Run("Should return true if the warehouse exists", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM warehouse").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		exists, err := sut.WarehouseExists(1)
+
+		assert.True(t, exists)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetInboundActivity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewProductivityMysqlRepository(db)
+
+	columns := []string{"id", "first_name", "last_name", "batches", "units", "timed", "minutes"}
+
+	t.Run("Should return an error if query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM inbound_order io").WillReturnError(errors.New("query_error"))
+
+		result, err := sut.GetInboundActivity(domain.ProductivityFilter{Warehouse_Id: 1})
+
+		assert.Equal(t, domain.EmployeeActivities{}, result)
+		assert.EqualError(t, err, "query_error")
+	})
+
+	t.Run("Should return the inbound activity on success", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(1, "Ana", "Silva", 2, 300, 2, 90)
+		mock.ExpectQuery("WHERE io.warehouse_id = \\? AND DATE\\(io.order_date\\) >= \\? AND DATE\\(io.order_date\\) <= \\? GROUP BY e.id").
+			WithArgs(1, "2022-07-01", "2022-07-31").
+			WillReturnRows(rows)
+
+		result, err := sut.GetInboundActivity(domain.ProductivityFilter{Warehouse_Id: 1, From: "2022-07-01", To: "2022-07-31"})
+
+		expected := domain.EmployeeActivities{
+			{Employee_Id: 1, First_Name: "Ana", Last_Name: "Silva", Batches_Received: 2, Units_Received: 300, Timed_Tasks: 2, Handling_Minutes: 90},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetPickingActivity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewProductivityMysqlRepository(db)
+
+	t.Run("Should only filter by the informed dates", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "lines", "minutes"}).AddRow(3, "Carla", "Lima", 4, 60)
+		mock.ExpectQuery("WHERE po.warehouse_id = \\? AND od.picked_at IS NOT NULL AND DATE\\(od.picked_at\\) <= \\? GROUP BY e.id").
+			WithArgs(1, "2022-07-31").
+			WillReturnRows(rows)
+
+		result, err := sut.GetPickingActivity(domain.ProductivityFilter{Warehouse_Id: 1, To: "2022-07-31"})
+
+		expected := domain.EmployeeActivities{
+			{Employee_Id: 3, First_Name: "Carla", Last_Name: "Lima", Lines_Picked: 4, Timed_Tasks: 4, Handling_Minutes: 60},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+// The rows below are what the queries return for the seed in db/dump.sql: the
+// inbound order received by employee 1 and the order line picked by employee 2.
+func TestEmployeeProductivityOfSeededData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := usecases.NewProductivityService(adapters.NewProductivityMysqlRepository(db))
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM warehouse").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("FROM inbound_order io INNER JOIN employee e ON e.id = io.employee_id").
+		WithArgs(1, "2022-07-01", "2022-07-31").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "batches", "units", "timed", "minutes"}).AddRow(1, "Marcos", "Mantovani", 1, 100, 1, 90))
+	mock.ExpectQuery("FROM order_details od (.+) INNER JOIN employee e ON e.id = od.picked_by").
+		WithArgs(1, "2022-07-01", "2022-07-31").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name", "lines", "minutes"}).AddRow(2, "Ana", "Silva", 1, 45))
+
+	report, err := sut.GetEmployeeProductivity(domain.ProductivityFilter{Warehouse_Id: 1, From: "2022-07-01", To: "2022-07-31"})
+
+	expected := domain.ProductivityReport{
+		Warehouse_Id: 1,
+		Employees: []domain.EmployeeProductivity{
+			{Rank: 1, Employee_Id: 1, First_Name: "Marcos", Last_Name: "Mantovani", Batches_Received: 1, Units_Received: 100, Average_Handling_Minutes: 90},
+			{Rank: 2, Employee_Id: 2, First_Name: "Ana", Last_Name: "Silva", Lines_Picked: 1, Average_Handling_Minutes: 45},
+		},
+	}
+	assert.Equal(t, expected, report)
+	assert.Nil(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.Nil(t, err)
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
+type MarginFilter struct {
+	Seller_Id       int
+	Product_Type_Id int
+	From            string
+	To              string
+}
+
+type PriceRecord struct {
+	Product_Id       int
+	Description      string
+	Seller_Id        int
+	Product_Type_Id  int
+	Last_Update_Date string
+	Purchase_Price   money.Money
+	Sale_Price       money.Money
+}
+
+type PriceRecords []PriceRecord
+
+type PriceChange struct {
+	Date                string      `json:"date"`
+	Previous_Sale_Price money.Money `json:"previous_sale_price"`
+	Sale_Price          money.Money `json:"sale_price"`
+	Change_Percent      float64     `json:"change_percent"`
+}
+
+type ProductMargin struct {
+	Product_Id            int          `json:"product_id"`
+	Description           string       `json:"description"`
+	Seller_Id             int          `json:"seller_id"`
+	Product_Type_Id       int          `json:"product_type_id"`
+	Records_Count         int          `json:"records_count"`
+	Average_Margin        float64      `json:"average_margin"`
+	Average_Margin_Amount money.Money  `json:"average_margin_amount"`
+	Price_Volatility      float64      `json:"price_volatility"`
+	Latest_Price_Change   *PriceChange `json:"latest_price_change"`
+}
+
+type SellerMargin struct {
+	Seller_Id             int         `json:"seller_id"`
+	Products_Count        int         `json:"products_count"`
+	Records_Count         int         `json:"records_count"`
+	Average_Margin        float64     `json:"average_margin"`
+	Average_Margin_Amount money.Money `json:"average_margin_amount"`
+}
+
+type MarginReport struct {
+	Products []ProductMargin `json:"products"`
+	Sellers  []SellerMargin  `json:"sellers"`
+}
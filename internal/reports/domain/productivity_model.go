@@ -0,0 +1,38 @@
+package domain
+
+type ProductivityFilter struct {
+	Warehouse_Id int
+	From         string
+	To           string
+}
+
+// EmployeeActivity holds the raw work totals of an employee; handling minutes
+// are summed over the Timed_Tasks that have both a start and an end time.
+type EmployeeActivity struct {
+	Employee_Id      int
+	First_Name       string
+	Last_Name        string
+	Batches_Received int
+	Units_Received   int
+	Lines_Picked     int
+	Timed_Tasks      int
+	Handling_Minutes float64
+}
+
+type EmployeeActivities []EmployeeActivity
+
+type EmployeeProductivity struct {
+	Rank                     int     `json:"rank"`
+	Employee_Id              int     `json:"employee_id"`
+	First_Name               string  `json:"first_name"`
+	Last_Name                string  `json:"last_name"`
+	Batches_Received         int     `json:"batches_received"`
+	Units_Received           int     `json:"units_received"`
+	Lines_Picked             int     `json:"order_lines_picked"`
+	Average_Handling_Minutes float64 `json:"average_handling_minutes"`
+}
+
+type ProductivityReport struct {
+	Warehouse_Id int                    `json:"warehouse_id"`
+	Employees    []EmployeeProductivity `json:"employees"`
+}
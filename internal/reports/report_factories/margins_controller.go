@@ -0,0 +1,15 @@
+package report_factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+)
+
+func MakeMarginsController() *adapters.MarginsController {
+	mr := adapters.NewMarginsMysqlRepository(db.GetInstance())
+	ms := usecases.NewMarginsService(mr)
+	mc := adapters.NewMarginsController(ms)
+
+	return mc
+}
@@ -0,0 +1,15 @@
+package report_factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+)
+
+func MakeProductivityController() *adapters.ProductivityController {
+	pr := adapters.NewProductivityMysqlRepository(db.GetInstance())
+	ps := usecases.NewProductivityService(pr)
+	pc := adapters.NewProductivityController(ps)
+
+	return pc
+}
@@ -0,0 +1,7 @@
+package usecases
+
+import "errors"
+
+var ErrInvalidDateRange = errors.New("from date must be before or equal to to date")
+
+var ErrWarehouseNotFound = errors.New("warehouse not found")
@@ -0,0 +1,7 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+
+type MarginsRepository interface {
+	GetPriceRecords(filter domain.MarginFilter) (domain.PriceRecords, error)
+}
@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"math"
+	"sort"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+)
+
+type MarginsService interface {
+	GetMargins(filter domain.MarginFilter) (domain.MarginReport, error)
+}
+
+type marginsService struct {
+	repository MarginsRepository
+}
+
+func NewMarginsService(r MarginsRepository) MarginsService {
+	return &marginsService{
+		repository: r,
+	}
+}
+
+type marginTotals struct {
+	records        int
+	amount         money.Money
+	percentSum     float64
+	percentRecords int
+}
+
+func (t *marginTotals) add(r domain.PriceRecord) {
+	margin := r.Sale_Price.Sub(r.Purchase_Price)
+
+	t.records++
+	t.amount = t.amount.Add(margin)
+
+	if r.Sale_Price.Cmp(money.Money{}) > 0 {
+		t.percentSum += margin.Float64() / r.Sale_Price.Float64() * 100
+		t.percentRecords++
+	}
+}
+
+func (t *marginTotals) averagePercent() float64 {
+	if t.percentRecords == 0 {
+		return 0
+	}
+
+	return round(t.percentSum / float64(t.percentRecords))
+}
+
+func (s *marginsService) GetMargins(filter domain.MarginFilter) (domain.MarginReport, error) {
+	if filter.From != "" && filter.To != "" && filter.From > filter.To {
+		return domain.MarginReport{}, ErrInvalidDateRange
+	}
+
+	records, err := s.repository.GetPriceRecords(filter)
+
+	if err != nil {
+		return domain.MarginReport{}, err
+	}
+
+	report := domain.MarginReport{
+		Products: []domain.ProductMargin{},
+		Sellers:  []domain.SellerMargin{},
+	}
+
+	sellers := map[int]*marginTotals{}
+	sellerProducts := map[int]int{}
+
+	// records come ordered by product and date, so each product is a run
+	for start := 0; start < len(records); {
+		end := start
+
+		for end < len(records) && records[end].Product_Id == records[start].Product_Id {
+			end++
+		}
+
+		productRecords := records[start:end]
+		first := productRecords[0]
+
+		if sellers[first.Seller_Id] == nil {
+			sellers[first.Seller_Id] = &marginTotals{}
+		}
+
+		totals := marginTotals{}
+
+		for _, r := range productRecords {
+			totals.add(r)
+			sellers[first.Seller_Id].add(r)
+		}
+
+		sellerProducts[first.Seller_Id]++
+
+		report.Products = append(report.Products, domain.ProductMargin{
+			Product_Id:            first.Product_Id,
+			Description:           first.Description,
+			Seller_Id:             first.Seller_Id,
+			Product_Type_Id:       first.Product_Type_Id,
+			Records_Count:         totals.records,
+			Average_Margin:        totals.averagePercent(),
+			Average_Margin_Amount: totals.amount.Div(int64(totals.records)),
+			Price_Volatility:      priceVolatility(productRecords),
+			Latest_Price_Change:   latestPriceChange(productRecords),
+		})
+
+		start = end
+	}
+
+	for seller_id, totals := range sellers {
+		report.Sellers = append(report.Sellers, domain.SellerMargin{
+			Seller_Id:             seller_id,
+			Products_Count:        sellerProducts[seller_id],
+			Records_Count:         totals.records,
+			Average_Margin:        totals.averagePercent(),
+			Average_Margin_Amount: totals.amount.Div(int64(totals.records)),
+		})
+	}
+
+	sort.Slice(report.Sellers, func(i, j int) bool {
+		return report.Sellers[i].Seller_Id < report.Sellers[j].Seller_Id
+	})
+
+	return report, nil
+}
+
+// priceVolatility is the coefficient of variation of the sale price, in percent.
+func priceVolatility(records domain.PriceRecords) float64 {
+	if len(records) < 2 {
+		return 0
+	}
+
+	sum := 0.0
+
+	for _, r := range records {
+		sum += r.Sale_Price.Float64()
+	}
+
+	mean := sum / float64(len(records))
+
+	if mean == 0 {
+		return 0
+	}
+
+	variance := 0.0
+
+	for _, r := range records {
+		variance += math.Pow(r.Sale_Price.Float64()-mean, 2)
+	}
+
+	variance /= float64(len(records))
+
+	return round(math.Sqrt(variance) / mean * 100)
+}
+
+func latestPriceChange(records domain.PriceRecords) *domain.PriceChange {
+	for i := len(records) - 1; i > 0; i-- {
+		previous, current := records[i-1].Sale_Price, records[i].Sale_Price
+
+		if previous.Cmp(current) == 0 {
+			continue
+		}
+
+		change := domain.PriceChange{
+			Date:                records[i].Last_Update_Date,
+			Previous_Sale_Price: previous,
+			Sale_Price:          current,
+		}
+
+		if !previous.IsZero() {
+			change.Change_Percent = round(current.Sub(previous).Float64() / previous.Float64() * 100)
+		}
+
+		return &change
+	}
+
+	return nil
+}
+
+func round(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,90 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func makePriceRecords() domain.PriceRecords {
+	return domain.PriceRecords{
+		{Product_Id: 1, Description: "Cafe", Seller_Id: 1, Product_Type_Id: 1, Last_Update_Date: "2022-07-01", Purchase_Price: money.FromCents(500), Sale_Price: money.FromCents(1000)},
+		{Product_Id: 1, Description: "Cafe", Seller_Id: 1, Product_Type_Id: 1, Last_Update_Date: "2022-07-10", Purchase_Price: money.FromCents(600), Sale_Price: money.FromCents(1200)},
+		{Product_Id: 1, Description: "Cafe", Seller_Id: 1, Product_Type_Id: 1, Last_Update_Date: "2022-07-20", Purchase_Price: money.FromCents(600), Sale_Price: money.FromCents(1200)},
+		{Product_Id: 2, Description: "Leite", Seller_Id: 2, Product_Type_Id: 1, Last_Update_Date: "2022-07-05", Purchase_Price: money.FromCents(300), Sale_Price: money.FromCents(400)},
+		{Product_Id: 3, Description: "Pao", Seller_Id: 1, Product_Type_Id: 2, Last_Update_Date: "2022-07-05", Purchase_Price: money.FromCents(100), Sale_Price: money.FromCents(200)},
+	}
+}
+
+func TestGetMargins(t *testing.T) {
+	t.Run("Should return ErrInvalidDateRange if from is after to", func(t *testing.T) {
+		service := usecases.NewMarginsService(mocks.NewMarginsRepository(t))
+
+		_, err := service.GetMargins(domain.MarginFilter{From: "2022-08-01", To: "2022-07-01"})
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidDateRange)
+	})
+
+	t.Run("Should return an error if GetPriceRecords from Margins Repository returns an error", func(t *testing.T) {
+		mockRepository := mocks.NewMarginsRepository(t)
+		service := usecases.NewMarginsService(mockRepository)
+		mockRepository.On("GetPriceRecords", domain.MarginFilter{}).Return(domain.PriceRecords{}, errors.New("any_error")).Once()
+
+		_, err := service.GetMargins(domain.MarginFilter{})
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should return empty lists if there are no records", func(t *testing.T) {
+		mockRepository := mocks.NewMarginsRepository(t)
+		service := usecases.NewMarginsService(mockRepository)
+		mockRepository.On("GetPriceRecords", domain.MarginFilter{Seller_Id: 1}).Return(domain.PriceRecords{}, nil).Once()
+
+		report, err := service.GetMargins(domain.MarginFilter{Seller_Id: 1})
+
+		assert.Equal(t, domain.MarginReport{Products: []domain.ProductMargin{}, Sellers: []domain.SellerMargin{}}, report)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should compute margins per product and per seller on success", func(t *testing.T) {
+		mockRepository := mocks.NewMarginsRepository(t)
+		service := usecases.NewMarginsService(mockRepository)
+		mockRepository.On("GetPriceRecords", domain.MarginFilter{}).Return(makePriceRecords(), nil).Once()
+
+		report, err := service.GetMargins(domain.MarginFilter{})
+
+		expected := domain.MarginReport{
+			Products: []domain.ProductMargin{
+				{
+					Product_Id:            1,
+					Description:           "Cafe",
+					Seller_Id:             1,
+					Product_Type_Id:       1,
+					Records_Count:         3,
+					Average_Margin:        50,
+					Average_Margin_Amount: money.FromCents(567),
+					Price_Volatility:      8.32,
+					Latest_Price_Change: &domain.PriceChange{
+						Date:                "2022-07-10",
+						Previous_Sale_Price: money.FromCents(1000),
+						Sale_Price:          money.FromCents(1200),
+						Change_Percent:      20,
+					},
+				},
+				{Product_Id: 2, Description: "Leite", Seller_Id: 2, Product_Type_Id: 1, Records_Count: 1, Average_Margin: 25, Average_Margin_Amount: money.FromCents(100)},
+				{Product_Id: 3, Description: "Pao", Seller_Id: 1, Product_Type_Id: 2, Records_Count: 1, Average_Margin: 50, Average_Margin_Amount: money.FromCents(100)},
+			},
+			Sellers: []domain.SellerMargin{
+				{Seller_Id: 1, Products_Count: 2, Records_Count: 4, Average_Margin: 50, Average_Margin_Amount: money.FromCents(450)},
+				{Seller_Id: 2, Products_Count: 1, Records_Count: 1, Average_Margin: 25, Average_Margin_Amount: money.FromCents(100)},
+			},
+		}
+		assert.Equal(t, expected, report)
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,9 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+
+type ProductivityRepository interface {
+	WarehouseExists(warehouseId int) (bool, error)
+	GetInboundActivity(filter domain.ProductivityFilter) (domain.EmployeeActivities, error)
+	GetPickingActivity(filter domain.ProductivityFilter) (domain.EmployeeActivities, error)
+}
@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"sort"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+)
+
+type ProductivityService interface {
+	GetEmployeeProductivity(filter domain.ProductivityFilter) (domain.ProductivityReport, error)
+}
+
+type productivityService struct {
+	repository ProductivityRepository
+}
+
+func NewProductivityService(r ProductivityRepository) ProductivityService {
+	return &productivityService{
+		repository: r,
+	}
+}
+
+func (s *productivityService) GetEmployeeProductivity(filter domain.ProductivityFilter) (domain.ProductivityReport, error) {
+	if filter.From != "" && filter.To != "" && filter.From > filter.To {
+		return domain.ProductivityReport{}, ErrInvalidDateRange
+	}
+
+	exists, err := s.repository.WarehouseExists(filter.Warehouse_Id)
+
+	if err != nil {
+		return domain.ProductivityReport{}, err
+	}
+
+	if !exists {
+		return domain.ProductivityReport{}, ErrWarehouseNotFound
+	}
+
+	inbound, err := s.repository.GetInboundActivity(filter)
+
+	if err != nil {
+		return domain.ProductivityReport{}, err
+	}
+
+	picking, err := s.repository.GetPickingActivity(filter)
+
+	if err != nil {
+		return domain.ProductivityReport{}, err
+	}
+
+	employees := map[int]*domain.EmployeeActivity{}
+
+	for _, a := range append(inbound, picking...) {
+		e := employees[a.Employee_Id]
+
+		if e == nil {
+			e = &domain.EmployeeActivity{Employee_Id: a.Employee_Id, First_Name: a.First_Name, Last_Name: a.Last_Name}
+			employees[a.Employee_Id] = e
+		}
+
+		e.Batches_Received += a.Batches_Received
+		e.Units_Received += a.Units_Received
+		e.Lines_Picked += a.Lines_Picked
+		e.Timed_Tasks += a.Timed_Tasks
+		e.Handling_Minutes += a.Handling_Minutes
+	}
+
+	report := domain.ProductivityReport{
+		Warehouse_Id: filter.Warehouse_Id,
+		Employees:    []domain.EmployeeProductivity{},
+	}
+
+	for _, e := range employees {
+		p := domain.EmployeeProductivity{
+			Employee_Id:      e.Employee_Id,
+			First_Name:       e.First_Name,
+			Last_Name:        e.Last_Name,
+			Batches_Received: e.Batches_Received,
+			Units_Received:   e.Units_Received,
+			Lines_Picked:     e.Lines_Picked,
+		}
+
+		if e.Timed_Tasks > 0 {
+			p.Average_Handling_Minutes = round(e.Handling_Minutes / float64(e.Timed_Tasks))
+		}
+
+		report.Employees = append(report.Employees, p)
+	}
+
+	sort.Slice(report.Employees, func(i, j int) bool {
+		a, b := report.Employees[i], report.Employees[j]
+
+		if tasks(a) != tasks(b) {
+			return tasks(a) > tasks(b)
+		}
+
+		if a.Units_Received != b.Units_Received {
+			return a.Units_Received > b.Units_Received
+		}
+
+		return a.Employee_Id < b.Employee_Id
+	})
+
+	// employees with the same amount of work share the rank
+	for i := range report.Employees {
+		report.Employees[i].Rank = i + 1
+
+		if i > 0 && tasks(report.Employees[i]) == tasks(report.Employees[i-1]) && report.Employees[i].Units_Received == report.Employees[i-1].Units_Received {
+			report.Employees[i].Rank = report.Employees[i-1].Rank
+		}
+	}
+
+	return report, nil
+}
+
+// tasks counts the received batches and picked lines of an employee.
+func tasks(p domain.EmployeeProductivity) int {
+	return p.Batches_Received + p.Lines_Picked
+}
@@ -0,0 +1,103 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/reports/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEmployeeProductivity(t *testing.T) {
+	filter := domain.ProductivityFilter{Warehouse_Id: 1, From: "2022-07-01", To: "2022-07-31"}
+
+	t.Run("Should return ErrInvalidDateRange if from is after to", func(t *testing.T) {
+		service := usecases.NewProductivityService(mocks.NewProductivityRepository(t))
+
+		_, err := service.GetEmployeeProductivity(domain.ProductivityFilter{Warehouse_Id: 1, From: "2022-08-01", To: "2022-07-01"})
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidDateRange)
+	})
+
+	t.Run("Should return ErrWarehouseNotFound if the warehouse does not exist", func(t *testing.T) {
+		mockRepository := mocks.NewProductivityRepository(t)
+		service := usecases.NewProductivityService(mockRepository)
+		mockRepository.On("WarehouseExists", 1).Return(false, nil).Once()
+
+		_, err := service.GetEmployeeProductivity(filter)
+
+		assert.ErrorIs(t, err, usecases.ErrWarehouseNotFound)
+	})
+
+	t.Run("Should return an error if GetPickingActivity from Productivity Repository returns an error", func(t *testing.T) {
+		mockRepository := mocks.NewProductivityRepository(t)
+		service := usecases.NewProductivityService(mockRepository)
+		mockRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockRepository.On("GetInboundActivity", filter).Return(domain.EmployeeActivities{}, nil).Once()
+		mockRepository.On("GetPickingActivity", filter).Return(domain.EmployeeActivities{}, errors.New("any_error")).Once()
+
+		_, err := service.GetEmployeeProductivity(filter)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should return an empty list if there is no activity", func(t *testing.T) {
+		mockRepository := mocks.NewProductivityRepository(t)
+		service := usecases.NewProductivityService(mockRepository)
+		mockRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockRepository.On("GetInboundActivity", filter).Return(domain.EmployeeActivities{}, nil).Once()
+		mockRepository.On("GetPickingActivity", filter).Return(domain.EmployeeActivities{}, nil).Once()
+
+		report, err := service.GetEmployeeProductivity(filter)
+
+		assert.Equal(t, domain.ProductivityReport{Warehouse_Id: 1, Employees: []domain.EmployeeProductivity{}}, report)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should combine inbound and picking work and rank the employees on success", func(t *testing.T) {
+		mockRepository := mocks.NewProductivityRepository(t)
+		service := usecases.NewProductivityService(mockRepository)
+		mockRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockRepository.On("GetInboundActivity", filter).Return(domain.EmployeeActivities{
+			{Employee_Id: 1, First_Name: "Ana", Last_Name: "Silva", Batches_Received: 2, Units_Received: 300, Timed_Tasks: 2, Handling_Minutes: 90},
+			{Employee_Id: 2, First_Name: "Bruno", Last_Name: "Souza", Batches_Received: 1, Units_Received: 50},
+		}, nil).Once()
+		mockRepository.On("GetPickingActivity", filter).Return(domain.EmployeeActivities{
+			{Employee_Id: 1, First_Name: "Ana", Last_Name: "Silva", Lines_Picked: 1, Timed_Tasks: 1, Handling_Minutes: 20},
+			{Employee_Id: 3, First_Name: "Carla", Last_Name: "Lima", Lines_Picked: 1, Timed_Tasks: 1, Handling_Minutes: 15},
+		}, nil).Once()
+
+		report, err := service.GetEmployeeProductivity(filter)
+
+		expected := domain.ProductivityReport{
+			Warehouse_Id: 1,
+			Employees: []domain.EmployeeProductivity{
+				{Rank: 1, Employee_Id: 1, First_Name: "Ana", Last_Name: "Silva", Batches_Received: 2, Units_Received: 300, Lines_Picked: 1, Average_Handling_Minutes: 36.67},
+				{Rank: 2, Employee_Id: 2, First_Name: "Bruno", Last_Name: "Souza", Batches_Received: 1, Units_Received: 50},
+				{Rank: 3, Employee_Id: 3, First_Name: "Carla", Last_Name: "Lima", Lines_Picked: 1, Average_Handling_Minutes: 15},
+			},
+		}
+		assert.Equal(t, expected, report)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should give the same rank to employees with the same work", func(t *testing.T) {
+		mockRepository := mocks.NewProductivityRepository(t)
+		service := usecases.NewProductivityService(mockRepository)
+		mockRepository.On("WarehouseExists", 1).Return(true, nil).Once()
+		mockRepository.On("GetInboundActivity", filter).Return(domain.EmployeeActivities{}, nil).Once()
+		mockRepository.On("GetPickingActivity", filter).Return(domain.EmployeeActivities{
+			{Employee_Id: 2, First_Name: "Bruno", Last_Name: "Souza", Lines_Picked: 3},
+			{Employee_Id: 1, First_Name: "Ana", Last_Name: "Silva", Lines_Picked: 3},
+			{Employee_Id: 3, First_Name: "Carla", Last_Name: "Lima", Lines_Picked: 1},
+		}, nil).Once()
+
+		report, err := service.GetEmployeeProductivity(filter)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 1, 3}, []int{report.Employees[0].Rank, report.Employees[1].Rank, report.Employees[2].Rank})
+		assert.Equal(t, []int{1, 2, 3}, []int{report.Employees[0].Employee_Id, report.Employees[1].Employee_Id, report.Employees[2].Employee_Id})
+	})
+}
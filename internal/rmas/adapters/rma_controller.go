@@ -0,0 +1,139 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases"
+)
+
+type RmaController struct {
+	service usecases.RmaService
+}
+
+func NewRmaController(s usecases.RmaService) *RmaController {
+	return &RmaController{
+		service: s,
+	}
+}
+
+type lineRequest struct {
+	Order_Details_Id int `json:"order_details_id" binding:"required"`
+	Quantity         int `json:"quantity" binding:"required,gt=0"`
+}
+
+type createRequest struct {
+	Purchase_Order_Id int           `json:"purchase_order_id" binding:"required"`
+	Reason            string        `json:"reason" binding:"required"`
+	Notes             string        `json:"notes"`
+	Lines             []lineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+type updateStatusRequest struct {
+	Status      string `json:"status" binding:"required"`
+	Disposition string `json:"disposition"`
+	Section_Id  int    `json:"section_id"`
+}
+
+func (c *RmaController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req createRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid input. Check the data entered"})
+			return
+		}
+
+		lines := domain.RmaLines{}
+
+		for _, l := range req.Lines {
+			lines = append(lines, domain.RmaLine{Order_Details_Id: l.Order_Details_Id, Quantity: l.Quantity})
+		}
+
+		rma, err := c.service.Create(req.Purchase_Order_Id, req.Reason, req.Notes, lines)
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, rma)
+	}
+}
+
+func (c *RmaController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		rmas, err := c.service.GetAll(ctx.Query("status"))
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, rmas)
+	}
+}
+
+func (c *RmaController) GetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		rma, err := c.service.GetById(id)
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, rma)
+	}
+}
+
+func (c *RmaController) UpdateStatus() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, err := strconv.Atoi(ctx.Param("id"))
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		var req updateStatusRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid input. Check the data entered"})
+			return
+		}
+
+		rma, err := c.service.UpdateStatus(id, req.Status, req.Disposition, req.Section_Id)
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, rma)
+	}
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrNoElementFound), errors.Is(err, usecases.ErrOrderNotFound), errors.Is(err, usecases.ErrSectionNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrInvalidStatusTransition), errors.Is(err, usecases.ErrStatusChanged):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrInvalidReason), errors.Is(err, usecases.ErrInvalidStatus), errors.Is(err, usecases.ErrInvalidDisposition), errors.Is(err, usecases.ErrOrderNotShipped),
+		errors.Is(err, usecases.ErrLineNotInOrder), errors.Is(err, usecases.ErrQuantityExceeded), errors.Is(err, usecases.ErrSectionNotInWarehouse), errors.Is(err, usecases.ErrSectionNotQuarantine):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
@@ -0,0 +1,175 @@
+package adapters_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeRma() domain.Rma {
+	return domain.Rma{
+		Id:                1,
+		Purchase_Order_Id: 1,
+		Reason:            domain.ReasonDamaged,
+		Status:            domain.StatusRequested,
+		Created_At:        "2022-07-01 10:00:00",
+		Lines:             domain.RmaLines{{Id: 1, Order_Details_Id: 10, Product_Id: 5, Quantity: 2}},
+	}
+}
+
+func TestCreateRma(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockRmaService := mocks.NewRmaService(t)
+	controller := adapters.NewRmaController(mockRmaService)
+
+	r := gin.Default()
+	r.POST("/rmas", controller.Create())
+
+	t.Run("Should return 400 status if the body is invalid", func(t *testing.T) {
+		for _, body := range []string{
+			`{"reason": "damaged", "lines": [{"order_details_id": 10, "quantity": 2}]}`,
+			`{"purchase_order_id": 1, "reason": "damaged", "lines": []}`,
+			`{"purchase_order_id": 1, "reason": "damaged", "lines": [{"order_details_id": 10, "quantity": 0}]}`,
+		} {
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/rmas", bytes.NewBufferString(body))
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code, body)
+		}
+	})
+
+	t.Run("Should return 422 status if Create from Rma Service returns ErrQuantityExceeded", func(t *testing.T) {
+		mockRmaService.On("Create", 1, "damaged", "", domain.RmaLines{{Order_Details_Id: 10, Quantity: 20}}).Return(domain.Rma{}, usecases.ErrQuantityExceeded).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/rmas", bytes.NewBufferString(`{"purchase_order_id": 1, "reason": "damaged", "lines": [{"order_details_id": 10, "quantity": 20}]}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+		assert.Equal(t, "{\"error\":\"returned quantity can't exceed the quantity ordered\"}", res.Body.String())
+	})
+
+	t.Run("Should return 404 status if Create from Rma Service returns ErrOrderNotFound", func(t *testing.T) {
+		mockRmaService.On("Create", 9, "damaged", "", domain.RmaLines{{Order_Details_Id: 10, Quantity: 2}}).Return(domain.Rma{}, usecases.ErrOrderNotFound).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/rmas", bytes.NewBufferString(`{"purchase_order_id": 9, "reason": "damaged", "lines": [{"order_details_id": 10, "quantity": 2}]}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("Should return 201 status and the rma on success", func(t *testing.T) {
+		mockRmaService.On("Create", 1, "damaged", "", domain.RmaLines{{Order_Details_Id: 10, Quantity: 2}}).Return(makeRma(), nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/rmas", bytes.NewBufferString(`{"purchase_order_id": 1, "reason": "damaged", "lines": [{"order_details_id": 10, "quantity": 2}]}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, "{\"id\":1,\"purchase_order_id\":1,\"reason\":\"damaged\",\"notes\":\"\",\"status\":\"requested\",\"disposition\":null,\"section_id\":null,\"created_at\":\"2022-07-01 10:00:00\",\"inspected_at\":null,\"closed_at\":null,\"lines\":[{\"id\":1,\"order_details_id\":10,\"product_id\":5,\"quantity\":2}]}", res.Body.String())
+	})
+}
+
+func TestGetRmas(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockRmaService := mocks.NewRmaService(t)
+	controller := adapters.NewRmaController(mockRmaService)
+
+	r := gin.Default()
+	r.GET("/rmas", controller.GetAll())
+	r.GET("/rmas/:id", controller.GetById())
+
+	t.Run("Should filter by status", func(t *testing.T) {
+		mockRmaService.On("GetAll", "inspecting").Return(domain.Rmas{}, nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/rmas?status=inspecting", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "[]", res.Body.String())
+	})
+
+	t.Run("Should return 400 status if the id is invalid", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/rmas/abc", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Should return 404 status if the rma does not exist", func(t *testing.T) {
+		mockRmaService.On("GetById", 1).Return(domain.Rma{}, usecases.ErrNoElementFound).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/rmas/1", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("Should return 500 status if GetById from Rma Service returns an error", func(t *testing.T) {
+		mockRmaService.On("GetById", 1).Return(domain.Rma{}, errors.New("any_error")).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/rmas/1", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+}
+
+func TestUpdateRmaStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockRmaService := mocks.NewRmaService(t)
+	controller := adapters.NewRmaController(mockRmaService)
+
+	r := gin.Default()
+	r.PATCH("/rmas/:id/status", controller.UpdateStatus())
+
+	t.Run("Should return 409 status if UpdateStatus from Rma Service returns ErrInvalidStatusTransition", func(t *testing.T) {
+		mockRmaService.On("UpdateStatus", 1, "accepted", "write_off", 0).Return(domain.Rma{}, usecases.ErrInvalidStatusTransition).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/rmas/1/status", bytes.NewBufferString(`{"status": "accepted", "disposition": "write_off"}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("Should return 409 status if UpdateStatus from Rma Service returns ErrStatusChanged", func(t *testing.T) {
+		mockRmaService.On("UpdateStatus", 1, "rejected", "", 0).Return(domain.Rma{}, usecases.ErrStatusChanged).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/rmas/1/status", bytes.NewBufferString(`{"status": "rejected"}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("Should return 422 status if UpdateStatus from Rma Service returns ErrInvalidDisposition", func(t *testing.T) {
+		mockRmaService.On("UpdateStatus", 1, "accepted", "restock", 0).Return(domain.Rma{}, usecases.ErrInvalidDisposition).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/rmas/1/status", bytes.NewBufferString(`{"status": "accepted", "disposition": "restock"}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
+
+	t.Run("Should return 200 status and the rma on success", func(t *testing.T) {
+		rma := makeRma()
+		rma.Status = domain.StatusInspecting
+		mockRmaService.On("UpdateStatus", 1, "inspecting", "", 0).Return(rma, nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/rmas/1/status", bytes.NewBufferString(`{"status": "inspecting"}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+}
@@ -0,0 +1,341 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases"
+)
+
+type rmaMysqlRepository struct {
+	db *sql.DB
+}
+
+func NewRmaMysqlRepository(db *sql.DB) usecases.RmaRepository {
+	return &rmaMysqlRepository{
+		db: db,
+	}
+}
+
+func (r *rmaMysqlRepository) GetOrder(purchase_order_id int) (domain.Order, error) {
+	order := domain.Order{}
+
+	err := r.db.QueryRow(`SELECT id, warehouse_id, order_status_id FROM purchase_order WHERE id=?`, purchase_order_id).Scan(&order.Id, &order.Warehouse_Id, &order.Order_Status_Id)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Order{}, usecases.ErrOrderNotFound
+	}
+
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	// quantities already on rejected rmas can be returned again
+	const query = `SELECT od.id, pr.product_id, od.quantity, COALESCE(SUM(CASE WHEN rma.status <> 'rejected' THEN rl.quantity END), 0) FROM order_details od
+	INNER JOIN product_record pr ON pr.id = od.product_record_id
+	LEFT JOIN rma_line rl ON rl.order_details_id = od.id
+	LEFT JOIN rma ON rma.id = rl.rma_id
+	WHERE od.purchase_order_id = ?
+	GROUP BY od.id, pr.product_id, od.quantity
+	ORDER BY od.id`
+
+	rows, err := r.db.Query(query, purchase_order_id)
+
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	defer rows.Close()
+
+	order.Lines = []domain.OrderLine{}
+
+	for rows.Next() {
+		l := domain.OrderLine{}
+
+		if err := rows.Scan(&l.Order_Details_Id, &l.Product_Id, &l.Quantity, &l.Returned_Quantity); err != nil {
+			return domain.Order{}, err
+		}
+
+		order.Lines = append(order.Lines, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Order{}, err
+	}
+
+	return order, nil
+}
+
+func (r *rmaMysqlRepository) GetSection(section_id int) (domain.Section, error) {
+	section := domain.Section{}
+
+	err := r.db.QueryRow(`SELECT id, warehouse_id, quarantine FROM section WHERE id=?`, section_id).Scan(&section.Id, &section.Warehouse_Id, &section.Quarantine)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Section{}, usecases.ErrSectionNotFound
+	}
+
+	if err != nil {
+		return domain.Section{}, err
+	}
+
+	return section, nil
+}
+
+func (r *rmaMysqlRepository) Create(rma domain.Rma) (domain.Rma, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return domain.Rma{}, err
+	}
+
+	const query = `INSERT INTO rma (purchase_order_id, reason, notes, status, created_at) VALUES (?, ?, ?, ?, ?)`
+
+	res, err := tx.Exec(query, rma.Purchase_Order_Id, rma.Reason, rma.Notes, rma.Status, rma.Created_At)
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Rma{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Rma{}, err
+	}
+
+	rma.Id = int(id)
+
+	const lineQuery = `INSERT INTO rma_line (rma_id, order_details_id, product_id, quantity) VALUES (?, ?, ?, ?)`
+
+	for i, l := range rma.Lines {
+		res, err := tx.Exec(lineQuery, rma.Id, l.Order_Details_Id, l.Product_Id, l.Quantity)
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Rma{}, err
+		}
+
+		lineId, err := res.LastInsertId()
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Rma{}, err
+		}
+
+		rma.Lines[i].Id = int(lineId)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Rma{}, err
+	}
+
+	return rma, nil
+}
+
+const rmaColumns = `id, purchase_order_id, reason, notes, status, disposition, section_id, DATE_FORMAT(created_at, '%Y-%m-%d %H:%i:%s'), DATE_FORMAT(inspected_at, '%Y-%m-%d %H:%i:%s'), DATE_FORMAT(closed_at, '%Y-%m-%d %H:%i:%s')`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRma(row scanner) (domain.Rma, error) {
+	rma := domain.Rma{}
+
+	err := row.Scan(&rma.Id, &rma.Purchase_Order_Id, &rma.Reason, &rma.Notes, &rma.Status, &rma.Disposition, &rma.Section_Id, &rma.Created_At, &rma.Inspected_At, &rma.Closed_At)
+
+	return rma, err
+}
+
+func (r *rmaMysqlRepository) GetAll(status string) (domain.Rmas, error) {
+	query := `SELECT ` + rmaColumns + ` FROM rma`
+
+	args := []interface{}{}
+
+	if status != "" {
+		query += ` WHERE status=?`
+		args = append(args, status)
+	}
+
+	rows, err := r.db.Query(query+` ORDER BY id`, args...)
+
+	if err != nil {
+		return domain.Rmas{}, err
+	}
+
+	defer rows.Close()
+
+	rmas := domain.Rmas{}
+
+	for rows.Next() {
+		rma, err := scanRma(rows)
+
+		if err != nil {
+			return domain.Rmas{}, err
+		}
+
+		rmas = append(rmas, rma)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Rmas{}, err
+	}
+
+	return rmas, nil
+}
+
+func (r *rmaMysqlRepository) GetById(id int) (domain.Rma, error) {
+	rma, err := scanRma(r.db.QueryRow(`SELECT `+rmaColumns+` FROM rma WHERE id=?`, id))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Rma{}, usecases.ErrNoElementFound
+	}
+
+	if err != nil {
+		return domain.Rma{}, err
+	}
+
+	lines, err := r.db.Query(`SELECT id, order_details_id, product_id, quantity FROM rma_line WHERE rma_id=? ORDER BY id`, id)
+
+	if err != nil {
+		return domain.Rma{}, err
+	}
+
+	defer lines.Close()
+
+	rma.Lines = domain.RmaLines{}
+
+	for lines.Next() {
+		l := domain.RmaLine{}
+
+		if err := lines.Scan(&l.Id, &l.Order_Details_Id, &l.Product_Id, &l.Quantity); err != nil {
+			return domain.Rma{}, err
+		}
+
+		rma.Lines = append(rma.Lines, l)
+	}
+
+	if err = lines.Err(); err != nil {
+		return domain.Rma{}, err
+	}
+
+	adjustments, err := r.db.Query(`SELECT id, product_id, section_id, type, quantity, reason, DATE_FORMAT(created_at, '%Y-%m-%d %H:%i:%s') FROM inventory_adjustment WHERE rma_id=? ORDER BY id`, id)
+
+	if err != nil {
+		return domain.Rma{}, err
+	}
+
+	defer adjustments.Close()
+
+	rma.Adjustments = domain.InventoryAdjustments{}
+
+	for adjustments.Next() {
+		a := domain.InventoryAdjustment{}
+
+		if err := adjustments.Scan(&a.Id, &a.Product_Id, &a.Section_Id, &a.Type, &a.Quantity, &a.Reason, &a.Created_At); err != nil {
+			return domain.Rma{}, err
+		}
+
+		rma.Adjustments = append(rma.Adjustments, a)
+	}
+
+	if err = adjustments.Err(); err != nil {
+		return domain.Rma{}, err
+	}
+
+	return rma, nil
+}
+
+// UpdateStatus only moves the rma if it is still in the from status.
+func (r *rmaMysqlRepository) UpdateStatus(id int, from string, to string, at string) error {
+	query := `UPDATE rma SET status=?, closed_at=? WHERE id=? AND status=?`
+
+	if to == domain.StatusInspecting {
+		query = `UPDATE rma SET status=?, inspected_at=? WHERE id=? AND status=?`
+	}
+
+	res, err := r.db.Exec(query, to, at, id, from)
+
+	if err != nil {
+		return err
+	}
+
+	return checkMoved(res)
+}
+
+// Accept only records the adjustments if the rma is still in the from status.
+func (r *rmaMysqlRepository) Accept(rma domain.Rma, from string) (domain.Rma, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return domain.Rma{}, err
+	}
+
+	const query = `UPDATE rma SET status=?, disposition=?, section_id=?, closed_at=? WHERE id=? AND status=?`
+
+	res, err := tx.Exec(query, rma.Status, rma.Disposition, rma.Section_Id, rma.Closed_At, rma.Id, from)
+
+	if err == nil {
+		err = checkMoved(res)
+	}
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Rma{}, err
+	}
+
+	const adjustmentQuery = `INSERT INTO inventory_adjustment (rma_id, product_id, section_id, type, quantity, reason, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	restocked := 0
+
+	for i, a := range rma.Adjustments {
+		res, err := tx.Exec(adjustmentQuery, rma.Id, a.Product_Id, a.Section_Id, a.Type, a.Quantity, a.Reason, a.Created_At)
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Rma{}, err
+		}
+
+		adjustmentId, err := res.LastInsertId()
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Rma{}, err
+		}
+
+		rma.Adjustments[i].Id = int(adjustmentId)
+
+		if a.Type == domain.DispositionRestock {
+			restocked += a.Quantity
+		}
+	}
+
+	if rma.Section_Id != nil {
+		if _, err := tx.Exec(`UPDATE section SET current_capacity = current_capacity + ? WHERE id=?`, restocked, *rma.Section_Id); err != nil {
+			_ = tx.Rollback()
+			return domain.Rma{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Rma{}, err
+	}
+
+	return rma, nil
+}
+
+func checkMoved(res sql.Result) error {
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return usecases.ErrStatusChanged
+	}
+
+	return nil
+}
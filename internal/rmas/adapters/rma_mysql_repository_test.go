@@ -0,0 +1,238 @@
+package adapters_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases"
+	"github.com/stretchr/testify/assert"
+)
+
+var rmaColumns = []string{"id", "purchase_order_id", "reason", "notes", "status", "disposition", "section_id", "created_at", "inspected_at", "closed_at"}
+
+func TestGetOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRmaMysqlRepository(db)
+
+	t.Run("Should return ErrOrderNotFound if the purchase order does not exist", func(t *testing.T) {
+		mock.ExpectQuery("SELECT id, warehouse_id, order_status_id FROM purchase_order").WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetOrder(1)
+
+		assert.ErrorIs(t, err, usecases.ErrOrderNotFound)
+	})
+
+	t.Run("Should return the order lines with the quantities already returned", func(t *testing.T) {
+		mock.ExpectQuery("SELECT id, warehouse_id, order_status_id FROM purchase_order").WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_id", "order_status_id"}).AddRow(1, 2, 3))
+		mock.ExpectQuery("SELECT (.+) rma.status <> 'rejected'(.+) FROM order_details od").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "quantity", "returned"}).AddRow(10, 5, 10, 4))
+
+		order, err := sut.GetOrder(1)
+
+		assert.Equal(t, domain.Order{Id: 1, Warehouse_Id: 2, Order_Status_Id: 3, Lines: []domain.OrderLine{{Order_Details_Id: 10, Product_Id: 5, Quantity: 10, Returned_Quantity: 4}}}, order)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRmaMysqlRepository(db)
+
+	rma := domain.Rma{
+		Purchase_Order_Id: 1,
+		Reason:            domain.ReasonDamaged,
+		Status:            domain.StatusRequested,
+		Created_At:        "2022-07-01 10:00:00",
+		Lines:             domain.RmaLines{{Order_Details_Id: 10, Product_Id: 5, Quantity: 2}},
+	}
+
+	t.Run("Should rollback if a line can't be inserted", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO rma \\(").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO rma_line").WillReturnError(errors.New("exec_error"))
+		mock.ExpectRollback()
+
+		_, err := sut.Create(rma)
+
+		assert.EqualError(t, err, "exec_error")
+	})
+
+	t.Run("Should return the rma with ids on success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO rma \\(").
+			WithArgs(1, domain.ReasonDamaged, "", domain.StatusRequested, "2022-07-01 10:00:00").
+			WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectExec("INSERT INTO rma_line").
+			WithArgs(3, 10, 5, 2).
+			WillReturnResult(sqlmock.NewResult(7, 1))
+		mock.ExpectCommit()
+
+		result, err := sut.Create(rma)
+
+		assert.Equal(t, 3, result.Id)
+		assert.Equal(t, 7, result.Lines[0].Id)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRmaMysqlRepository(db)
+
+	t.Run("Should return ErrNoElementFound if the rma does not exist", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM rma WHERE id=\\?").WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetById(1)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
+
+	t.Run("Should return the rma with lines and adjustments", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) DATE_FORMAT\\(closed_at, '%Y-%m-%d %H:%i:%s'\\) FROM rma WHERE id=\\?").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(rmaColumns).AddRow(1, 1, "expired", "", "accepted", "write_off", nil, "2022-07-01 10:00:00", "2022-07-02 10:00:00", "2022-07-03 10:00:00"))
+		mock.ExpectQuery("FROM rma_line WHERE rma_id=\\?").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_details_id", "product_id", "quantity"}).AddRow(1, 10, 5, 2))
+		mock.ExpectQuery("FROM inventory_adjustment WHERE rma_id=\\?").WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "section_id", "type", "quantity", "reason", "created_at"}).AddRow(1, 5, nil, "write_off", 2, "expired", "2022-07-03 10:00:00"))
+
+		rma, err := sut.GetById(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, domain.StatusAccepted, rma.Status)
+		assert.Equal(t, domain.DispositionWriteOff, *rma.Disposition)
+		assert.Nil(t, rma.Section_Id)
+		assert.Equal(t, domain.RmaLines{{Id: 1, Order_Details_Id: 10, Product_Id: 5, Quantity: 2}}, rma.Lines)
+		assert.Equal(t, domain.InventoryAdjustments{{Id: 1, Product_Id: 5, Type: "write_off", Quantity: 2, Reason: "expired", Created_At: "2022-07-03 10:00:00"}}, rma.Adjustments)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccept(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRmaMysqlRepository(db)
+
+	t.Run("Should record the adjustments and fill the section on restock", func(t *testing.T) {
+		section, disposition, at := 3, domain.DispositionRestock, "2022-07-03 10:00:00"
+		rma := domain.Rma{
+			Id:          1,
+			Status:      domain.StatusAccepted,
+			Disposition: &disposition,
+			Section_Id:  &section,
+			Closed_At:   &at,
+			Adjustments: domain.InventoryAdjustments{
+				{Product_Id: 5, Section_Id: &section, Type: disposition, Quantity: 2, Reason: "temperature", Created_At: at},
+				{Product_Id: 6, Section_Id: &section, Type: disposition, Quantity: 3, Reason: "temperature", Created_At: at},
+			},
+		}
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE rma SET status=\\?, disposition=\\?, section_id=\\?, closed_at=\\? WHERE id=\\? AND status=\\?").
+			WithArgs(domain.StatusAccepted, &disposition, &section, &at, 1, domain.StatusInspecting).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO inventory_adjustment").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO inventory_adjustment").WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec("UPDATE section SET current_capacity = current_capacity \\+ \\?").WithArgs(5, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		result, err := sut.Accept(rma, domain.StatusInspecting)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, result.Adjustments[0].Id)
+		assert.Equal(t, 2, result.Adjustments[1].Id)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should rollback if an adjustment can't be inserted", func(t *testing.T) {
+		disposition, at := domain.DispositionWriteOff, "2022-07-03 10:00:00"
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE rma SET").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO inventory_adjustment").WillReturnError(errors.New("exec_error"))
+		mock.ExpectRollback()
+
+		_, err := sut.Accept(domain.Rma{Id: 1, Disposition: &disposition, Closed_At: &at, Adjustments: domain.InventoryAdjustments{{Product_Id: 5, Type: disposition, Quantity: 2}}}, domain.StatusInspecting)
+
+		assert.EqualError(t, err, "exec_error")
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should rollback without adjustments if the rma left the expected status", func(t *testing.T) {
+		disposition, at := domain.DispositionWriteOff, "2022-07-03 10:00:00"
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE rma SET").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := sut.Accept(domain.Rma{Id: 1, Disposition: &disposition, Closed_At: &at, Adjustments: domain.InventoryAdjustments{{Product_Id: 5, Type: disposition, Quantity: 2}}}, domain.StatusInspecting)
+
+		assert.ErrorIs(t, err, usecases.ErrStatusChanged)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestUpdateStatusRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewRmaMysqlRepository(db)
+
+	t.Run("Should set inspected_at when inspecting a requested rma", func(t *testing.T) {
+		mock.ExpectExec("UPDATE rma SET status=\\?, inspected_at=\\? WHERE id=\\? AND status=\\?").
+			WithArgs(domain.StatusInspecting, "2022-07-02 10:00:00", 1, domain.StatusRequested).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := sut.UpdateStatus(1, domain.StatusRequested, domain.StatusInspecting, "2022-07-02 10:00:00")
+
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return ErrStatusChanged if the rma left the expected status", func(t *testing.T) {
+		mock.ExpectExec("UPDATE rma SET status=\\?, closed_at=\\? WHERE id=\\? AND status=\\?").
+			WithArgs(domain.StatusRejected, "2022-07-02 10:00:00", 1, domain.StatusInspecting).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := sut.UpdateStatus(1, domain.StatusInspecting, domain.StatusRejected, "2022-07-02 10:00:00")
+
+		assert.ErrorIs(t, err, usecases.ErrStatusChanged)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
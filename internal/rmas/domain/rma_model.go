@@ -0,0 +1,78 @@
+package domain
+
+const (
+	ReasonDamaged     = "damaged"
+	ReasonTemperature = "temperature"
+	ReasonWrongItem   = "wrong_item"
+	ReasonExpired     = "expired"
+)
+
+const (
+	StatusRequested  = "requested"
+	StatusInspecting = "inspecting"
+	StatusAccepted   = "accepted"
+	StatusRejected   = "rejected"
+)
+
+const (
+	DispositionRestock  = "restock"
+	DispositionWriteOff = "write_off"
+)
+
+type OrderLine struct {
+	Order_Details_Id  int
+	Product_Id        int
+	Quantity          int
+	Returned_Quantity int
+}
+
+type Order struct {
+	Id              int
+	Warehouse_Id    int
+	Order_Status_Id int
+	Lines           []OrderLine
+}
+
+type Section struct {
+	Id           int
+	Warehouse_Id int
+	Quarantine   bool
+}
+
+type RmaLine struct {
+	Id               int `json:"id"`
+	Order_Details_Id int `json:"order_details_id"`
+	Product_Id       int `json:"product_id"`
+	Quantity         int `json:"quantity"`
+}
+
+type RmaLines []RmaLine
+
+type InventoryAdjustment struct {
+	Id         int    `json:"id"`
+	Product_Id int    `json:"product_id"`
+	Section_Id *int   `json:"section_id"`
+	Type       string `json:"type"`
+	Quantity   int    `json:"quantity"`
+	Reason     string `json:"reason"`
+	Created_At string `json:"created_at"`
+}
+
+type InventoryAdjustments []InventoryAdjustment
+
+type Rma struct {
+	Id                int                  `json:"id"`
+	Purchase_Order_Id int                  `json:"purchase_order_id"`
+	Reason            string               `json:"reason"`
+	Notes             string               `json:"notes"`
+	Status            string               `json:"status"`
+	Disposition       *string              `json:"disposition"`
+	Section_Id        *int                 `json:"section_id"`
+	Created_At        string               `json:"created_at"`
+	Inspected_At      *string              `json:"inspected_at"`
+	Closed_At         *string              `json:"closed_at"`
+	Lines             RmaLines             `json:"lines,omitempty"`
+	Adjustments       InventoryAdjustments `json:"adjustments,omitempty"`
+}
+
+type Rmas []Rma
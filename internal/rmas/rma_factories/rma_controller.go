@@ -0,0 +1,15 @@
+package rma_factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases"
+)
+
+func MakeRmaController() *adapters.RmaController {
+	rr := adapters.NewRmaMysqlRepository(db.GetInstance())
+	rs := usecases.NewRmaService(rr)
+	rc := adapters.NewRmaController(rs)
+
+	return rc
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import "errors"
+
+var ErrNoElementFound = errors.New("rma not found")
+
+var ErrOrderNotFound = errors.New("purchase order not found")
+
+var ErrSectionNotFound = errors.New("section not found")
+
+var ErrInvalidReason = errors.New("reason must be damaged, temperature, wrong_item or expired")
+
+var ErrInvalidStatus = errors.New("status must be inspecting, accepted or rejected")
+
+var ErrInvalidStatusTransition = errors.New("rmas can only move from requested to inspecting and from inspecting to accepted or rejected")
+
+var ErrStatusChanged = errors.New("rma status was changed by another request")
+
+var ErrInvalidDisposition = errors.New("accepted rmas need a disposition of restock, with a section_id, or write_off")
+
+var ErrOrderNotShipped = errors.New("only shipped or delivered purchase orders can be returned")
+
+var ErrLineNotInOrder = errors.New("order_details_id must belong to the purchase order")
+
+var ErrQuantityExceeded = errors.New("returned quantity can't exceed the quantity ordered")
+
+var ErrSectionNotInWarehouse = errors.New("section must belong to the purchase order warehouse")
+
+var ErrSectionNotQuarantine = errors.New("restock section must be a quarantine section")
@@ -0,0 +1,13 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/domain"
+
+type RmaRepository interface {
+	GetOrder(purchase_order_id int) (domain.Order, error)
+	GetSection(section_id int) (domain.Section, error)
+	Create(rma domain.Rma) (domain.Rma, error)
+	GetAll(status string) (domain.Rmas, error)
+	GetById(id int) (domain.Rma, error)
+	UpdateStatus(id int, from string, to string, at string) error
+	Accept(rma domain.Rma, from string) (domain.Rma, error)
+}
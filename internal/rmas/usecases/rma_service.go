@@ -0,0 +1,204 @@
+package usecases
+
+import (
+	"time"
+
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/domain"
+)
+
+type RmaService interface {
+	Create(purchase_order_id int, reason string, notes string, lines domain.RmaLines) (domain.Rma, error)
+	GetAll(status string) (domain.Rmas, error)
+	GetById(id int) (domain.Rma, error)
+	UpdateStatus(id int, status string, disposition string, section_id int) (domain.Rma, error)
+}
+
+type rmaService struct {
+	repository RmaRepository
+}
+
+func NewRmaService(r RmaRepository) RmaService {
+	return &rmaService{
+		repository: r,
+	}
+}
+
+// next holds the statuses each status may move to.
+var next = map[string][]string{
+	domain.StatusRequested:  {domain.StatusInspecting},
+	domain.StatusInspecting: {domain.StatusAccepted, domain.StatusRejected},
+}
+
+func isValidReason(reason string) bool {
+	switch reason {
+	case domain.ReasonDamaged, domain.ReasonTemperature, domain.ReasonWrongItem, domain.ReasonExpired:
+		return true
+	}
+
+	return false
+}
+
+func canMove(from string, to string) bool {
+	for _, status := range next[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+func now() string {
+	return time.Now().Format("2006-01-02 15:04:05")
+}
+
+func (s *rmaService) Create(purchase_order_id int, reason string, notes string, lines domain.RmaLines) (domain.Rma, error) {
+	if !isValidReason(reason) {
+		return domain.Rma{}, ErrInvalidReason
+	}
+
+	order, err := s.repository.GetOrder(purchase_order_id)
+
+	if err != nil {
+		return domain.Rma{}, err
+	}
+
+	if order.Order_Status_Id != purchase.StatusShipped && order.Order_Status_Id != purchase.StatusDelivered {
+		return domain.Rma{}, ErrOrderNotShipped
+	}
+
+	orderLines := map[int]domain.OrderLine{}
+
+	for _, l := range order.Lines {
+		orderLines[l.Order_Details_Id] = l
+	}
+
+	requested := map[int]int{}
+
+	for i, l := range lines {
+		orderLine, ok := orderLines[l.Order_Details_Id]
+
+		if !ok {
+			return domain.Rma{}, ErrLineNotInOrder
+		}
+
+		requested[l.Order_Details_Id] += l.Quantity
+
+		if orderLine.Returned_Quantity+requested[l.Order_Details_Id] > orderLine.Quantity {
+			return domain.Rma{}, ErrQuantityExceeded
+		}
+
+		lines[i].Product_Id = orderLine.Product_Id
+	}
+
+	return s.repository.Create(domain.Rma{
+		Purchase_Order_Id: purchase_order_id,
+		Reason:            reason,
+		Notes:             notes,
+		Status:            domain.StatusRequested,
+		Created_At:        now(),
+		Lines:             lines,
+	})
+}
+
+func (s *rmaService) GetAll(status string) (domain.Rmas, error) {
+	return s.repository.GetAll(status)
+}
+
+func (s *rmaService) GetById(id int) (domain.Rma, error) {
+	return s.repository.GetById(id)
+}
+
+func (s *rmaService) UpdateStatus(id int, status string, disposition string, section_id int) (domain.Rma, error) {
+	if status != domain.StatusInspecting && status != domain.StatusAccepted && status != domain.StatusRejected {
+		return domain.Rma{}, ErrInvalidStatus
+	}
+
+	if status == domain.StatusAccepted && !(disposition == domain.DispositionRestock && section_id > 0 || disposition == domain.DispositionWriteOff) {
+		return domain.Rma{}, ErrInvalidDisposition
+	}
+
+	rma, err := s.repository.GetById(id)
+
+	if err != nil {
+		return domain.Rma{}, err
+	}
+
+	if !canMove(rma.Status, status) {
+		return domain.Rma{}, ErrInvalidStatusTransition
+	}
+
+	from, at := rma.Status, now()
+
+	if status != domain.StatusAccepted {
+		if err := s.repository.UpdateStatus(id, from, status, at); err != nil {
+			return domain.Rma{}, err
+		}
+
+		rma.Status = status
+
+		if status == domain.StatusInspecting {
+			rma.Inspected_At = &at
+		} else {
+			rma.Closed_At = &at
+		}
+
+		return rma, nil
+	}
+
+	var section *int
+
+	if disposition == domain.DispositionRestock {
+		if err := s.checkSection(rma.Purchase_Order_Id, section_id); err != nil {
+			return domain.Rma{}, err
+		}
+
+		section = &section_id
+	}
+
+	rma.Status = status
+	rma.Disposition = &disposition
+	rma.Section_Id = section
+	rma.Closed_At = &at
+	rma.Adjustments = domain.InventoryAdjustments{}
+
+	for _, l := range rma.Lines {
+		rma.Adjustments = append(rma.Adjustments, domain.InventoryAdjustment{
+			Product_Id: l.Product_Id,
+			Section_Id: section,
+			Type:       disposition,
+			Quantity:   l.Quantity,
+			Reason:     rma.Reason,
+			Created_At: at,
+		})
+	}
+
+	return s.repository.Accept(rma, from)
+}
+
+// checkSection makes sure returned goods are restocked in a quarantine section of the warehouse that shipped them.
+func (s *rmaService) checkSection(purchase_order_id int, section_id int) error {
+	order, err := s.repository.GetOrder(purchase_order_id)
+
+	if err != nil {
+		return err
+	}
+
+	section, err := s.repository.GetSection(section_id)
+
+	if err != nil {
+		return err
+	}
+
+	if section.Warehouse_Id != order.Warehouse_Id {
+		return ErrSectionNotInWarehouse
+	}
+
+	if !section.Quarantine {
+		return ErrSectionNotQuarantine
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/rmas/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeOrder() domain.Order {
+	return domain.Order{
+		Id:              1,
+		Warehouse_Id:    1,
+		Order_Status_Id: purchase.StatusDelivered,
+		Lines: []domain.OrderLine{
+			{Order_Details_Id: 10, Product_Id: 5, Quantity: 10, Returned_Quantity: 4},
+			{Order_Details_Id: 11, Product_Id: 6, Quantity: 3},
+		},
+	}
+}
+
+func makeRma(status string) domain.Rma {
+	return domain.Rma{
+		Id:                1,
+		Purchase_Order_Id: 1,
+		Reason:            domain.ReasonTemperature,
+		Status:            status,
+		Created_At:        "2022-07-01 10:00:00",
+		Lines: domain.RmaLines{
+			{Id: 1, Order_Details_Id: 10, Product_Id: 5, Quantity: 2},
+			{Id: 2, Order_Details_Id: 11, Product_Id: 6, Quantity: 3},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should return ErrInvalidReason if the reason is unknown", func(t *testing.T) {
+		service := usecases.NewRmaService(mocks.NewRmaRepository(t))
+
+		_, err := service.Create(1, "late", "", domain.RmaLines{{Order_Details_Id: 10, Quantity: 1}})
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidReason)
+	})
+
+	t.Run("Should return ErrOrderNotFound if the purchase order does not exist", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetOrder", 1).Return(domain.Order{}, usecases.ErrOrderNotFound).Once()
+
+		_, err := service.Create(1, domain.ReasonDamaged, "", domain.RmaLines{{Order_Details_Id: 10, Quantity: 1}})
+
+		assert.ErrorIs(t, err, usecases.ErrOrderNotFound)
+	})
+
+	t.Run("Should return ErrOrderNotShipped if the purchase order was not shipped yet", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		order := makeOrder()
+		order.Order_Status_Id = purchase.StatusPending
+		mockRepository.On("GetOrder", 1).Return(order, nil).Once()
+
+		_, err := service.Create(1, domain.ReasonDamaged, "", domain.RmaLines{{Order_Details_Id: 10, Quantity: 1}})
+
+		assert.ErrorIs(t, err, usecases.ErrOrderNotShipped)
+	})
+
+	t.Run("Should return ErrLineNotInOrder if a line belongs to another order", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetOrder", 1).Return(makeOrder(), nil).Once()
+
+		_, err := service.Create(1, domain.ReasonDamaged, "", domain.RmaLines{{Order_Details_Id: 99, Quantity: 1}})
+
+		assert.ErrorIs(t, err, usecases.ErrLineNotInOrder)
+	})
+
+	t.Run("Should return ErrQuantityExceeded if the line was already returned", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetOrder", 1).Return(makeOrder(), nil).Once()
+
+		_, err := service.Create(1, domain.ReasonDamaged, "", domain.RmaLines{{Order_Details_Id: 10, Quantity: 4}, {Order_Details_Id: 10, Quantity: 3}})
+
+		assert.ErrorIs(t, err, usecases.ErrQuantityExceeded)
+	})
+
+	t.Run("Should create a requested rma with the products of the order lines", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetOrder", 1).Return(makeOrder(), nil).Once()
+		mockRepository.On("Create", mock.MatchedBy(func(rma domain.Rma) bool {
+			return rma.Status == domain.StatusRequested && rma.Reason == domain.ReasonTemperature && rma.Notes == "warm on arrival" &&
+				len(rma.Lines) == 2 && rma.Lines[0].Product_Id == 5 && rma.Lines[1].Product_Id == 6
+		})).Return(makeRma(domain.StatusRequested), nil).Once()
+
+		rma, err := service.Create(1, domain.ReasonTemperature, "warm on arrival", domain.RmaLines{{Order_Details_Id: 10, Quantity: 2}, {Order_Details_Id: 11, Quantity: 3}})
+
+		assert.Equal(t, makeRma(domain.StatusRequested), rma)
+		assert.Nil(t, err)
+	})
+}
+
+func TestUpdateStatus(t *testing.T) {
+	t.Run("Should return ErrInvalidStatus if the status is unknown", func(t *testing.T) {
+		service := usecases.NewRmaService(mocks.NewRmaRepository(t))
+
+		_, err := service.UpdateStatus(1, "closed", "", 0)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidStatus)
+	})
+
+	t.Run("Should return ErrInvalidDisposition if an accepted rma has no valid disposition", func(t *testing.T) {
+		service := usecases.NewRmaService(mocks.NewRmaRepository(t))
+
+		for _, disposition := range []string{"", "resell", domain.DispositionRestock} {
+			_, err := service.UpdateStatus(1, domain.StatusAccepted, disposition, 0)
+
+			assert.ErrorIs(t, err, usecases.ErrInvalidDisposition, disposition)
+		}
+	})
+
+	t.Run("Should return ErrInvalidStatusTransition if the rma skips inspection", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetById", 1).Return(makeRma(domain.StatusRequested), nil).Once()
+
+		_, err := service.UpdateStatus(1, domain.StatusAccepted, domain.DispositionWriteOff, 0)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidStatusTransition)
+	})
+
+	t.Run("Should move a requested rma to inspecting", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetById", 1).Return(makeRma(domain.StatusRequested), nil).Once()
+		mockRepository.On("UpdateStatus", 1, domain.StatusRequested, domain.StatusInspecting, mock.AnythingOfType("string")).Return(nil).Once()
+
+		rma, err := service.UpdateStatus(1, domain.StatusInspecting, "", 0)
+
+		assert.Equal(t, domain.StatusInspecting, rma.Status)
+		assert.NotNil(t, rma.Inspected_At)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return an error if UpdateStatus from Rma Repository returns an error", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetById", 1).Return(makeRma(domain.StatusInspecting), nil).Once()
+		mockRepository.On("UpdateStatus", 1, domain.StatusInspecting, domain.StatusRejected, mock.AnythingOfType("string")).Return(errors.New("any_error")).Once()
+
+		_, err := service.UpdateStatus(1, domain.StatusRejected, "", 0)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should return ErrSectionNotInWarehouse if the restock section is in another warehouse", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetById", 1).Return(makeRma(domain.StatusInspecting), nil).Once()
+		mockRepository.On("GetOrder", 1).Return(makeOrder(), nil).Once()
+		mockRepository.On("GetSection", 3).Return(domain.Section{Id: 3, Warehouse_Id: 2, Quarantine: true}, nil).Once()
+
+		_, err := service.UpdateStatus(1, domain.StatusAccepted, domain.DispositionRestock, 3)
+
+		assert.ErrorIs(t, err, usecases.ErrSectionNotInWarehouse)
+	})
+
+	t.Run("Should return ErrSectionNotQuarantine if the restock section is not a quarantine section", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetById", 1).Return(makeRma(domain.StatusInspecting), nil).Once()
+		mockRepository.On("GetOrder", 1).Return(makeOrder(), nil).Once()
+		mockRepository.On("GetSection", 3).Return(domain.Section{Id: 3, Warehouse_Id: 1}, nil).Once()
+
+		_, err := service.UpdateStatus(1, domain.StatusAccepted, domain.DispositionRestock, 3)
+
+		assert.ErrorIs(t, err, usecases.ErrSectionNotQuarantine)
+	})
+
+	t.Run("Should restock every line into the section on acceptance", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetById", 1).Return(makeRma(domain.StatusInspecting), nil).Once()
+		mockRepository.On("GetOrder", 1).Return(makeOrder(), nil).Once()
+		mockRepository.On("GetSection", 3).Return(domain.Section{Id: 3, Warehouse_Id: 1, Quarantine: true}, nil).Once()
+		mockRepository.On("Accept", mock.Anything, domain.StatusInspecting).Return(func(rma domain.Rma, from string) domain.Rma { return rma }, nil).Once()
+
+		rma, err := service.UpdateStatus(1, domain.StatusAccepted, domain.DispositionRestock, 3)
+
+		assert.Nil(t, err)
+		assert.Equal(t, domain.StatusAccepted, rma.Status)
+		assert.Equal(t, domain.DispositionRestock, *rma.Disposition)
+		assert.Equal(t, 3, *rma.Section_Id)
+		assert.Len(t, rma.Adjustments, 2)
+
+		for i, a := range rma.Adjustments {
+			assert.Equal(t, rma.Lines[i].Product_Id, a.Product_Id)
+			assert.Equal(t, rma.Lines[i].Quantity, a.Quantity)
+			assert.Equal(t, domain.DispositionRestock, a.Type)
+			assert.Equal(t, domain.ReasonTemperature, a.Reason)
+			assert.Equal(t, 3, *a.Section_Id)
+		}
+	})
+
+	t.Run("Should write off every line without a section on acceptance", func(t *testing.T) {
+		mockRepository := mocks.NewRmaRepository(t)
+		service := usecases.NewRmaService(mockRepository)
+		mockRepository.On("GetById", 1).Return(makeRma(domain.StatusInspecting), nil).Once()
+		mockRepository.On("Accept", mock.Anything, domain.StatusInspecting).Return(func(rma domain.Rma, from string) domain.Rma { return rma }, nil).Once()
+
+		rma, err := service.UpdateStatus(1, domain.StatusAccepted, domain.DispositionWriteOff, 0)
+
+		assert.Nil(t, err)
+		assert.Nil(t, rma.Section_Id)
+		assert.Len(t, rma.Adjustments, 2)
+
+		for _, a := range rma.Adjustments {
+			assert.Equal(t, domain.DispositionWriteOff, a.Type)
+			assert.Nil(t, a.Section_Id)
+		}
+	})
+}
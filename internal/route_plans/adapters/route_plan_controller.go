@@ -0,0 +1,171 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases"
+)
+
+const dateLayout = "2006-01-02"
+
+type RoutePlanController struct {
+	service usecases.RoutePlanService
+}
+
+func CreateRoutePlanController(rs usecases.RoutePlanService) *RoutePlanController {
+	return &RoutePlanController{
+		service: rs,
+	}
+}
+
+func (rc *RoutePlanController) CreateRoute(ctx *gin.Context) {
+	carrierId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "id must be a number",
+		})
+		return
+	}
+
+	var req routeRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+
+	if req.RouteDate == "" {
+		req.RouteDate = time.Now().Format(dateLayout)
+	}
+
+	if _, err := time.Parse(dateLayout, req.RouteDate); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "route_date must be in the format yyyy-mm-dd",
+		})
+		return
+	}
+
+	manifest, err := rc.service.Plan(carrierId, req.RouteDate)
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"data": manifest,
+	})
+}
+
+func (rc *RoutePlanController) GetRoute(ctx *gin.Context) {
+	carrierId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "id must be a number",
+		})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("route_id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "route_id must be a number",
+		})
+		return
+	}
+
+	format := ctx.DefaultQuery("format", "json")
+
+	if format != "json" && format != "csv" {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "format must be json or csv",
+		})
+		return
+	}
+
+	manifest, err := rc.service.GetById(carrierId, id)
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	if format == "json" {
+		ctx.JSON(http.StatusOK, gin.H{
+			"data": manifest,
+		})
+		return
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.WriteAll(manifestCSV(manifest)); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="route_`+strconv.Itoa(manifest.Id)+`.csv"`)
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrCarrierNotFound), errors.Is(err, usecases.ErrManifestNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrNoShippedOrders):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrOrderAlreadyRouted):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
+
+func manifestCSV(manifest domain.Manifest) [][]string {
+	rows := [][]string{{
+		"sequence", "locality", "province", "distance_km", "purchase_order_id",
+		"order_number", "tracking_code", "buyer_id", "address",
+	}}
+
+	for _, s := range manifest.Stops {
+		distance := ""
+
+		if s.DistanceKm != nil {
+			distance = strconv.FormatFloat(*s.DistanceKm, 'f', 2, 64)
+		}
+
+		for _, o := range s.Orders {
+			rows = append(rows, []string{
+				strconv.Itoa(s.Sequence),
+				s.LocalityName,
+				s.ProvinceName,
+				distance,
+				strconv.Itoa(o.PurchaseOrderId),
+				o.OrderNumber,
+				o.TrackingCode,
+				strconv.Itoa(o.BuyerId),
+				o.Address,
+			})
+		}
+	}
+
+	return rows
+}
+
+type routeRequest struct {
+	RouteDate string `json:"route_date"`
+}
@@ -0,0 +1,113 @@
+package adapters_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeControllerSut(t *testing.T) (*gin.Engine, *mocks.RoutePlanService) {
+	gin.SetMode(gin.TestMode)
+	mockRoutePlanService := mocks.NewRoutePlanService(t)
+	sut := adapters.CreateRoutePlanController(mockRoutePlanService)
+	server := gin.Default()
+	server.POST("/carriers/:id/routes", sut.CreateRoute)
+	server.GET("/carriers/:id/routes/:route_id", sut.GetRoute)
+	return server, mockRoutePlanService
+}
+
+func TestCreateRoute(t *testing.T) {
+	t.Run("Should return 400 if route_date is malformed", func(t *testing.T) {
+		server, _ := makeControllerSut(t)
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/carriers/1/routes", bytes.NewBuffer([]byte(`{"route_date": "01/07/2022"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should default route_date to today when there is no body", func(t *testing.T) {
+		server, mockRoutePlanService := makeControllerSut(t)
+		mockRoutePlanService.On("Plan", 1, mock.AnythingOfType("string")).Return(domain.Manifest{Id: 1}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/carriers/1/routes", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Should map service errors to status codes", func(t *testing.T) {
+		cases := map[error]int{
+			usecases.ErrCarrierNotFound: http.StatusNotFound,
+			usecases.ErrNoShippedOrders: http.StatusUnprocessableEntity,
+			errors.New("any_error"):     http.StatusInternalServerError,
+		}
+
+		for err, code := range cases {
+			server, mockRoutePlanService := makeControllerSut(t)
+			mockRoutePlanService.On("Plan", 1, "2022-07-01").Return(domain.Manifest{}, err).Once()
+			rr := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/carriers/1/routes", bytes.NewBuffer([]byte(`{"route_date": "2022-07-01"}`)))
+			server.ServeHTTP(rr, req)
+
+			assert.Equal(t, code, rr.Code)
+		}
+	})
+}
+
+func TestGetRoute(t *testing.T) {
+	distance := 83.64
+	manifest := domain.Manifest{
+		Id:        5,
+		CarrierId: 1,
+		Stops: []domain.Stop{
+			{Sequence: 1, LocalityName: "Campinas", ProvinceName: "SP", DistanceKm: &distance, Orders: []domain.ManifestOrder{{PurchaseOrderId: 1, OrderNumber: "order1", TrackingCode: "MF000000014BR", BuyerId: 2, Address: "rua 1"}}},
+			{Sequence: 2, LocalityName: "Itabaiana", ProvinceName: "SE", Orders: []domain.ManifestOrder{{PurchaseOrderId: 3, OrderNumber: "order3", TrackingCode: "MF000000031BR", BuyerId: 2, Address: "rua 3"}}},
+		},
+	}
+
+	t.Run("Should return 400 if format is unknown", func(t *testing.T) {
+		server, _ := makeControllerSut(t)
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers/1/routes/5?format=xml", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should return 404 if the manifest doesn't exist", func(t *testing.T) {
+		server, mockRoutePlanService := makeControllerSut(t)
+		mockRoutePlanService.On("GetById", 1, 5).Return(domain.Manifest{}, usecases.ErrManifestNotFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers/1/routes/5", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return one csv row per order", func(t *testing.T) {
+		server, mockRoutePlanService := makeControllerSut(t)
+		mockRoutePlanService.On("GetById", 1, 5).Return(manifest, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/carriers/1/routes/5?format=csv", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+		lines := strings.Split(strings.TrimSpace(rr.Body.String()), "\n")
+		assert.Len(t, lines, 3)
+		assert.Equal(t, "1,Campinas,SP,83.64,1,order1,MF000000014BR,2,rua 1", lines[1])
+		assert.Equal(t, "2,Itabaiana,SE,,3,order3,MF000000031BR,2,rua 3", lines[2])
+	})
+}
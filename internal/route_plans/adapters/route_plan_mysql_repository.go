@@ -0,0 +1,209 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases"
+)
+
+type routePlanMySQLRepositoryAdapter struct {
+	db *sql.DB
+}
+
+func CreateRoutePlanMySQLRepository(db *sql.DB) usecases.RoutePlanRepository {
+	return &routePlanMySQLRepositoryAdapter{
+		db: db,
+	}
+}
+
+func (r *routePlanMySQLRepositoryAdapter) GetOrigin(carrierId int) (domain.Origin, error) {
+	const query = `SELECT c.locality_id, l.latitude, l.longitude FROM carrier c
+	INNER JOIN locality l ON l.id = c.locality_id
+	WHERE c.id=? AND c.deleted_at IS NULL`
+
+	o := domain.Origin{}
+	err := r.db.QueryRow(query, carrierId).Scan(&o.LocalityId, &o.Latitude, &o.Longitude)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Origin{}, usecases.ErrCarrierNotFound
+	}
+
+	if err != nil {
+		return domain.Origin{}, err
+	}
+
+	return o, nil
+}
+
+// GetShippedOrders skips orders placed after the route date and orders that
+// are already on a manifest. Orders whose address was removed come back
+// without a locality.
+func (r *routePlanMySQLRepositoryAdapter) GetShippedOrders(carrierId int, orderStatusId int, routeDate string) ([]domain.ShippedOrder, error) {
+	const query = `SELECT po.id, po.order_number, po.tracking_code, po.buyer_id, COALESCE(ba.address, ''), l.id, COALESCE(l.name, ''), COALESCE(p.name, ''), l.latitude, l.longitude
+	FROM purchase_order po
+	LEFT JOIN buyer_address ba ON ba.id = po.buyer_address_id
+	LEFT JOIN locality l ON l.id = ba.locality_id
+	LEFT JOIN province p ON p.id = l.province_id
+	WHERE po.carrier_id=? AND po.order_status_id=? AND DATE(po.order_date) <= ?
+	AND NOT EXISTS (SELECT 1 FROM route_manifest_order mo INNER JOIN route_manifest m ON m.id = mo.manifest_id WHERE mo.purchase_order_id = po.id AND m.route_date >= ?)
+	ORDER BY po.id`
+
+	// orders still shipped after an earlier manifest failed delivery and can be planned again
+	rows, err := r.db.Query(query, carrierId, orderStatusId, routeDate, routeDate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	orders := []domain.ShippedOrder{}
+
+	for rows.Next() {
+		o := domain.ShippedOrder{}
+
+		if err := rows.Scan(&o.PurchaseOrderId, &o.OrderNumber, &o.TrackingCode, &o.BuyerId, &o.Address, &o.LocalityId, &o.LocalityName, &o.ProvinceName, &o.Latitude, &o.Longitude); err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, o)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// Create saves the manifest, its stops and their orders in one transaction.
+func (r *routePlanMySQLRepositoryAdapter) Create(manifest domain.Manifest) (domain.Manifest, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return domain.Manifest{}, err
+	}
+
+	const query = `INSERT INTO route_manifest (carrier_id, route_date, total_distance_km, created_at) VALUES (?, ?, ?, ?)`
+
+	res, err := tx.Exec(query, manifest.CarrierId, manifest.RouteDate, manifest.TotalDistanceKm, manifest.CreatedAt)
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Manifest{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Manifest{}, err
+	}
+
+	manifest.Id = int(id)
+
+	const stopQuery = `INSERT INTO route_manifest_stop (manifest_id, sequence, locality_id, distance_km) VALUES (?, ?, ?, ?)`
+	const orderQuery = `INSERT INTO route_manifest_order (manifest_id, stop_id, purchase_order_id) VALUES (?, ?, ?)`
+
+	for i, stop := range manifest.Stops {
+		res, err := tx.Exec(stopQuery, manifest.Id, stop.Sequence, stop.LocalityId, stop.DistanceKm)
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Manifest{}, err
+		}
+
+		stopId, err := res.LastInsertId()
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Manifest{}, err
+		}
+
+		manifest.Stops[i].Id = int(stopId)
+
+		for _, order := range stop.Orders {
+			if _, err := tx.Exec(orderQuery, manifest.Id, stopId, order.PurchaseOrderId); err != nil {
+				_ = tx.Rollback()
+
+				var mysqlErr *mysql.MySQLError
+
+				if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+					return domain.Manifest{}, usecases.ErrOrderAlreadyRouted
+				}
+
+				return domain.Manifest{}, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+func (r *routePlanMySQLRepositoryAdapter) GetById(carrierId int, id int) (domain.Manifest, error) {
+	const query = `SELECT id, carrier_id, DATE_FORMAT(route_date, '%Y-%m-%d'), total_distance_km, DATE_FORMAT(created_at, '%Y-%m-%d %H:%i:%s')
+	FROM route_manifest WHERE id=? AND carrier_id=?`
+
+	m := domain.Manifest{}
+	err := r.db.QueryRow(query, id, carrierId).Scan(&m.Id, &m.CarrierId, &m.RouteDate, &m.TotalDistanceKm, &m.CreatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Manifest{}, usecases.ErrManifestNotFound
+	}
+
+	if err != nil {
+		return domain.Manifest{}, err
+	}
+
+	const stopsQuery = `SELECT s.id, s.sequence, l.id, COALESCE(l.name, ''), COALESCE(p.name, ''), s.distance_km,
+	po.id, po.order_number, po.tracking_code, po.buyer_id, COALESCE(ba.address, '')
+	FROM route_manifest_stop s
+	LEFT JOIN locality l ON l.id = s.locality_id
+	LEFT JOIN province p ON p.id = l.province_id
+	INNER JOIN route_manifest_order mo ON mo.stop_id = s.id
+	INNER JOIN purchase_order po ON po.id = mo.purchase_order_id
+	LEFT JOIN buyer_address ba ON ba.id = po.buyer_address_id
+	WHERE s.manifest_id=?
+	ORDER BY s.sequence, po.id`
+
+	rows, err := r.db.Query(stopsQuery, m.Id)
+
+	if err != nil {
+		return domain.Manifest{}, err
+	}
+
+	defer rows.Close()
+
+	m.Stops = []domain.Stop{}
+
+	for rows.Next() {
+		s := domain.Stop{}
+		o := domain.ManifestOrder{}
+
+		if err := rows.Scan(&s.Id, &s.Sequence, &s.LocalityId, &s.LocalityName, &s.ProvinceName, &s.DistanceKm,
+			&o.PurchaseOrderId, &o.OrderNumber, &o.TrackingCode, &o.BuyerId, &o.Address); err != nil {
+			return domain.Manifest{}, err
+		}
+
+		if last := len(m.Stops) - 1; last < 0 || m.Stops[last].Id != s.Id {
+			m.Stops = append(m.Stops, s)
+		}
+
+		last := len(m.Stops) - 1
+		m.Stops[last].Orders = append(m.Stops[last].Orders, o)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Manifest{}, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,171 @@
+package adapters_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeRepositorySut(t *testing.T) (usecases.RoutePlanRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	return adapters.CreateRoutePlanMySQLRepository(db), mock
+}
+
+func TestRepositoryGetOrigin(t *testing.T) {
+	t.Run("Should return ErrCarrierNotFound if the carrier doesn't exist", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectQuery("FROM carrier c").WithArgs(1).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetOrigin(1)
+
+		assert.ErrorIs(t, err, usecases.ErrCarrierNotFound)
+	})
+
+	t.Run("Should return the carrier locality without coordinates", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		rows := sqlmock.NewRows([]string{"locality_id", "latitude", "longitude"}).AddRow(3, nil, nil)
+		mock.ExpectQuery("FROM carrier c").WithArgs(1).WillReturnRows(rows)
+
+		result, err := sut.GetOrigin(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, domain.Origin{LocalityId: 3}, result)
+	})
+}
+
+func TestRepositoryGetShippedOrders(t *testing.T) {
+	t.Run("Should return the shipped orders with their localities", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		rows := sqlmock.NewRows([]string{"id", "order_number", "tracking_code", "buyer_id", "address", "locality_id", "locality", "province", "latitude", "longitude"}).
+			AddRow(1, "order", "MF000000014BR", 2, "rua 1", 3, "Campinas", "SP", -22.91, -47.06)
+		mock.ExpectQuery("FROM purchase_order po").WithArgs(1, purchase.StatusShipped, "2022-07-01", "2022-07-01").WillReturnRows(rows)
+
+		result, err := sut.GetShippedOrders(1, purchase.StatusShipped, "2022-07-01")
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "Campinas", result[0].LocalityName)
+		assert.Equal(t, -22.91, *result[0].Latitude)
+	})
+
+	t.Run("Should skip orders routed on or after the date and keep orders without an address", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		rows := sqlmock.NewRows([]string{"id", "order_number", "tracking_code", "buyer_id", "address", "locality_id", "locality", "province", "latitude", "longitude"}).
+			AddRow(1, "order", "MF000000014BR", 2, "", nil, "", "", nil, nil)
+		mock.ExpectQuery("LEFT JOIN buyer_address ba (.+) NOT EXISTS \\(SELECT 1 FROM route_manifest_order mo INNER JOIN route_manifest m (.+) m.route_date >= \\?\\)").WithArgs(1, purchase.StatusShipped, "2022-07-01", "2022-07-01").WillReturnRows(rows)
+
+		result, err := sut.GetShippedOrders(1, purchase.StatusShipped, "2022-07-01")
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 1)
+		assert.Nil(t, result[0].LocalityId)
+	})
+
+	t.Run("Should return error if the query fails", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectQuery("FROM purchase_order po").WithArgs(1, purchase.StatusShipped, "2022-07-01", "2022-07-01").WillReturnError(errors.New("any_error"))
+
+		_, err := sut.GetShippedOrders(1, purchase.StatusShipped, "2022-07-01")
+
+		assert.EqualError(t, err, "any_error")
+	})
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	distance, localityId := 83.64, 2
+	manifest := domain.Manifest{
+		CarrierId:       1,
+		RouteDate:       "2022-07-01",
+		TotalDistanceKm: distance,
+		CreatedAt:       "2022-07-01 08:00:00",
+		Stops: []domain.Stop{
+			{Sequence: 1, LocalityId: &localityId, DistanceKm: &distance, Orders: []domain.ManifestOrder{{PurchaseOrderId: 1}, {PurchaseOrderId: 4}}},
+		},
+	}
+
+	t.Run("Should save the manifest, stops and orders in one transaction", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO route_manifest ").WithArgs(1, "2022-07-01", distance, "2022-07-01 08:00:00").WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec("INSERT INTO route_manifest_stop").WithArgs(5, 1, &localityId, &distance).WillReturnResult(sqlmock.NewResult(7, 1))
+		mock.ExpectExec("INSERT INTO route_manifest_order").WithArgs(5, 7, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO route_manifest_order").WithArgs(5, 7, 4).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		result, err := sut.Create(manifest)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 5, result.Id)
+		assert.Equal(t, 7, result.Stops[0].Id)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should rollback if a stop can't be saved", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO route_manifest ").WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec("INSERT INTO route_manifest_stop").WillReturnError(errors.New("any_error"))
+		mock.ExpectRollback()
+
+		_, err := sut.Create(manifest)
+
+		assert.EqualError(t, err, "any_error")
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return ErrOrderAlreadyRouted if an order is repeated on the manifest", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO route_manifest ").WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec("INSERT INTO route_manifest_stop").WillReturnResult(sqlmock.NewResult(7, 1))
+		mock.ExpectExec("INSERT INTO route_manifest_order").WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '5-1' for key 'manifest_purchase_order_UNIQUE'"})
+		mock.ExpectRollback()
+
+		_, err := sut.Create(manifest)
+
+		assert.ErrorIs(t, err, usecases.ErrOrderAlreadyRouted)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryGetById(t *testing.T) {
+	t.Run("Should return ErrManifestNotFound if the carrier has no such manifest", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectQuery("FROM route_manifest WHERE").WithArgs(5, 1).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetById(1, 5)
+
+		assert.ErrorIs(t, err, usecases.ErrManifestNotFound)
+	})
+
+	t.Run("Should group the order rows by stop", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectQuery("FROM route_manifest WHERE").WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "carrier_id", "route_date", "total_distance_km", "created_at"}).AddRow(5, 1, "2022-07-01", 83.64, "2022-07-01 08:00:00"))
+		mock.ExpectQuery("FROM route_manifest_stop s").WithArgs(5).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "sequence", "locality_id", "locality", "province", "distance_km", "po_id", "order_number", "tracking_code", "buyer_id", "address"}).
+				AddRow(7, 1, 2, "Campinas", "SP", 83.64, 1, "order1", "MF000000014BR", 2, "rua 1").
+				AddRow(7, 1, 2, "Campinas", "SP", 83.64, 4, "order4", "MF000000045BR", 3, "rua 4").
+				AddRow(8, 2, 4, "Itabaiana", "SE", nil, 3, "order3", "MF000000031BR", 2, "rua 3"))
+
+		result, err := sut.GetById(1, 5)
+
+		assert.Nil(t, err)
+		assert.Len(t, result.Stops, 2)
+		assert.Len(t, result.Stops[0].Orders, 2)
+		assert.Nil(t, result.Stops[1].DistanceKm)
+		assert.Equal(t, "2022-07-01", result.RouteDate)
+	})
+}
@@ -0,0 +1,47 @@
+package domain
+
+type Origin struct {
+	LocalityId int
+	Latitude   *float64
+	Longitude  *float64
+}
+
+type ShippedOrder struct {
+	PurchaseOrderId int
+	OrderNumber     string
+	TrackingCode    string
+	BuyerId         int
+	Address         string
+	LocalityId      *int
+	LocalityName    string
+	ProvinceName    string
+	Latitude        *float64
+	Longitude       *float64
+}
+
+type ManifestOrder struct {
+	PurchaseOrderId int    `json:"purchase_order_id"`
+	OrderNumber     string `json:"order_number"`
+	TrackingCode    string `json:"tracking_code"`
+	BuyerId         int    `json:"buyer_id"`
+	Address         string `json:"address"`
+}
+
+type Stop struct {
+	Id           int             `json:"id"`
+	Sequence     int             `json:"sequence"`
+	LocalityId   *int            `json:"locality_id"`
+	LocalityName string          `json:"locality_name"`
+	ProvinceName string          `json:"province_name"`
+	DistanceKm   *float64        `json:"distance_km"`
+	Orders       []ManifestOrder `json:"orders"`
+}
+
+type Manifest struct {
+	Id              int     `json:"id"`
+	CarrierId       int     `json:"carrier_id"`
+	RouteDate       string  `json:"route_date"`
+	TotalDistanceKm float64 `json:"total_distance_km"`
+	CreatedAt       string  `json:"created_at"`
+	Stops           []Stop  `json:"stops"`
+}
@@ -0,0 +1,15 @@
+package factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases"
+)
+
+func MakeRoutePlanController() *adapters.RoutePlanController {
+	rr := adapters.CreateRoutePlanMySQLRepository(db.GetInstance())
+	rs := usecases.CreateRoutePlanService(rr)
+	rc := adapters.CreateRoutePlanController(rs)
+
+	return rc
+}
@@ -0,0 +1,11 @@
+package usecases
+
+import "errors"
+
+var ErrCarrierNotFound = errors.New("carrier not found")
+
+var ErrManifestNotFound = errors.New("route manifest not found")
+
+var ErrNoShippedOrders = errors.New("carrier has no shipped orders to deliver")
+
+var ErrOrderAlreadyRouted = errors.New("an order is already on this route manifest")
@@ -0,0 +1,10 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/domain"
+
+type RoutePlanRepository interface {
+	GetOrigin(carrierId int) (domain.Origin, error)
+	GetShippedOrders(carrierId int, orderStatusId int, routeDate string) ([]domain.ShippedOrder, error)
+	Create(manifest domain.Manifest) (domain.Manifest, error)
+	GetById(carrierId int, id int) (domain.Manifest, error)
+}
@@ -0,0 +1,194 @@
+package usecases
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/domain"
+)
+
+const earthRadiusKm = 6371.0
+
+type RoutePlanService interface {
+	Plan(carrierId int, routeDate string) (domain.Manifest, error)
+	GetById(carrierId int, id int) (domain.Manifest, error)
+}
+
+type routePlanService struct {
+	routePlanRepository RoutePlanRepository
+}
+
+func CreateRoutePlanService(r RoutePlanRepository) RoutePlanService {
+	return &routePlanService{
+		routePlanRepository: r,
+	}
+}
+
+type point struct {
+	latitude  float64
+	longitude float64
+}
+
+type plannedStop struct {
+	stop     domain.Stop
+	location *point
+}
+
+// Plan groups the carrier's shipped orders by locality and orders the stops
+// with a nearest-neighbour walk starting at the carrier's locality. Stops
+// whose locality has no coordinates go last, by province and locality name,
+// followed by the orders that lost their delivery address.
+func (s *routePlanService) Plan(carrierId int, routeDate string) (domain.Manifest, error) {
+	origin, err := s.routePlanRepository.GetOrigin(carrierId)
+
+	if err != nil {
+		return domain.Manifest{}, err
+	}
+
+	orders, err := s.routePlanRepository.GetShippedOrders(carrierId, purchase.StatusShipped, routeDate)
+
+	if err != nil {
+		return domain.Manifest{}, err
+	}
+
+	if len(orders) == 0 {
+		return domain.Manifest{}, ErrNoShippedOrders
+	}
+
+	located, unlocated := groupStops(orders)
+
+	manifest := domain.Manifest{
+		CarrierId: carrierId,
+		RouteDate: routeDate,
+		CreatedAt: time.Now().Format("2006-01-02 15:04:05"),
+		Stops:     []domain.Stop{},
+	}
+
+	current := pointOf(origin.Latitude, origin.Longitude)
+	hasOrigin := current != nil
+
+	if !hasOrigin && len(located) > 0 {
+		sortByName(located)
+		current = located[0].location
+	}
+
+	for len(located) > 0 {
+		next := 0
+
+		for i := range located {
+			if haversineKm(*current, *located[i].location) < haversineKm(*current, *located[next].location) {
+				next = i
+			}
+		}
+
+		stop := located[next].stop
+
+		if hasOrigin || len(manifest.Stops) > 0 {
+			distance := round(haversineKm(*current, *located[next].location))
+			stop.DistanceKm = &distance
+			manifest.TotalDistanceKm = round(manifest.TotalDistanceKm + distance)
+		}
+
+		stop.Sequence = len(manifest.Stops) + 1
+		manifest.Stops = append(manifest.Stops, stop)
+		current = located[next].location
+		located = append(located[:next], located[next+1:]...)
+	}
+
+	sortByName(unlocated)
+
+	for _, ps := range unlocated {
+		ps.stop.Sequence = len(manifest.Stops) + 1
+		manifest.Stops = append(manifest.Stops, ps.stop)
+	}
+
+	return s.routePlanRepository.Create(manifest)
+}
+
+func (s *routePlanService) GetById(carrierId int, id int) (domain.Manifest, error) {
+	return s.routePlanRepository.GetById(carrierId, id)
+}
+
+func groupStops(orders []domain.ShippedOrder) (located []plannedStop, unlocated []plannedStop) {
+	var stops []plannedStop
+	index := map[int]int{}
+
+	for _, o := range orders {
+		key := 0
+
+		if o.LocalityId != nil {
+			key = *o.LocalityId
+		}
+
+		i, ok := index[key]
+
+		if !ok {
+			i = len(stops)
+			index[key] = i
+			stops = append(stops, plannedStop{
+				stop: domain.Stop{
+					LocalityId:   o.LocalityId,
+					LocalityName: o.LocalityName,
+					ProvinceName: o.ProvinceName,
+				},
+				location: pointOf(o.Latitude, o.Longitude),
+			})
+		}
+
+		stops[i].stop.Orders = append(stops[i].stop.Orders, domain.ManifestOrder{
+			PurchaseOrderId: o.PurchaseOrderId,
+			OrderNumber:     o.OrderNumber,
+			TrackingCode:    o.TrackingCode,
+			BuyerId:         o.BuyerId,
+			Address:         o.Address,
+		})
+	}
+
+	for _, ps := range stops {
+		if ps.location != nil {
+			located = append(located, ps)
+		} else {
+			unlocated = append(unlocated, ps)
+		}
+	}
+
+	return located, unlocated
+}
+
+func sortByName(stops []plannedStop) {
+	sort.SliceStable(stops, func(i, j int) bool {
+		if (stops[i].stop.LocalityId == nil) != (stops[j].stop.LocalityId == nil) {
+			return stops[j].stop.LocalityId == nil
+		}
+		if stops[i].stop.ProvinceName != stops[j].stop.ProvinceName {
+			return stops[i].stop.ProvinceName < stops[j].stop.ProvinceName
+		}
+		return stops[i].stop.LocalityName < stops[j].stop.LocalityName
+	})
+}
+
+func pointOf(latitude *float64, longitude *float64) *point {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+
+	return &point{latitude: *latitude, longitude: *longitude}
+}
+
+func haversineKm(a point, b point) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(b.latitude - a.latitude)
+	dLng := toRad(b.longitude - a.longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.latitude))*math.Cos(toRad(b.latitude))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+func round(km float64) float64 {
+	return math.Round(km*100) / 100
+}
@@ -0,0 +1,158 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/route_plans/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func coordinate(f float64) *float64 {
+	return &f
+}
+
+func locality(id int) *int {
+	return &id
+}
+
+func makeShippedOrder(id int, localityId *int, localityName string, provinceName string, latitude *float64, longitude *float64) domain.ShippedOrder {
+	return domain.ShippedOrder{
+		PurchaseOrderId: id,
+		OrderNumber:     "order",
+		TrackingCode:    "MF000000014BR",
+		BuyerId:         1,
+		Address:         "rua 1",
+		LocalityId:      localityId,
+		LocalityName:    localityName,
+		ProvinceName:    provinceName,
+		Latitude:        latitude,
+		Longitude:       longitude,
+	}
+}
+
+func returnManifest(m domain.Manifest) domain.Manifest {
+	return m
+}
+
+func TestPlan(t *testing.T) {
+	makeSut := func() (usecases.RoutePlanService, *mocks.RoutePlanRepository) {
+		mockRoutePlanRepository := mocks.NewRoutePlanRepository(t)
+		sut := usecases.CreateRoutePlanService(mockRoutePlanRepository)
+		return sut, mockRoutePlanRepository
+	}
+
+	saoPaulo := domain.Origin{LocalityId: 1, Latitude: coordinate(-23.55), Longitude: coordinate(-46.63)}
+
+	t.Run("Should return ErrCarrierNotFound if the carrier doesn't exist", func(t *testing.T) {
+		sut, mockRoutePlanRepository := makeSut()
+		mockRoutePlanRepository.On("GetOrigin", 1).Return(domain.Origin{}, usecases.ErrCarrierNotFound).Once()
+
+		_, err := sut.Plan(1, "2022-07-01")
+
+		assert.ErrorIs(t, err, usecases.ErrCarrierNotFound)
+	})
+
+	t.Run("Should return ErrNoShippedOrders if the carrier has nothing to deliver", func(t *testing.T) {
+		sut, mockRoutePlanRepository := makeSut()
+		mockRoutePlanRepository.On("GetOrigin", 1).Return(saoPaulo, nil).Once()
+		mockRoutePlanRepository.On("GetShippedOrders", 1, purchase.StatusShipped, "2022-07-01").Return([]domain.ShippedOrder{}, nil).Once()
+
+		_, err := sut.Plan(1, "2022-07-01")
+
+		assert.ErrorIs(t, err, usecases.ErrNoShippedOrders)
+	})
+
+	t.Run("Should return error if GetShippedOrders fails", func(t *testing.T) {
+		sut, mockRoutePlanRepository := makeSut()
+		mockRoutePlanRepository.On("GetOrigin", 1).Return(saoPaulo, nil).Once()
+		mockRoutePlanRepository.On("GetShippedOrders", 1, purchase.StatusShipped, "2022-07-01").Return(nil, errors.New("any_error")).Once()
+
+		_, err := sut.Plan(1, "2022-07-01")
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should group orders by locality and visit the nearest stop first", func(t *testing.T) {
+		sut, mockRoutePlanRepository := makeSut()
+		mockRoutePlanRepository.On("GetOrigin", 1).Return(saoPaulo, nil).Once()
+		mockRoutePlanRepository.On("GetShippedOrders", 1, purchase.StatusShipped, "2022-07-01").Return([]domain.ShippedOrder{
+			makeShippedOrder(1, locality(3), "Rio de Janeiro", "RJ", coordinate(-22.91), coordinate(-43.17)),
+			makeShippedOrder(2, locality(2), "Campinas", "SP", coordinate(-22.91), coordinate(-47.06)),
+			makeShippedOrder(3, locality(4), "Itabaiana", "SE", nil, nil),
+			makeShippedOrder(4, locality(3), "Rio de Janeiro", "RJ", coordinate(-22.91), coordinate(-43.17)),
+			makeShippedOrder(5, locality(5), "Aracaju", "SE", nil, nil),
+		}, nil).Once()
+		mockRoutePlanRepository.On("Create", mock.AnythingOfType("domain.Manifest")).Return(returnManifest, nil).Once()
+
+		result, err := sut.Plan(1, "2022-07-01")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "2022-07-01", result.RouteDate)
+		assert.NotEmpty(t, result.CreatedAt)
+		assert.Len(t, result.Stops, 4)
+		assert.Equal(t, []int{2, 3, 5, 4}, []int{*result.Stops[0].LocalityId, *result.Stops[1].LocalityId, *result.Stops[2].LocalityId, *result.Stops[3].LocalityId})
+		assert.Equal(t, []int{1, 2, 3, 4}, []int{result.Stops[0].Sequence, result.Stops[1].Sequence, result.Stops[2].Sequence, result.Stops[3].Sequence})
+		assert.Len(t, result.Stops[1].Orders, 2)
+		assert.InDelta(t, 83.64, *result.Stops[0].DistanceKm, 0.01)
+		assert.InDelta(t, 400.0, *result.Stops[1].DistanceKm, 10)
+		assert.Nil(t, result.Stops[2].DistanceKm)
+		assert.Nil(t, result.Stops[3].DistanceKm)
+		assert.Equal(t, *result.Stops[0].DistanceKm+*result.Stops[1].DistanceKm, result.TotalDistanceKm)
+	})
+
+	t.Run("Should start at the first stop by name if the carrier locality has no coordinates", func(t *testing.T) {
+		sut, mockRoutePlanRepository := makeSut()
+		mockRoutePlanRepository.On("GetOrigin", 1).Return(domain.Origin{LocalityId: 1}, nil).Once()
+		mockRoutePlanRepository.On("GetShippedOrders", 1, purchase.StatusShipped, "2022-07-01").Return([]domain.ShippedOrder{
+			makeShippedOrder(1, locality(3), "Rio de Janeiro", "RJ", coordinate(-22.91), coordinate(-43.17)),
+			makeShippedOrder(2, locality(2), "Campinas", "SP", coordinate(-22.91), coordinate(-47.06)),
+		}, nil).Once()
+		mockRoutePlanRepository.On("Create", mock.AnythingOfType("domain.Manifest")).Return(returnManifest, nil).Once()
+
+		result, err := sut.Plan(1, "2022-07-01")
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, *result.Stops[0].LocalityId)
+		assert.Nil(t, result.Stops[0].DistanceKm)
+		assert.NotNil(t, result.Stops[1].DistanceKm)
+		assert.Equal(t, *result.Stops[1].DistanceKm, result.TotalDistanceKm)
+	})
+
+	t.Run("Should put orders without a delivery address on a last stop without a location", func(t *testing.T) {
+		sut, mockRoutePlanRepository := makeSut()
+		mockRoutePlanRepository.On("GetOrigin", 1).Return(saoPaulo, nil).Once()
+		mockRoutePlanRepository.On("GetShippedOrders", 1, purchase.StatusShipped, "2022-07-01").Return([]domain.ShippedOrder{
+			makeShippedOrder(1, nil, "", "", nil, nil),
+			makeShippedOrder(2, locality(4), "Itabaiana", "SE", nil, nil),
+			makeShippedOrder(3, locality(2), "Campinas", "SP", coordinate(-22.91), coordinate(-47.06)),
+		}, nil).Once()
+		mockRoutePlanRepository.On("Create", mock.AnythingOfType("domain.Manifest")).Return(returnManifest, nil).Once()
+
+		result, err := sut.Plan(1, "2022-07-01")
+
+		assert.Nil(t, err)
+		assert.Len(t, result.Stops, 3)
+		assert.Equal(t, 2, *result.Stops[0].LocalityId)
+		assert.Equal(t, 4, *result.Stops[1].LocalityId)
+		assert.Nil(t, result.Stops[2].LocalityId)
+		assert.Equal(t, 1, result.Stops[2].Orders[0].PurchaseOrderId)
+	})
+}
+
+func TestGetById(t *testing.T) {
+	t.Run("Should return the manifest from the repository", func(t *testing.T) {
+		mockRoutePlanRepository := mocks.NewRoutePlanRepository(t)
+		sut := usecases.CreateRoutePlanService(mockRoutePlanRepository)
+		mockRoutePlanRepository.On("GetById", 1, 2).Return(domain.Manifest{Id: 2, CarrierId: 1}, nil).Once()
+
+		result, err := sut.GetById(1, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result.Id)
+	})
+}
@@ -135,6 +135,20 @@ func (_m *Repository) LastID(ctx context.Context) (int, error) {
 	return r0, r1
 }
 
+// SetQuarantine provides a mock function with given fields: ctx, id, quarantine
+func (_m *Repository) SetQuarantine(ctx context.Context, id int, quarantine bool) error {
+	ret := _m.Called(ctx, id, quarantine)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) error); ok {
+		r0 = rf(ctx, id, quarantine)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateById provides a mock function with given fields: ctx, id, section
 func (_m *Repository) UpdateById(ctx context.Context, id int, section sections.Section) (sections.Section, error) {
 	ret := _m.Called(ctx, id, section)
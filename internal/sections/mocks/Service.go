@@ -135,6 +135,27 @@ func (_m *Service) LastID(ctx context.Context) (int, error) {
 	return r0, r1
 }
 
+// SetQuarantine provides a mock function with given fields: ctx, id, quarantine
+func (_m *Service) SetQuarantine(ctx context.Context, id int, quarantine bool) (sections.Section, error) {
+	ret := _m.Called(ctx, id, quarantine)
+
+	var r0 sections.Section
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) sections.Section); ok {
+		r0 = rf(ctx, id, quarantine)
+	} else {
+		r0 = ret.Get(0).(sections.Section)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, bool) error); ok {
+		r1 = rf(ctx, id, quarantine)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateById provides a mock function with given fields: ctx, id, section
 func (_m *Service) UpdateById(ctx context.Context, id int, section sections.Section) (sections.Section, error) {
 	ret := _m.Called(ctx, id, section)
@@ -10,4 +10,5 @@ type Section struct {
 	MaximumCapacity    int     `json:"maximum_capacity"`
 	WarehouseID        int     `json:"warehouse_id"`
 	ProductTypeID      int     `json:"product_type_id"`
+	Quarantine         bool    `json:"quarantine"`
 }
@@ -10,4 +10,5 @@ type Repository interface {
 	Add(ctx context.Context, id int, sectionNumber int, currentTemperature float32, minimumTemprarature float32, currentCapacity int, minimumCapacity int, maximumCapacity int, warehouseID int, productTypeID int) (Section, error)
 	UpdateById(ctx context.Context, id int, section Section) (Section, error)
 	Delete(ctx context.Context, id int) error
+	SetQuarantine(ctx context.Context, id int, quarantine bool) error
 }
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log"
 	"reflect"
 
@@ -22,7 +23,7 @@ func NewMySQLRepository(db *sql.DB) sections.Repository {
 func (m mySQLRepository) GetAll(ctx context.Context) ([]sections.Section, error) {
 	sects := []sections.Section{}
 
-	rows, err := m.db.QueryContext(ctx, "SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section")
+	rows, err := m.db.QueryContext(ctx, "SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section")
 	if err != nil {
 		return sects, err
 	}
@@ -32,7 +33,7 @@ func (m mySQLRepository) GetAll(ctx context.Context) ([]sections.Section, error)
 	for rows.Next() {
 		var sect sections.Section
 
-		err := rows.Scan(&sect.ID, &sect.SectionNumber, &sect.CurrentTemperature, &sect.MinimumTemperature, &sect.CurrentCapacity, &sect.MinimumCapacity, &sect.MaximumCapacity, &sect.WarehouseID, &sect.ProductTypeID)
+		err := rows.Scan(&sect.ID, &sect.SectionNumber, &sect.CurrentTemperature, &sect.MinimumTemperature, &sect.CurrentCapacity, &sect.MinimumCapacity, &sect.MaximumCapacity, &sect.WarehouseID, &sect.ProductTypeID, &sect.Quarantine)
 		if err != nil {
 			return sects, err
 		}
@@ -48,11 +49,14 @@ func (m mySQLRepository) GetById(ctx context.Context, id int) (sections.Section,
 
 	row := m.db.QueryRowContext(
 		ctx,
-		"SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section WHERE id=?",
+		"SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section WHERE id=?",
 		id,
 	)
 
-	err := row.Scan(&sect.ID, &sect.SectionNumber, &sect.CurrentTemperature, &sect.MinimumTemperature, &sect.CurrentCapacity, &sect.MinimumCapacity, &sect.MaximumCapacity, &sect.WarehouseID, &sect.ProductTypeID)
+	err := row.Scan(&sect.ID, &sect.SectionNumber, &sect.CurrentTemperature, &sect.MinimumTemperature, &sect.CurrentCapacity, &sect.MinimumCapacity, &sect.MaximumCapacity, &sect.WarehouseID, &sect.ProductTypeID, &sect.Quarantine)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sections.Section{}, sections.ErrSectionNotFound
+	}
 	if err != nil {
 		return sections.Section{}, err
 	}
@@ -135,6 +139,9 @@ func (m mySQLRepository) UpdateById(ctx context.Context, id int, section section
 		return sections.Section{}, err
 	}
 
+	// quarantine only changes through SetQuarantine, which also updates the batches
+	section.Quarantine = sect.Quarantine
+
 	sectTypes := reflect.TypeOf(sect)
 
 	sectionValues := reflect.ValueOf(&section).Elem()
@@ -185,3 +192,30 @@ func (m mySQLRepository) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+func (m mySQLRepository) SetQuarantine(ctx context.Context, id int, quarantine bool) error {
+	from, to := "quarantined", "available"
+	if quarantine {
+		from, to = to, from
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE section SET quarantine=? WHERE id=?", quarantine, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// batches on hold or expired keep their status
+	_, err = tx.ExecContext(ctx, "UPDATE product_batch SET status=? WHERE section_id=? AND status=?", to, id, from)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -2,6 +2,7 @@ package mysql_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sections"
@@ -32,24 +33,24 @@ func TestGetAll(t *testing.T) {
 	defer db.Close()
 	repo := mysql.NewMySQLRepository(db)
 
-	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id"}
+	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id", "quarantine"}
 
 	t.Run("get_all_ok", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section").
-			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1).AddRow(2, 2, 1, 1, 5, 2, 10, 1, 1))
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1, false).AddRow(2, 2, 1, 1, 5, 2, 10, 1, 1, false))
 
 		sects, err := repo.GetAll(context.Background())
 
 		assert.Nil(t, err)
-		assert.Equal(t, []sections.Section{{1, 1, 1, 1, 2, 1, 4, 1, 1}, {2, 2, 1, 1, 5, 2, 10, 1, 1}}, sects)
+		assert.Equal(t, []sections.Section{{1, 1, 1, 1, 2, 1, 4, 1, 1, false}, {2, 2, 1, 1, 5, 2, 10, 1, 1, false}}, sects)
 		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
 	})
 
 	t.Run("get_all_fail", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section").
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section").
 			WillReturnError(errors.New("error"))
 
 		_, err := repo.GetAll(context.Background())
@@ -67,23 +68,34 @@ func TestGetById(t *testing.T) {
 	defer db.Close()
 	repo := mysql.NewMySQLRepository(db)
 
-	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id"}
+	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id", "quarantine"}
 
 	t.Run("get_by_id_ok", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section WHERE id=?").
-			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1))
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section WHERE id=?").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1, false))
 
 		sect, err := repo.GetById(context.Background(), 1)
 		assert.Nil(t, err)
-		assert.Equal(t, sections.Section{1, 1, 1, 1, 2, 1, 4, 1, 1}, sect)
+		assert.Equal(t, sections.Section{1, 1, 1, 1, 2, 1, 4, 1, 1, false}, sect)
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("get_by_id_not_found", func(t *testing.T) {
+		mock.
+			ExpectQuery("SELECT (.+) FROM section WHERE id=?").
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetById(context.Background(), 9)
+		assert.ErrorIs(t, err, sections.ErrSectionNotFound)
 		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
 	})
 
 	t.Run("get_by_id_fail", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section WHERE id=?").
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section WHERE id=?").
 			WillReturnError(errors.New("error"))
 
 		_, err := repo.GetById(context.Background(), 1)
@@ -179,7 +191,7 @@ func TestAdd(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		sect, err := repo.Add(context.Background(), 1, 1, 1.0, 1.0, 2, 1, 4, 1, 1)
-		assert.Equal(t, sections.Section{1, 1, 1, 1, 2, 1, 4, 1, 1}, sect)
+		assert.Equal(t, sections.Section{1, 1, 1, 1, 2, 1, 4, 1, 1, false}, sect)
 		assert.Nil(t, err)
 		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
@@ -206,20 +218,20 @@ func TestUpdateById(t *testing.T) {
 	defer db.Close()
 	repo := mysql.NewMySQLRepository(db)
 
-	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id"}
+	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id", "quarantine"}
 
 	t.Run("update_by_id_ok", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section WHERE id=?").
-			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1))
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section WHERE id=?").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1, false))
 
 		mock.
 			ExpectExec("UPDATE section").
 			WithArgs(1, 1.0, 1.0, 5, 1, 10, 1, 1, 1).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		sect, err := repo.UpdateById(context.Background(), 1, sections.Section{0, 0, 0.0, 0.0, 5, 1, 10, 0, 0})
-		assert.Equal(t, sections.Section{1, 1, 1.0, 1.0, 5, 1, 10, 1, 1}, sect)
+		sect, err := repo.UpdateById(context.Background(), 1, sections.Section{0, 0, 0.0, 0.0, 5, 1, 10, 0, 0, false})
+		assert.Equal(t, sections.Section{1, 1, 1.0, 1.0, 5, 1, 10, 1, 1, false}, sect)
 		assert.Nil(t, err)
 		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
@@ -227,10 +239,10 @@ func TestUpdateById(t *testing.T) {
 
 	t.Run("update_by_id_fail", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section WHERE id=?").
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section WHERE id=?").
 			WillReturnError(errors.New("error"))
 
-		_, err := repo.UpdateById(context.Background(), 1, sections.Section{0, 0, 0.0, 0.0, 5, 1, 10, 0, 0})
+		_, err := repo.UpdateById(context.Background(), 1, sections.Section{0, 0, 0.0, 0.0, 5, 1, 10, 0, 0, false})
 		assert.Error(t, err)
 		err = mock.ExpectationsWereMet()
 		assert.Nil(t, err)
@@ -245,12 +257,12 @@ func TestDelete(t *testing.T) {
 	defer db.Close()
 	repo := mysql.NewMySQLRepository(db)
 
-	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id"}
+	columns := []string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id", "quarantine"}
 
 	t.Run("delete_ok", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section WHERE id=?").
-			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1))
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section WHERE id=?").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1, false))
 
 		mock.
 			ExpectExec("DELETE FROM section WHERE id=?").
@@ -265,8 +277,8 @@ func TestDelete(t *testing.T) {
 
 	t.Run("delete_fail", func(t *testing.T) {
 		mock.
-			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM section WHERE id=?").
-			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1))
+			ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id, quarantine FROM section WHERE id=?").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, 1, 1, 2, 1, 4, 1, 1, false))
 
 		err := repo.Delete(context.Background(), 1)
 		assert.Error(t, err)
@@ -274,3 +286,61 @@ func TestDelete(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestSetQuarantine(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+	repo := mysql.NewMySQLRepository(db)
+
+	t.Run("set_quarantine_ok", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectExec("UPDATE section SET quarantine=\\? WHERE id=\\?").
+			WithArgs(true, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.
+			ExpectExec("UPDATE product_batch SET status=\\? WHERE section_id=\\? AND status=\\?").
+			WithArgs("quarantined", 1, "available").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.SetQuarantine(context.Background(), 1, true)
+		assert.Nil(t, err)
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("release_quarantine_ok", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectExec("UPDATE section SET quarantine=\\? WHERE id=\\?").
+			WithArgs(false, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.
+			ExpectExec("UPDATE product_batch SET status=\\? WHERE section_id=\\? AND status=\\?").
+			WithArgs("available", 1, "quarantined").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.SetQuarantine(context.Background(), 1, false)
+		assert.Nil(t, err)
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("set_quarantine_fail", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.
+			ExpectExec("UPDATE section SET quarantine=\\? WHERE id=\\?").
+			WillReturnError(errors.New("error"))
+		mock.ExpectRollback()
+
+		err := repo.SetQuarantine(context.Background(), 1, true)
+		assert.Error(t, err)
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
@@ -7,6 +7,8 @@ import (
 
 var ss []Section = []Section{}
 
+var ErrSectionNotFound = errors.New("inexistent section")
+
 type Service interface {
 	GetAll(ctx context.Context) ([]Section, error)
 	GetById(ctx context.Context, id int) (Section, error)
@@ -15,6 +17,7 @@ type Service interface {
 	Add(ctx context.Context, sectionNumber int, currentTemperature float32, minimumTemprarature float32, currentCapacity int, minimumCapacity int, maximumCapacity int, warehouseID int, productTypeID int) (Section, error)
 	UpdateById(ctx context.Context, id int, section Section) (Section, error)
 	Delete(ctx context.Context, id int) error
+	SetQuarantine(ctx context.Context, id int, quarantine bool) (Section, error)
 }
 
 type service struct {
@@ -108,3 +111,18 @@ func (s *service) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+func (s *service) SetQuarantine(ctx context.Context, id int, quarantine bool) (Section, error) {
+	sect, err := s.repository.GetById(ctx, id)
+	if err != nil {
+		return Section{}, err
+	}
+
+	err = s.repository.SetQuarantine(ctx, id, quarantine)
+	if err != nil {
+		return Section{}, err
+	}
+
+	sect.Quarantine = quarantine
+	return sect, nil
+}
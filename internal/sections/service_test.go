@@ -175,7 +175,7 @@ func TestUpdateById(t *testing.T) {
 			Return(createSectionFromParams(createSectionParamsUpdated()), nil).
 			Once()
 
-		sect, _ := serv.UpdateById(ctx, 1, sections.Section{1, 1, 3.0, 1.0, 3, 1, 4, 1, 1})
+		sect, _ := serv.UpdateById(ctx, 1, sections.Section{1, 1, 3.0, 1.0, 3, 1, 4, 1, 1, false})
 
 		assert.Equal(t, createSectionFromParams(createSectionParamsUpdated()), sect)
 	})
@@ -189,7 +189,7 @@ func TestUpdateById(t *testing.T) {
 			On("UpdateById", mock.Anything, mock.AnythingOfType("int"), mock.AnythingOfType("sections.Section")).
 			Return(sections.Section{}, errors.New("inexistent section"))
 
-		_, err := serv.UpdateById(ctx, 3, sections.Section{1, 1, 3.0, 1.0, 3, 1, 4, 1, 1})
+		_, err := serv.UpdateById(ctx, 3, sections.Section{1, 1, 3.0, 1.0, 3, 1, 4, 1, 1, false})
 
 		assert.EqualError(t, err, "inexistent section")
 	})
@@ -222,3 +222,72 @@ func TestDelete(t *testing.T) {
 		assert.Equal(t, nil, err)
 	})
 }
+
+func TestSetQuarantine(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("set_quarantine_ok", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := sections.NewService(repo)
+
+		repo.
+			On("GetById", mock.Anything, 1).
+			Return(createSectionFromParams(createSectionParams()), nil).
+			Once()
+		repo.
+			On("SetQuarantine", mock.Anything, 1, true).
+			Return(nil).
+			Once()
+
+		sect, err := serv.SetQuarantine(ctx, 1, true)
+
+		assert.Nil(t, err)
+		assert.True(t, sect.Quarantine)
+	})
+
+	t.Run("set_quarantine_inexistent", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := sections.NewService(repo)
+
+		repo.
+			On("GetById", mock.Anything, 9).
+			Return(sections.Section{}, sections.ErrSectionNotFound).
+			Once()
+
+		_, err := serv.SetQuarantine(ctx, 9, true)
+
+		assert.ErrorIs(t, err, sections.ErrSectionNotFound)
+	})
+
+	t.Run("set_quarantine_get_by_id_fail", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := sections.NewService(repo)
+
+		repo.
+			On("GetById", mock.Anything, 1).
+			Return(sections.Section{}, errors.New("db error")).
+			Once()
+
+		_, err := serv.SetQuarantine(ctx, 1, true)
+
+		assert.EqualError(t, err, "db error")
+	})
+
+	t.Run("set_quarantine_fail", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		serv := sections.NewService(repo)
+
+		repo.
+			On("GetById", mock.Anything, 1).
+			Return(createSectionFromParams(createSectionParams()), nil).
+			Once()
+		repo.
+			On("SetQuarantine", mock.Anything, 1, false).
+			Return(errors.New("db error")).
+			Once()
+
+		_, err := serv.SetQuarantine(ctx, 1, false)
+
+		assert.EqualError(t, err, "db error")
+	})
+}
@@ -26,13 +26,13 @@ func (_m *NRepository) Delete(id int) error {
 	return r0
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *NRepository) GetAll() ([]domain.Seller, error) {
-	ret := _m.Called()
+// GetAll provides a mock function with given fields: includeDeleted
+func (_m *NRepository) GetAll(includeDeleted bool) ([]domain.Seller, error) {
+	ret := _m.Called(includeDeleted)
 
 	var r0 []domain.Seller
-	if rf, ok := ret.Get(0).(func() []domain.Seller); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(bool) []domain.Seller); ok {
+		r0 = rf(includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Seller)
@@ -40,8 +40,29 @@ func (_m *NRepository) GetAll() ([]domain.Seller, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCid provides a mock function with given fields: cid
+func (_m *NRepository) GetByCid(cid string) (domain.Seller, error) {
+	ret := _m.Called(cid)
+
+	var r0 domain.Seller
+	if rf, ok := ret.Get(0).(func(string) domain.Seller); ok {
+		r0 = rf(cid)
+	} else {
+		r0 = ret.Get(0).(domain.Seller)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(cid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,19 +91,144 @@ func (_m *NRepository) GetById(id int) (domain.Seller, error) {
 	return r0, r1
 }
 
+// GetProducts provides a mock function with given fields: sellerId, date
+func (_m *NRepository) GetProducts(sellerId int, date string) ([]domain.SellerProduct, error) {
+	ret := _m.Called(sellerId, date)
+
+	var r0 []domain.SellerProduct
+	if rf, ok := ret.Get(0).(func(int, string) []domain.SellerProduct); ok {
+		r0 = rf(sellerId, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SellerProduct)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(sellerId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSaleLines provides a mock function with given fields: sellerId, from, to
+func (_m *NRepository) GetSaleLines(sellerId int, from string, to string) ([]domain.SaleLine, error) {
+	ret := _m.Called(sellerId, from, to)
+
+	var r0 []domain.SaleLine
+	if rf, ok := ret.Get(0).(func(int, string, string) []domain.SaleLine); ok {
+		r0 = rf(sellerId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SaleLine)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, string, string) error); ok {
+		r1 = rf(sellerId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStock provides a mock function with given fields: sellerId
+func (_m *NRepository) GetStock(sellerId int) ([]domain.ProductStock, error) {
+	ret := _m.Called(sellerId)
+
+	var r0 []domain.ProductStock
+	if rf, ok := ret.Get(0).(func(int) []domain.ProductStock); ok {
+		r0 = rf(sellerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductStock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(sellerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasProducts provides a mock function with given fields: id
+func (_m *NRepository) HasProducts(id int) (bool, error) {
+	ret := _m.Called(id)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: id
+func (_m *NRepository) Restore(id int) (domain.Seller, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Seller
+	if rf, ok := ret.Get(0).(func(int) domain.Seller); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Seller)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDelete provides a mock function with given fields: id
+func (_m *NRepository) SoftDelete(id int) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Store provides a mock function with given fields: cid, companyName, address, telephone, localityId
-func (_m *NRepository) Store(cid int, companyName string, address string, telephone string, localityId int) (domain.Seller, error) {
+func (_m *NRepository) Store(cid string, companyName string, address string, telephone string, localityId int) (domain.Seller, error) {
 	ret := _m.Called(cid, companyName, address, telephone, localityId)
 
 	var r0 domain.Seller
-	if rf, ok := ret.Get(0).(func(int, string, string, string, int) domain.Seller); ok {
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int) domain.Seller); ok {
 		r0 = rf(cid, companyName, address, telephone, localityId)
 	} else {
 		r0 = ret.Get(0).(domain.Seller)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string, string, string, int) error); ok {
+	if rf, ok := ret.Get(1).(func(string, string, string, string, int) error); ok {
 		r1 = rf(cid, companyName, address, telephone, localityId)
 	} else {
 		r1 = ret.Error(1)
@@ -92,18 +238,18 @@ func (_m *NRepository) Store(cid int, companyName string, address string, teleph
 }
 
 // Update provides a mock function with given fields: id, cid, companyName, address, telephone, localityId
-func (_m *NRepository) Update(id int, cid int, companyName string, address string, telephone string, localityId int) (domain.Seller, error) {
+func (_m *NRepository) Update(id int, cid string, companyName string, address string, telephone string, localityId int) (domain.Seller, error) {
 	ret := _m.Called(id, cid, companyName, address, telephone, localityId)
 
 	var r0 domain.Seller
-	if rf, ok := ret.Get(0).(func(int, int, string, string, string, int) domain.Seller); ok {
+	if rf, ok := ret.Get(0).(func(int, string, string, string, string, int) domain.Seller); ok {
 		r0 = rf(id, cid, companyName, address, telephone, localityId)
 	} else {
 		r0 = ret.Get(0).(domain.Seller)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int, string, string, string, int) error); ok {
+	if rf, ok := ret.Get(1).(func(int, string, string, string, string, int) error); ok {
 		r1 = rf(id, cid, companyName, address, telephone, localityId)
 	} else {
 		r1 = ret.Error(1)
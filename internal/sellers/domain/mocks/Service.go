@@ -12,13 +12,13 @@ type Service struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *Service) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: id, hard
+func (_m *Service) Delete(id int, hard bool) error {
+	ret := _m.Called(id, hard)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(int, bool) error); ok {
+		r0 = rf(id, hard)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -26,13 +26,13 @@ func (_m *Service) Delete(id int) error {
 	return r0
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *Service) GetAll() ([]sellers.Seller, error) {
-	ret := _m.Called()
+// GetAll provides a mock function with given fields: includeDeleted
+func (_m *Service) GetAll(includeDeleted bool) ([]sellers.Seller, error) {
+	ret := _m.Called(includeDeleted)
 
 	var r0 []sellers.Seller
-	if rf, ok := ret.Get(0).(func() []sellers.Seller); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(bool) []sellers.Seller); ok {
+		r0 = rf(includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]sellers.Seller)
@@ -40,8 +40,8 @@ func (_m *Service) GetAll() ([]sellers.Seller, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,19 +70,107 @@ func (_m *Service) GetById(id int) (sellers.Seller, error) {
 	return r0, r1
 }
 
-// Store provides a mock function with given fields: cid, companyName, address, telephone
-func (_m *Service) Store(cid int, companyName string, address string, telephone string, localityId int) (sellers.Seller, error) {
+// GetProducts provides a mock function with given fields: id
+func (_m *Service) GetProducts(id int) ([]sellers.SellerProduct, error) {
+	ret := _m.Called(id)
+
+	var r0 []sellers.SellerProduct
+	if rf, ok := ret.Get(0).(func(int) []sellers.SellerProduct); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sellers.SellerProduct)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSalesReport provides a mock function with given fields: id, from, to
+func (_m *Service) GetSalesReport(id int, from string, to string) (sellers.SalesReport, error) {
+	ret := _m.Called(id, from, to)
+
+	var r0 sellers.SalesReport
+	if rf, ok := ret.Get(0).(func(int, string, string) sellers.SalesReport); ok {
+		r0 = rf(id, from, to)
+	} else {
+		r0 = ret.Get(0).(sellers.SalesReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, string, string) error); ok {
+		r1 = rf(id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStock provides a mock function with given fields: id
+func (_m *Service) GetStock(id int) ([]sellers.ProductStock, error) {
+	ret := _m.Called(id)
+
+	var r0 []sellers.ProductStock
+	if rf, ok := ret.Get(0).(func(int) []sellers.ProductStock); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sellers.ProductStock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: id
+func (_m *Service) Restore(id int) (sellers.Seller, error) {
+	ret := _m.Called(id)
+
+	var r0 sellers.Seller
+	if rf, ok := ret.Get(0).(func(int) sellers.Seller); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(sellers.Seller)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: cid, companyName, address, telephone, localityId
+func (_m *Service) Store(cid string, companyName string, address string, telephone string, localityId int) (sellers.Seller, error) {
 	ret := _m.Called(cid, companyName, address, telephone, localityId)
 
 	var r0 sellers.Seller
-	if rf, ok := ret.Get(0).(func(int, string, string, string, int) sellers.Seller); ok {
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int) sellers.Seller); ok {
 		r0 = rf(cid, companyName, address, telephone, localityId)
 	} else {
 		r0 = ret.Get(0).(sellers.Seller)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string, string, string, int) error); ok {
+	if rf, ok := ret.Get(1).(func(string, string, string, string, int) error); ok {
 		r1 = rf(cid, companyName, address, telephone, localityId)
 	} else {
 		r1 = ret.Error(1)
@@ -91,19 +179,19 @@ func (_m *Service) Store(cid int, companyName string, address string, telephone
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, cid, companyName, address, telephone
-func (_m *Service) Update(id int, cid int, companyName string, address string, telephone string, localityId int) (sellers.Seller, error) {
+// Update provides a mock function with given fields: id, cid, companyName, address, telephone, localityId
+func (_m *Service) Update(id int, cid string, companyName string, address string, telephone string, localityId int) (sellers.Seller, error) {
 	ret := _m.Called(id, cid, companyName, address, telephone, localityId)
 
 	var r0 sellers.Seller
-	if rf, ok := ret.Get(0).(func(int, int, string, string, string, int) sellers.Seller); ok {
+	if rf, ok := ret.Get(0).(func(int, string, string, string, string, int) sellers.Seller); ok {
 		r0 = rf(id, cid, companyName, address, telephone, localityId)
 	} else {
 		r0 = ret.Get(0).(sellers.Seller)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, int, string, string, string, int) error); ok {
+	if rf, ok := ret.Get(1).(func(int, string, string, string, string, int) error); ok {
 		r1 = rf(id, cid, companyName, address, telephone, localityId)
 	} else {
 		r1 = ret.Error(1)
@@ -1,12 +1,72 @@
 package domain
 
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
 type Seller struct{
 	Id	int `json:"id"`
-	Cid int `json:"cid"`
+	Cid string `json:"cid"`
 	CompanyName string `json:"company_name"`
 	Address string `json:"address"`
 	Telephone string `json:"telephone"`
 	LocalityId  int    `json:"locality_id"`
+	DeletedAt   *string `json:"deleted_at,omitempty"`
+}
+
+type Sellers []Seller
+
+type SellerProduct struct {
+	Id               int          `json:"id"`
+	ProductCode      string       `json:"product_code"`
+	Description      string       `json:"description"`
+	ProductTypeId    int          `json:"product_type_id"`
+	CurrentSalePrice *money.Money `json:"current_sale_price"`
+}
+
+type SectionStock struct {
+	SectionId   int `json:"section_id"`
+	WarehouseId int `json:"warehouse_id"`
+	Quantity    int `json:"quantity"`
+}
+
+type ProductStock struct {
+	ProductId   int            `json:"product_id"`
+	ProductCode string         `json:"product_code"`
+	Description string         `json:"description"`
+	Quantity    int            `json:"quantity"`
+	Sections    []SectionStock `json:"sections"`
 }
 
-type Sellers []Seller
\ No newline at end of file
+type SaleLine struct {
+	PurchaseOrderId int
+	OrderDate       string
+	ProductId       int
+	Description     string
+	Quantity        int
+	SalePrice       money.Money
+	PurchasePrice   money.Money
+}
+
+type ProductSales struct {
+	ProductId   int         `json:"product_id"`
+	Description string      `json:"description"`
+	UnitsSold   int         `json:"units_sold"`
+	Revenue     money.Money `json:"revenue"`
+}
+
+type MonthSales struct {
+	Month       string      `json:"month"`
+	OrdersCount int         `json:"orders_count"`
+	UnitsSold   int         `json:"units_sold"`
+	Revenue     money.Money `json:"revenue"`
+}
+
+type SalesReport struct {
+	SellerId    int            `json:"seller_id"`
+	UnitsSold   int            `json:"units_sold"`
+	OrdersCount int            `json:"orders_count"`
+	Revenue     money.Money    `json:"revenue"`
+	Cost        money.Money    `json:"cost"`
+	GrossProfit money.Money    `json:"gross_profit"`
+	TopProducts []ProductSales `json:"top_products"`
+	Months      []MonthSales   `json:"months"`
+}
@@ -3,6 +3,7 @@ package newController
 import (
 	controllers "github.com/natpapa17/MercadoFresco-ASociedadeGo/cmd/server/controllers/seller"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	localities "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
 	repository "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/repository/mySql"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/services"
 )
@@ -11,7 +12,8 @@ import (
 func NewSellerController() *controllers.SellerController {
 
 	sellerRepo := repository.CreateMySQLRepository(db.GetInstance())
-	sellerService := services.NewService(sellerRepo)
+	localityRepo := localities.CreateMySQLRepository(db.GetInstance())
+	sellerService := services.NewService(sellerRepo, localityRepo)
 	sellercontroller := controllers.NewSeller(sellerService)
 
 	return sellercontroller
@@ -2,23 +2,38 @@ package repository
 
 import (
 	"database/sql"
-
+	"errors"
 	"fmt"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/document"
 )
 
+var ErrNoElementFound = errors.New("can't find element")
+
+var ErrDuplicateCid = errors.New("cid already exists")
+
+var ErrSellerReferenced = errors.New("seller is still referenced")
+
 type mySqlRepository struct {
 	db *sql.DB
 }
 
 type NRepository interface{
-	GetAll() ([]domain.Seller, error)
+	GetAll(includeDeleted bool) ([]domain.Seller, error)
 	GetById(id int) (domain.Seller, error)
-	Store( cid int, companyName string, address string , telephone string , localityId int) (domain.Seller , error)
+	GetByCid(cid string) (domain.Seller, error)
+	Store( cid string, companyName string, address string , telephone string , localityId int) (domain.Seller , error)
 	
-	Update(id , cid int, companyName, address, telephone string, localityId int) (domain.Seller, error)
+	Update(id int, cid, companyName, address, telephone string, localityId int) (domain.Seller, error)
 	Delete(id int) error
+	SoftDelete(id int) error
+	Restore(id int) (domain.Seller, error)
+	HasProducts(id int) (bool, error)
+	GetProducts(sellerId int, date string) ([]domain.SellerProduct, error)
+	GetStock(sellerId int) ([]domain.ProductStock, error)
+	GetSaleLines(sellerId int, from, to string) ([]domain.SaleLine, error)
 }
 
 func (r *mySqlRepository) Delete(id int) error {
@@ -26,6 +41,10 @@ func (r *mySqlRepository) Delete(id int) error {
 
 	res, err := r.db.Exec(query, id)
 
+	if isMySQLError(err, 1451) {
+		return ErrSellerReferenced
+	}
+
 	if err != nil {
 		return err
 	}
@@ -37,14 +56,74 @@ func (r *mySqlRepository) Delete(id int) error {
 	}
 
 	if rows == 0 {
-		return fmt.Errorf("seller with %d not found", id)
+		return ErrNoElementFound
 	}
 
 	return nil
 }
 
-func (r *mySqlRepository) GetAll() ([]domain.Seller, error) {
-	const query = `SELECT * FROM seller`
+func (r *mySqlRepository) SoftDelete(id int) error {
+	const query = `UPDATE seller SET deleted_at=NOW() WHERE id=? AND deleted_at IS NULL`
+
+	res, err := r.db.Exec(query, id)
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrNoElementFound
+	}
+
+	return nil
+}
+
+func (r *mySqlRepository) Restore(id int) (domain.Seller, error) {
+	const query = `UPDATE seller SET deleted_at=NULL WHERE id=? AND deleted_at IS NOT NULL`
+
+	res, err := r.db.Exec(query, id)
+
+	if err != nil {
+		return domain.Seller{}, err
+	}
+
+	rows, err := res.RowsAffected()
+
+	if err != nil {
+		return domain.Seller{}, err
+	}
+
+	if rows == 0 {
+		return domain.Seller{}, ErrNoElementFound
+	}
+
+	return r.GetById(id)
+}
+
+func (r *mySqlRepository) HasProducts(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM product WHERE seller_id=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *mySqlRepository) GetAll(includeDeleted bool) ([]domain.Seller, error) {
+	query := `SELECT id, cid, company_name, address, telephone, locality_id, deleted_at FROM seller`
+
+	if !includeDeleted {
+		query += ` WHERE deleted_at IS NULL`
+	}
 
 	rows, err := r.db.Query(query)
 
@@ -58,7 +137,7 @@ func (r *mySqlRepository) GetAll() ([]domain.Seller, error) {
 
 	for rows.Next() {
 		s := domain.Seller{}
-		rows.Scan(&s.Id, &s.Cid, &s.CompanyName, &s.Address, &s.Telephone, &s.LocalityId)
+		rows.Scan(&s.Id, &s.Cid, &s.CompanyName, &s.Address, &s.Telephone, &s.LocalityId, &s.DeletedAt)
 		sl = append(sl, s)
 	}
 
@@ -71,7 +150,7 @@ func (r *mySqlRepository) GetAll() ([]domain.Seller, error) {
 
 
 func (r *mySqlRepository) GetById(id int) (domain.Seller, error) {
-	stmt, err := r.db.Prepare("SELECT * FROM seller WHERE id = ?")
+	stmt, err := r.db.Prepare("SELECT id, cid, company_name, address, telephone, locality_id FROM seller WHERE id = ? AND deleted_at IS NULL")
 	if err != nil {
 		return domain.Seller{}, err
 	}
@@ -95,7 +174,25 @@ func (r *mySqlRepository) GetById(id int) (domain.Seller, error) {
 
 
 
-func (r *mySqlRepository) Store(  cid int, companyName string, address string, telephone string, localityId int) (domain.Seller, error) {
+func (r *mySqlRepository) GetByCid(cid string) (domain.Seller, error) {
+	query := `SELECT id, cid, company_name, address, telephone, locality_id FROM seller WHERE ` + document.KeySQL("cid") + ` = ?`
+
+	seller := domain.Seller{}
+
+	err := r.db.QueryRow(query, document.Key(cid)).Scan(&seller.Id, &seller.Cid, &seller.CompanyName, &seller.Address, &seller.Telephone, &seller.LocalityId)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Seller{}, ErrNoElementFound
+	}
+
+	if err != nil {
+		return domain.Seller{}, err
+	}
+
+	return seller, nil
+}
+
+func (r *mySqlRepository) Store(  cid string, companyName string, address string, telephone string, localityId int) (domain.Seller, error) {
 	stmt, err := r.db.Prepare(`INSERT INTO seller
 	(cid,
 	company_name,
@@ -116,6 +213,9 @@ func (r *mySqlRepository) Store(  cid int, companyName string, address string, t
 		telephone,
 		localityId,
 	)
+	if isMySQLError(err, 1062) {
+		return domain.Seller{}, ErrDuplicateCid
+	}
 	if err != nil {
 		return domain.Seller{}, err
 	}
@@ -123,20 +223,20 @@ func (r *mySqlRepository) Store(  cid int, companyName string, address string, t
 	if err != nil {
 		return domain.Seller{}, err
 	}
-	newSeller := domain.Seller{int(lastID), cid, companyName, address, telephone, localityId}
+	newSeller := domain.Seller{Id: int(lastID), Cid: cid, CompanyName: companyName, Address: address, Telephone: telephone, LocalityId: localityId}
 	return newSeller, nil
 }
 
 
-func (r *mySqlRepository) Update(id int, cid int, companyName string, address string, telephone string, locality_Id int) (domain.Seller, error) {
+func (r *mySqlRepository) Update(id int, cid string, companyName string, address string, telephone string, locality_Id int) (domain.Seller, error) {
 	
-	updatedSeller := domain.Seller{id, cid, companyName, address, telephone, locality_Id}
+	updatedSeller := domain.Seller{Id: id, Cid: cid, CompanyName: companyName, Address: address, Telephone: telephone, LocalityId: locality_Id}
 	stmt, err := r.db.Prepare(`UPDATE seller SET 
 	 	cid=?,
 	  	company_name=?,
 		address=?,
 		telephone=?,
-		locality_id=? WHERE id=?`)
+		locality_id=? WHERE id=? AND deleted_at IS NULL`)
 	if err != nil {
 		return domain.Seller{}, err
 	}
@@ -150,6 +250,9 @@ func (r *mySqlRepository) Update(id int, cid int, companyName string, address st
 		telephone,
 		locality_Id,
 		id)
+	if isMySQLError(err, 1062) {
+		return domain.Seller{}, ErrDuplicateCid
+	}
 	if err != nil {
 		
 		return updatedSeller, err
@@ -163,6 +266,134 @@ func (r *mySqlRepository) Update(id int, cid int, companyName string, address st
 }
 
 
+func (r *mySqlRepository) GetProducts(sellerId int, date string) ([]domain.SellerProduct, error) {
+	const query = `SELECT p.id, p.product_code, p.description, p.product_type_id, pr.sale_price FROM product p
+	LEFT JOIN product_record pr ON pr.id = (SELECT latest.id FROM product_record latest WHERE latest.product_id = p.id AND DATE(latest.last_update_date) <= ? ORDER BY latest.last_update_date DESC, latest.id DESC LIMIT 1)
+	WHERE p.seller_id = ? ORDER BY p.id`
+
+	rows, err := r.db.Query(query, date, sellerId)
+
+	if err != nil {
+		return []domain.SellerProduct{}, err
+	}
+
+	defer rows.Close()
+
+	products := []domain.SellerProduct{}
+
+	for rows.Next() {
+		p := domain.SellerProduct{}
+
+		if err := rows.Scan(&p.Id, &p.ProductCode, &p.Description, &p.ProductTypeId, &p.CurrentSalePrice); err != nil {
+			return []domain.SellerProduct{}, err
+		}
+
+		products = append(products, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.SellerProduct{}, err
+	}
+
+	return products, nil
+}
+
+func (r *mySqlRepository) GetStock(sellerId int) ([]domain.ProductStock, error) {
+	const query = `SELECT p.id, p.product_code, p.description, s.id, s.warehouse_id, SUM(pb.current_quantity) FROM product p
+	INNER JOIN product_batch pb ON pb.product_id = p.id
+	INNER JOIN section s ON s.id = pb.section_id
+	WHERE p.seller_id = ?
+	GROUP BY p.id, p.product_code, p.description, s.id, s.warehouse_id
+	ORDER BY p.id, s.warehouse_id, s.id`
+
+	rows, err := r.db.Query(query, sellerId)
+
+	if err != nil {
+		return []domain.ProductStock{}, err
+	}
+
+	defer rows.Close()
+
+	stock := []domain.ProductStock{}
+
+	for rows.Next() {
+		p := domain.ProductStock{}
+		s := domain.SectionStock{}
+
+		if err := rows.Scan(&p.ProductId, &p.ProductCode, &p.Description, &s.SectionId, &s.WarehouseId, &s.Quantity); err != nil {
+			return []domain.ProductStock{}, err
+		}
+
+		if len(stock) == 0 || stock[len(stock)-1].ProductId != p.ProductId {
+			p.Sections = []domain.SectionStock{}
+			stock = append(stock, p)
+		}
+
+		last := &stock[len(stock)-1]
+		last.Quantity += s.Quantity
+		last.Sections = append(last.Sections, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.ProductStock{}, err
+	}
+
+	return stock, nil
+}
+
+func (r *mySqlRepository) GetSaleLines(sellerId int, from, to string) ([]domain.SaleLine, error) {
+	query := `SELECT po.id, po.order_date, p.id, p.description, od.quantity, COALESCE(od.unit_price, pr.sale_price), pr.purchase_price FROM order_details od
+	INNER JOIN product_record pr ON pr.id = od.product_record_id
+	INNER JOIN product p ON p.id = pr.product_id
+	INNER JOIN purchase_order po ON po.id = od.purchase_order_id
+	WHERE p.seller_id = ?`
+
+	args := []interface{}{sellerId}
+
+	if from != "" {
+		query += ` AND DATE(po.order_date) >= ?`
+		args = append(args, from)
+	}
+
+	if to != "" {
+		query += ` AND DATE(po.order_date) <= ?`
+		args = append(args, to)
+	}
+
+	query += ` ORDER BY po.order_date, po.id, od.id`
+
+	rows, err := r.db.Query(query, args...)
+
+	if err != nil {
+		return []domain.SaleLine{}, err
+	}
+
+	defer rows.Close()
+
+	lines := []domain.SaleLine{}
+
+	for rows.Next() {
+		l := domain.SaleLine{}
+
+		if err := rows.Scan(&l.PurchaseOrderId, &l.OrderDate, &l.ProductId, &l.Description, &l.Quantity, &l.SalePrice, &l.PurchasePrice); err != nil {
+			return []domain.SaleLine{}, err
+		}
+
+		lines = append(lines, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.SaleLine{}, err
+	}
+
+	return lines, nil
+}
+
+func isMySQLError(err error, number uint16) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == number
+}
+
 func CreateMySQLRepository(db *sql.DB) NRepository {
 	return &mySqlRepository{
 		db: db,
@@ -0,0 +1,17 @@
+package services
+
+import "errors"
+
+var ErrSellerNotFound = errors.New("seller not found")
+
+var ErrCidInUse = errors.New("this cid is in use")
+
+var ErrInvalidCid = errors.New("cid must be a valid CPF or CNPJ")
+
+var ErrInvalidDateRange = errors.New("from date must be before or equal to to date")
+
+var ErrInvalidLocalityId = errors.New("this locality_id is invalid")
+
+var ErrSellerHasProducts = errors.New("seller has products and can't be permanently deleted")
+
+var ErrSellerHasSettlements = errors.New("seller has settlements and can't be permanently deleted")
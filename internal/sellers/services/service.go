@@ -1,31 +1,73 @@
 package services
 import(
+	"errors"
+	"sort"
+	"time"
+
+	localities "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/repository/mySql"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/document"
 
 ) 
 
 type Service interface {
-	GetAll() ([]domain.Seller, error)
+	GetAll(includeDeleted bool) ([]domain.Seller, error)
 	GetById(id int) (domain.Seller, error)
-	Store( cid int, companyName string, address string , telephone string, localityId int ) (domain.Seller, error)
-	Update(id , cid int, companyName, address, telephone string, localityId int) (domain.Seller, error)
-	Delete(id int) error
+	Store( cid string, companyName string, address string , telephone string, localityId int ) (domain.Seller, error)
+	Update(id int, cid, companyName, address, telephone string, localityId int) (domain.Seller, error)
+	Delete(id int, hard bool) error
+	Restore(id int) (domain.Seller, error)
+	GetProducts(id int) ([]domain.SellerProduct, error)
+	GetStock(id int) ([]domain.ProductStock, error)
+	GetSalesReport(id int, from, to string) (domain.SalesReport, error)
 }
 
 type service struct {
 	repository repository.NRepository
-
+	localityRepository localities.LocalityRepository
 }
 
-func NewService(r repository.NRepository) Service {
+func NewService(r repository.NRepository, l localities.LocalityRepository) Service {
 	return &service{
 		repository: r,
+		localityRepository: l,
+	}
+}
+
+// validate returns the cid in its canonical CPF/CNPJ formatting.
+func (s service) validate(id int, cid string, localityId int) (string, error) {
+	cid, _, err := document.Normalize(cid)
+
+	if err != nil {
+		return "", ErrInvalidCid
+	}
+
+	seller, err := s.repository.GetByCid(cid)
+
+	if err != nil && !errors.Is(err, repository.ErrNoElementFound) {
+		return "", err
+	}
+
+	if err == nil && seller.Id != id {
+		return "", ErrCidInUse
+	}
+
+	_, err = s.localityRepository.GetById(localityId)
+
+	if errors.Is(err, localities.ErrLocalityNotFound) {
+		return "", ErrInvalidLocalityId
 	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return cid, nil
 }
 
-func (s service) GetAll() ([]domain.Seller, error) {
-	sl, err := s.repository.GetAll()
+func (s service) GetAll(includeDeleted bool) ([]domain.Seller, error) {
+	sl, err := s.repository.GetAll(includeDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -42,35 +84,182 @@ func (s service) GetById (id int) (domain.Seller, error){
 }
 
 
-func (s service) Store(cid int, companyName string, address string , telephone string, localityId int) (domain.Seller, error) {
+func (s service) Store(cid string, companyName string, address string , telephone string, localityId int) (domain.Seller, error) {
+	cid, err := s.validate(0, cid, localityId)
 
+	if err != nil {
+		return domain.Seller{}, err
+	}
 
 	seller, err := s.repository.Store( cid, companyName, address, telephone, localityId)
 
 	if err != nil {
-		return domain.Seller{}, err
+		return domain.Seller{}, cidInUse(err)
 	}
 
-	
-
 	return seller, nil
 
 }
 
-func (s service) Update(id , cid int, companyName, address, telephone string, localityId int) (domain.Seller, error) {
-	seller, err := s.repository.Update(id, cid, companyName, address, telephone, localityId)
+func (s service) Update(id int, cid, companyName, address, telephone string, localityId int) (domain.Seller, error) {
+	if _, err := s.repository.GetById(id); err != nil {
+		return domain.Seller{}, ErrSellerNotFound
+	}
+
+	cid, err := s.validate(id, cid, localityId)
+
 	if err != nil {
 		return domain.Seller{}, err
 	}
+
+	seller, err := s.repository.Update(id, cid, companyName, address, telephone, localityId)
+	if err != nil {
+		return domain.Seller{}, cidInUse(err)
+	}
 	return seller, err
 }
 
 
 
-func (s service) Delete(id int) error {
-	err := s.repository.Delete(id)
+// Delete refuses a hard delete while the seller owns products or settlements.
+func (s service) Delete(id int, hard bool) error {
+	if !hard {
+		return notFound(s.repository.SoftDelete(id))
+	}
+
+	hasProducts, err := s.repository.HasProducts(id)
 	if err != nil {
 		return err
 	}
+
+	if hasProducts {
+		return ErrSellerHasProducts
+	}
+
+	err = s.repository.Delete(id)
+
+	if errors.Is(err, repository.ErrSellerReferenced) {
+		return ErrSellerHasSettlements
+	}
+
+	return notFound(err)
+}
+
+func (s service) Restore(id int) (domain.Seller, error) {
+	seller, err := s.repository.Restore(id)
+	if err != nil {
+		return domain.Seller{}, notFound(err)
+	}
+	return seller, nil
+}
+
+// cidInUse covers a concurrent request storing the same cid after validate.
+func cidInUse(err error) error {
+	if errors.Is(err, repository.ErrDuplicateCid) {
+		return ErrCidInUse
+	}
 	return err
 }
+
+func notFound(err error) error {
+	if errors.Is(err, repository.ErrNoElementFound) {
+		return ErrSellerNotFound
+	}
+	return err
+}
+
+func (s service) GetProducts(id int) ([]domain.SellerProduct, error) {
+	if _, err := s.repository.GetById(id); err != nil {
+		return nil, ErrSellerNotFound
+	}
+
+	return s.repository.GetProducts(id, time.Now().Format("2006-01-02"))
+}
+
+func (s service) GetStock(id int) ([]domain.ProductStock, error) {
+	if _, err := s.repository.GetById(id); err != nil {
+		return nil, ErrSellerNotFound
+	}
+
+	return s.repository.GetStock(id)
+}
+
+const topProductsLimit = 5
+
+func (s service) GetSalesReport(id int, from, to string) (domain.SalesReport, error) {
+	if from != "" && to != "" && from > to {
+		return domain.SalesReport{}, ErrInvalidDateRange
+	}
+
+	if _, err := s.repository.GetById(id); err != nil {
+		return domain.SalesReport{}, ErrSellerNotFound
+	}
+
+	lines, err := s.repository.GetSaleLines(id, from, to)
+	if err != nil {
+		return domain.SalesReport{}, err
+	}
+
+	report := domain.SalesReport{
+		SellerId:    id,
+		TopProducts: []domain.ProductSales{},
+		Months:      []domain.MonthSales{},
+	}
+
+	orders := map[int]bool{}
+	monthOrders := map[string]map[int]bool{}
+	products := map[int]*domain.ProductSales{}
+
+	for _, l := range lines {
+		revenue := l.SalePrice.Mul(int64(l.Quantity))
+		month := l.OrderDate[:7]
+
+		report.UnitsSold += l.Quantity
+		report.Revenue = report.Revenue.Add(revenue)
+		report.Cost = report.Cost.Add(l.PurchasePrice.Mul(int64(l.Quantity)))
+		orders[l.PurchaseOrderId] = true
+
+		if len(report.Months) == 0 || report.Months[len(report.Months)-1].Month != month {
+			report.Months = append(report.Months, domain.MonthSales{Month: month})
+			monthOrders[month] = map[int]bool{}
+		}
+
+		m := &report.Months[len(report.Months)-1]
+		m.UnitsSold += l.Quantity
+		m.Revenue = m.Revenue.Add(revenue)
+		monthOrders[month][l.PurchaseOrderId] = true
+		m.OrdersCount = len(monthOrders[month])
+
+		if products[l.ProductId] == nil {
+			products[l.ProductId] = &domain.ProductSales{ProductId: l.ProductId, Description: l.Description}
+		}
+
+		p := products[l.ProductId]
+		p.UnitsSold += l.Quantity
+		p.Revenue = p.Revenue.Add(revenue)
+	}
+
+	report.OrdersCount = len(orders)
+	report.GrossProfit = report.Revenue.Sub(report.Cost)
+
+	for _, p := range products {
+		report.TopProducts = append(report.TopProducts, *p)
+	}
+
+	sort.Slice(report.TopProducts, func(i, j int) bool {
+		a, b := report.TopProducts[i], report.TopProducts[j]
+		if c := a.Revenue.Cmp(b.Revenue); c != 0 {
+			return c > 0
+		}
+		if a.UnitsSold != b.UnitsSold {
+			return a.UnitsSold > b.UnitsSold
+		}
+		return a.ProductId < b.ProductId
+	})
+
+	if len(report.TopProducts) > topProductsLimit {
+		report.TopProducts = report.TopProducts[:topProductsLimit]
+	}
+
+	return report, nil
+}
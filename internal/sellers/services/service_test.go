@@ -6,9 +6,14 @@ import (
 
 	"testing"
 
+	localityDomain "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/domain"
+	localities "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository"
+	localityMocks "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/localities/repository/mocks"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/domain/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/repository/mySql"
 	sellers "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/sellers/services"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,7 +24,7 @@ func TestGetAll(t *testing.T) {
 	mockRepo := mocks.NewNRepository(t)
 	s := domain.Seller{
 		Id:    1,
-		Cid:  1,
+		Cid:  "1",
 		CompanyName:  "None",
 		Address: "none",
 		Telephone: "00000",
@@ -30,10 +35,10 @@ func TestGetAll(t *testing.T) {
 	sList = append(sList, s)
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo.On("GetAll").Return(sList, nil).Once()
+		mockRepo.On("GetAll", false).Return(sList, nil).Once()
 
-		sl := sellers.NewService(mockRepo)
-		list, err := sl.GetAll()
+		sl := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+		list, err := sl.GetAll(false)
 
 		assert.NoError(t, err)
 
@@ -43,12 +48,12 @@ func TestGetAll(t *testing.T) {
 	})
 
 	t.Run("error", func(t *testing.T) {
-		mockRepo.On("GetAll").
+		mockRepo.On("GetAll", false).
 			Return(nil, errors.New("failed to retrieve products")).
 			Once()
 
-		s := sellers.NewService(mockRepo)
-		_, err := s.GetAll()
+		s := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+		_, err := s.GetAll(false)
 
 		assert.NotNil(t, err)
 
@@ -59,47 +64,99 @@ func TestGetAll(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	mockRepo := mocks.NewNRepository(t)
-	s := sellers.NewService(mockRepo)
-	t.Run("Should call Delete from seller repository with correct id", func(t *testing.T) {
+	s := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+	t.Run("Should soft delete the seller by default", func(t *testing.T) {
 		mockRepo.
-			On("Delete", mock.AnythingOfType("int")).
+			On("SoftDelete", mock.AnythingOfType("int")).
 			Return(nil).
 			Once()
 
-		s.Delete(1)
+		err := s.Delete(1, false)
 
-		mockRepo.AssertCalled(t, "Delete", 1)
+		assert.Nil(t, err)
+		mockRepo.AssertCalled(t, "SoftDelete", 1)
 	})
 
-	t.Run("return an error if Delete from seller repository returns an error", func(t *testing.T) {
+	t.Run("return ErrSellerNotFound if there is no seller to delete", func(t *testing.T) {
 		mockRepo.
-			On("Delete", mock.AnythingOfType("int")).
+			On("SoftDelete", mock.AnythingOfType("int")).
+			Return(repository.ErrNoElementFound).
+			Once()
+
+		err := s.Delete(1, false)
+
+		assert.ErrorIs(t, err, sellers.ErrSellerNotFound)
+	})
+
+	t.Run("return an error if SoftDelete from seller repository returns an error", func(t *testing.T) {
+		mockRepo.
+			On("SoftDelete", mock.AnythingOfType("int")).
 			Return(errors.New("any_error")).
 			Once()
 
-		err := s.Delete(1)
+		err := s.Delete(1, false)
 
 		assert.EqualError(t, err, "any_error")
 	})
 
-	t.Run(" return nil on success", func(t *testing.T) {
+	t.Run("Should call Delete on a hard delete of a seller without products", func(t *testing.T) {
+		mockRepo.On("HasProducts", 1).Return(false, nil).Once()
 		mockRepo.
 			On("Delete", mock.AnythingOfType("int")).
 			Return(nil).
 			Once()
 
-		err := s.Delete(1)
+		err := s.Delete(1, true)
 
 		assert.Nil(t, err)
+		mockRepo.AssertCalled(t, "Delete", 1)
+	})
+
+	t.Run("return ErrSellerHasProducts on a hard delete of a seller with products", func(t *testing.T) {
+		mockRepo.On("HasProducts", 2).Return(true, nil).Once()
+
+		err := s.Delete(2, true)
+
+		assert.ErrorIs(t, err, sellers.ErrSellerHasProducts)
+		mockRepo.AssertNotCalled(t, "Delete", 2)
+	})
+
+	t.Run("return ErrSellerHasSettlements on a hard delete of a seller with settlements", func(t *testing.T) {
+		mockRepo.On("HasProducts", 3).Return(false, nil).Once()
+		mockRepo.On("Delete", 3).Return(repository.ErrSellerReferenced).Once()
+
+		err := s.Delete(3, true)
+
+		assert.ErrorIs(t, err, sellers.ErrSellerHasSettlements)
 	})
 }
 
+func TestRestore(t *testing.T) {
+	mockRepo := mocks.NewNRepository(t)
+	s := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+	t.Run("return the restored seller", func(t *testing.T) {
+		mockRepo.On("Restore", 1).Return(domain.Seller{Id: 1, CompanyName: "None"}, nil).Once()
+
+		seller, err := s.Restore(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "None", seller.CompanyName)
+	})
+
+	t.Run("return ErrSellerNotFound if there is no deleted seller", func(t *testing.T) {
+		mockRepo.On("Restore", 2).Return(domain.Seller{}, repository.ErrNoElementFound).Once()
+
+		_, err := s.Restore(2)
+
+		assert.ErrorIs(t, err, sellers.ErrSellerNotFound)
+	})
+}
 
 func TestStore(t *testing.T){
-	mockRepo := mocks.NewNRepository(t)
 	expectSeller := domain.Seller{
 		Id:    1,
-		Cid:  1,
+		Cid:  "1",
 		CompanyName:  "None",
 		Address: "none",
 		Telephone: "00000",
@@ -107,49 +164,121 @@ func TestStore(t *testing.T){
 	}
 
 	t.Run("if the fields are correct, the new seller will be stored", func(t *testing.T) {
-		
-		mockRepo.On("Store",   mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(expectSeller, nil).Once()
+		mockRepo := mocks.NewNRepository(t)
+		mockLocalityRepo := localityMocks.NewLocalityRepository(t)
+		mockRepo.On("GetByCid", "529.982.247-25").Return(domain.Seller{}, repository.ErrNoElementFound).Once()
+		mockLocalityRepo.On("GetById", 1).Return(localityDomain.Locality{Id: 1}, nil).Once()
+		mockRepo.On("Store", "529.982.247-25", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(expectSeller, nil).Once()
 
-		service := sellers.NewService(mockRepo)
+		service := sellers.NewService(mockRepo, mockLocalityRepo)
 
-		result, _ := service.Store(219, "Name", "Addres", "telephone", 1)
-		
+		result, err := service.Store(" 529982247-25 ", "Name", "Addres", "telephone", 1)
+
+		assert.Nil(t, err)
 		assert.Equal(t, expectSeller, result)
 	})
 
+	t.Run("return ErrInvalidCid if the cid is not a valid CPF or CNPJ", func(t *testing.T) {
+		service := sellers.NewService(mocks.NewNRepository(t), localityMocks.NewLocalityRepository(t))
+
+		for _, cid := range []string{"  ", "219", "529.982.247-24", "11222333000182"} {
+			_, err := service.Store(cid, "Name", "Addres", "telephone", 1)
+
+			assert.ErrorIs(t, err, sellers.ErrInvalidCid, cid)
+		}
+	})
+
+	t.Run("return ErrCidInUse if another seller has the cid", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetByCid", "529.982.247-25").Return(domain.Seller{Id: 2, Cid: "529.982.247-25"}, nil).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.Store("52998224725", "Name", "Addres", "telephone", 1)
+
+		assert.ErrorIs(t, err, sellers.ErrCidInUse)
+	})
+
+	t.Run("return an error if GetByCid returns an error other than ErrNoElementFound", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetByCid", "529.982.247-25").Return(domain.Seller{}, errors.New("any_error")).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.Store("52998224725", "Name", "Addres", "telephone", 1)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("return ErrInvalidLocalityId if the locality doesn't exist", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockLocalityRepo := localityMocks.NewLocalityRepository(t)
+		mockRepo.On("GetByCid", "529.982.247-25").Return(domain.Seller{}, repository.ErrNoElementFound).Once()
+		mockLocalityRepo.On("GetById", 99).Return(localityDomain.Locality{}, localities.ErrLocalityNotFound).Once()
+		service := sellers.NewService(mockRepo, mockLocalityRepo)
+
+		_, err := service.Store("52998224725", "Name", "Addres", "telephone", 99)
+
+		assert.ErrorIs(t, err, sellers.ErrInvalidLocalityId)
+	})
+
+	t.Run("return ErrCidInUse if another seller stored the cid concurrently", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockLocalityRepo := localityMocks.NewLocalityRepository(t)
+		mockRepo.On("GetByCid", "529.982.247-25").Return(domain.Seller{}, repository.ErrNoElementFound).Once()
+		mockLocalityRepo.On("GetById", 1).Return(localityDomain.Locality{Id: 1}, nil).Once()
+		mockRepo.On("Store", "529.982.247-25", "Name", "Addres", "telephone", 1).Return(domain.Seller{}, repository.ErrDuplicateCid).Once()
+		service := sellers.NewService(mockRepo, mockLocalityRepo)
+
+		_, err := service.Store("52998224725", "Name", "Addres", "telephone", 1)
+
+		assert.ErrorIs(t, err, sellers.ErrCidInUse)
+	})
 }
 
 func TestUpdate(t *testing.T){
-	mockRepo := mocks.NewNRepository(t)
 	expectSeller := domain.Seller{
-		
-		Cid:  1,
+		Id:    1,
+		Cid:  "529.982.247-25",
 		CompanyName:  "None",
 		Address: "none",
 		Telephone: "00000",
 		LocalityId: 1,
 	}
 	t.Run("return the updated information", func(t*testing.T){
-	
-		mockRepo.On("Update", mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int")).Return(expectSeller, nil).Once()
-		service := sellers.NewService(mockRepo)
-		result, _ := service.Update( int(1),int(219), "Name", "Addres", "telephone", int(1))
-		
-		assert.Equal(t, expectSeller, result)
+		mockRepo := mocks.NewNRepository(t)
+		mockLocalityRepo := localityMocks.NewLocalityRepository(t)
+		mockRepo.On("GetById", 1).Return(expectSeller, nil).Once()
+		mockRepo.On("GetByCid", "529.982.247-25").Return(expectSeller, nil).Once()
+		mockLocalityRepo.On("GetById", 1).Return(localityDomain.Locality{Id: 1}, nil).Once()
+		mockRepo.On("Update", 1, "529.982.247-25", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), 1).Return(expectSeller, nil).Once()
+		service := sellers.NewService(mockRepo, mockLocalityRepo)
+
+		result, err := service.Update(1, "529.982.247-25", "Name", "Addres", "telephone", 1)
 
+		assert.Nil(t, err)
+		assert.Equal(t, expectSeller, result)
 	})
 
-	t.Run(" If the element with the specified id not exists, return nil", func(t *testing.T) {
-		mockRepo.On("Update", int(3), int(219),"None", "None", "None", 1).Return(domain.Seller{}, fmt.Errorf("Seller not found"))
-		service := sellers.NewService(mockRepo)
+	t.Run(" If the element with the specified id not exists, return ErrSellerNotFound", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 3).Return(domain.Seller{}, fmt.Errorf("Seller 3 not found")).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
 
-		result, err := service.Update(int(3), int(219),"None", "None", "None", 1)
+		result, err := service.Update(3, "52998224725", "None", "None", "None", 1)
 
 		assert.Equal(t, domain.Seller{}, result)
-		assert.NotNil(t, err)
-
+		assert.ErrorIs(t, err, sellers.ErrSellerNotFound)
 	})
 
+	t.Run("return ErrCidInUse if the cid belongs to another seller", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(expectSeller, nil).Once()
+		mockRepo.On("GetByCid", "11.222.333/0001-81").Return(domain.Seller{Id: 2, Cid: "11.222.333/0001-81"}, nil).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.Update(1, "11222333000181", "None", "None", "None", 1)
+
+		assert.ErrorIs(t, err, sellers.ErrCidInUse)
+	})
 }
 
 
@@ -159,14 +288,14 @@ func TestGetById(t *testing.T){
 	expectedSellersList := []domain.Seller{
 		{
 			Id:          1,
-			Cid:         219,
+			Cid:         "219",
 			CompanyName: "Meta",
 			Address:     " SP",
 			Telephone:   "00000000",
 		},
 		{
 			Id:          2,
-			Cid:         422,
+			Cid:         "422",
 			CompanyName: "Herbalife",
 			Address:     "None",
 			Telephone:   "0000000",
@@ -174,7 +303,7 @@ func TestGetById(t *testing.T){
 	}
 	t.Run(" if the Id exists, it will return the element with its information", func(t *testing.T) {
 		mockRepo.On("GetById", int(2)).Return(expectedSellersList[1], nil)
-		service := sellers.NewService(mockRepo)
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
 	
 		result, err := service.GetById(int(2))
 	
@@ -187,7 +316,7 @@ func TestGetById(t *testing.T){
 
 	t.Run("fif the element with the specified Id not exists,, return nil", func(t *testing.T) {
 		mockRepo.On("GetById", int(1)).Return(domain.Seller{}, fmt.Errorf("Seller not found."))
-		service := sellers.NewService(mockRepo)
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
 
 		result, err := service.GetById(int(1))
 
@@ -199,3 +328,148 @@ func TestGetById(t *testing.T){
 }
 
 
+
+func TestGetProducts(t *testing.T) {
+	price := money.FromCents(1050)
+	products := []domain.SellerProduct{
+		{Id: 1, ProductCode: "P01", Description: "Cafe", ProductTypeId: 1, CurrentSalePrice: &price},
+		{Id: 2, ProductCode: "P02", Description: "Leite", ProductTypeId: 1},
+	}
+
+	t.Run("return ErrSellerNotFound if the seller doesn't exist", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{}, fmt.Errorf("Seller 1 not found")).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.GetProducts(1)
+
+		assert.ErrorIs(t, err, sellers.ErrSellerNotFound)
+	})
+
+	t.Run("return the seller products with the current price", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{Id: 1}, nil).Once()
+		mockRepo.On("GetProducts", 1, mock.AnythingOfType("string")).Return(products, nil).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		result, err := service.GetProducts(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, products, result)
+	})
+}
+
+func TestGetStock(t *testing.T) {
+	stock := []domain.ProductStock{
+		{
+			ProductId:   1,
+			ProductCode: "P01",
+			Description: "Cafe",
+			Quantity:    30,
+			Sections: []domain.SectionStock{
+				{SectionId: 1, WarehouseId: 1, Quantity: 10},
+				{SectionId: 3, WarehouseId: 2, Quantity: 20},
+			},
+		},
+	}
+
+	t.Run("return ErrSellerNotFound if the seller doesn't exist", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{}, fmt.Errorf("Seller 1 not found")).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.GetStock(1)
+
+		assert.ErrorIs(t, err, sellers.ErrSellerNotFound)
+	})
+
+	t.Run("return an error if GetStock from seller repository returns an error", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{Id: 1}, nil).Once()
+		mockRepo.On("GetStock", 1).Return(nil, errors.New("any_error")).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.GetStock(1)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("return the seller stock per product", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{Id: 1}, nil).Once()
+		mockRepo.On("GetStock", 1).Return(stock, nil).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		result, err := service.GetStock(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, stock, result)
+	})
+}
+
+func TestGetSalesReport(t *testing.T) {
+	lines := []domain.SaleLine{
+		{PurchaseOrderId: 1, OrderDate: "2022-06-30 10:00:00", ProductId: 1, Description: "Cafe", Quantity: 2, SalePrice: money.FromCents(1050), PurchasePrice: money.FromCents(500)},
+		{PurchaseOrderId: 1, OrderDate: "2022-06-30 10:00:00", ProductId: 2, Description: "Leite", Quantity: 10, SalePrice: money.FromCents(400), PurchasePrice: money.FromCents(300)},
+		{PurchaseOrderId: 2, OrderDate: "2022-07-01 08:00:00", ProductId: 1, Description: "Cafe", Quantity: 1, SalePrice: money.FromCents(1200), PurchasePrice: money.FromCents(600)},
+		{PurchaseOrderId: 3, OrderDate: "2022-07-15 08:00:00", ProductId: 3, Description: "Pao", Quantity: 3, SalePrice: money.FromCents(100), PurchasePrice: money.FromCents(50)},
+	}
+
+	t.Run("return ErrInvalidDateRange if from is after to", func(t *testing.T) {
+		service := sellers.NewService(mocks.NewNRepository(t), localityMocks.NewLocalityRepository(t))
+
+		_, err := service.GetSalesReport(1, "2022-08-01", "2022-07-01")
+
+		assert.ErrorIs(t, err, sellers.ErrInvalidDateRange)
+	})
+
+	t.Run("return ErrSellerNotFound if the seller doesn't exist", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{}, fmt.Errorf("Seller 1 not found")).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.GetSalesReport(1, "", "")
+
+		assert.ErrorIs(t, err, sellers.ErrSellerNotFound)
+	})
+
+	t.Run("return an error if GetSaleLines from seller repository returns an error", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{Id: 1}, nil).Once()
+		mockRepo.On("GetSaleLines", 1, "", "").Return(nil, errors.New("any_error")).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		_, err := service.GetSalesReport(1, "", "")
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("return the sales totals, top products and months", func(t *testing.T) {
+		mockRepo := mocks.NewNRepository(t)
+		mockRepo.On("GetById", 1).Return(domain.Seller{Id: 1}, nil).Once()
+		mockRepo.On("GetSaleLines", 1, "2022-06-01", "2022-07-31").Return(lines, nil).Once()
+		service := sellers.NewService(mockRepo, localityMocks.NewLocalityRepository(t))
+
+		result, err := service.GetSalesReport(1, "2022-06-01", "2022-07-31")
+
+		expected := domain.SalesReport{
+			SellerId:    1,
+			UnitsSold:   16,
+			OrdersCount: 3,
+			Revenue:     money.FromCents(7600),
+			Cost:        money.FromCents(4750),
+			GrossProfit: money.FromCents(2850),
+			TopProducts: []domain.ProductSales{
+				{ProductId: 2, Description: "Leite", UnitsSold: 10, Revenue: money.FromCents(4000)},
+				{ProductId: 1, Description: "Cafe", UnitsSold: 3, Revenue: money.FromCents(3300)},
+				{ProductId: 3, Description: "Pao", UnitsSold: 3, Revenue: money.FromCents(300)},
+			},
+			Months: []domain.MonthSales{
+				{Month: "2022-06", OrdersCount: 1, UnitsSold: 12, Revenue: money.FromCents(6100)},
+				{Month: "2022-07", OrdersCount: 2, UnitsSold: 4, Revenue: money.FromCents(1500)},
+			},
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+}
@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"database/sql"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases"
+)
+
+type sellerMysqlRepository struct {
+	db *sql.DB
+}
+
+func NewSellerMysqlRepository(db *sql.DB) usecases.SellerRepository {
+	return &sellerMysqlRepository{
+		db: db,
+	}
+}
+
+func (r *sellerMysqlRepository) Exists(id int) (bool, error) {
+	const query = `SELECT COUNT(*) FROM seller WHERE id=?`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
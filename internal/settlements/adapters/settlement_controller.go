@@ -0,0 +1,205 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases"
+)
+
+type SettlementController struct {
+	service usecases.SettlementService
+}
+
+func NewSettlementController(s usecases.SettlementService) *SettlementController {
+	return &SettlementController{
+		service: s,
+	}
+}
+
+type createRequest struct {
+	From string `json:"from" binding:"required"`
+	To   string `json:"to" binding:"required"`
+}
+
+type updateStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+func (c *SettlementController) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		seller_id, err := strconv.Atoi(ctx.Param("id"))
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		var req createRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid input. Check the data entered"})
+			return
+		}
+
+		for _, date := range []string{req.From, req.To} {
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "dates must be in the format yyyy-mm-dd"})
+				return
+			}
+		}
+
+		settlement, err := c.service.Create(seller_id, req.From, req.To)
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, settlement)
+	}
+}
+
+func (c *SettlementController) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		seller_id, err := strconv.Atoi(ctx.Param("id"))
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+			return
+		}
+
+		settlements, err := c.service.GetAllBySeller(seller_id)
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, settlements)
+	}
+}
+
+func (c *SettlementController) GetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		seller_id, id, ok := parseIds(ctx)
+
+		if !ok {
+			return
+		}
+
+		format := ctx.DefaultQuery("format", "json")
+
+		if format != "json" && format != "csv" {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "format must be json or csv"})
+			return
+		}
+
+		settlement, err := c.service.GetById(seller_id, id)
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		if format == "json" {
+			ctx.JSON(http.StatusOK, settlement)
+			return
+		}
+
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+
+		if err := w.WriteAll(settlementCSV(settlement)); err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			return
+		}
+
+		ctx.Header("Content-Disposition", `attachment; filename="settlement_`+strconv.Itoa(settlement.Id)+`.csv"`)
+		ctx.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+	}
+}
+
+func (c *SettlementController) UpdateStatus() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		seller_id, id, ok := parseIds(ctx)
+
+		if !ok {
+			return
+		}
+
+		var req updateStatusRequest
+
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid input. Check the data entered"})
+			return
+		}
+
+		settlement, err := c.service.UpdateStatus(seller_id, id, req.Status)
+
+		if err != nil {
+			respondError(ctx, err)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, settlement)
+	}
+}
+
+func parseIds(ctx *gin.Context) (int, int, bool) {
+	seller_id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid id"})
+		return 0, 0, false
+	}
+
+	id, err := strconv.Atoi(ctx.Param("settlement_id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid settlement_id"})
+		return 0, 0, false
+	}
+
+	return seller_id, id, true
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidDateRange):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrSellerNotFound), errors.Is(err, usecases.ErrNoElementFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrInvalidStatusTransition), errors.Is(err, usecases.ErrStatusChanged), errors.Is(err, usecases.ErrLinesAlreadySettled):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrNothingToSettle), errors.Is(err, usecases.ErrInvalidStatus):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
+
+func settlementCSV(settlement domain.Settlement) [][]string {
+	rows := [][]string{{"order_details_id", "purchase_order_id", "order_date", "product_id", "description", "quantity", "unit_price", "amount"}}
+
+	for _, l := range settlement.Lines {
+		rows = append(rows, []string{
+			strconv.Itoa(l.Order_Details_Id),
+			strconv.Itoa(l.Purchase_Order_Id),
+			l.Order_Date,
+			strconv.Itoa(l.Product_Id),
+			l.Description,
+			strconv.Itoa(l.Quantity),
+			l.Unit_Price.String(),
+			l.Amount.String(),
+		})
+	}
+
+	return append(rows, []string{"total", "", "", "", "", "", "", settlement.Total_Amount.String()})
+}
@@ -0,0 +1,191 @@
+package adapters_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeSettlement() domain.Settlement {
+	return domain.Settlement{
+		Id:           1,
+		Seller_Id:    1,
+		Period_Start: "2022-07-01",
+		Period_End:   "2022-07-31",
+		Status:       domain.StatusDraft,
+		Total_Amount: money.FromCents(750),
+		Created_At:   "2022-08-01 10:00:00",
+		Lines: domain.SettlementLines{
+			{Id: 1, Order_Details_Id: 1, Purchase_Order_Id: 1, Order_Date: "2022-07-01", Product_Id: 1, Description: "Cafe", Quantity: 3, Unit_Price: money.FromCents(250), Amount: money.FromCents(750)},
+		},
+	}
+}
+
+func setupRouter(s usecases.SettlementService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	controller := adapters.NewSettlementController(s)
+
+	r := gin.Default()
+	r.GET("/seller/:id/settlements", controller.GetAll())
+	r.POST("/seller/:id/settlements", controller.Create())
+	r.GET("/seller/:id/settlements/:settlement_id", controller.GetById())
+	r.PATCH("/seller/:id/settlements/:settlement_id", controller.UpdateStatus())
+
+	return r
+}
+
+func TestCreateSettlement(t *testing.T) {
+	mockService := mocks.NewSettlementService(t)
+	r := setupRouter(mockService)
+
+	t.Run("Should return 400 status if the input is invalid", func(t *testing.T) {
+		for _, body := range []string{`{}`, `{"from":"2022-07-01"}`, `{"from":"01-07-2022","to":"2022-07-31"}`} {
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/seller/1/settlements", bytes.NewBufferString(body))
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code, body)
+		}
+	})
+
+	t.Run("Should map service errors to status codes", func(t *testing.T) {
+		for err, code := range map[error]int{
+			usecases.ErrInvalidDateRange:    http.StatusBadRequest,
+			usecases.ErrSellerNotFound:      http.StatusNotFound,
+			usecases.ErrLinesAlreadySettled: http.StatusConflict,
+			usecases.ErrNothingToSettle:     http.StatusUnprocessableEntity,
+			errors.New("any_error"):         http.StatusInternalServerError,
+		} {
+			mockService.On("Create", 1, "2022-07-01", "2022-07-31").Return(domain.Settlement{}, err).Once()
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/seller/1/settlements", bytes.NewBufferString(`{"from":"2022-07-01","to":"2022-07-31"}`))
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, code, res.Code, err.Error())
+		}
+	})
+
+	t.Run("Should return 201 status and the settlement on success", func(t *testing.T) {
+		mockService.On("Create", 1, "2022-07-01", "2022-07-31").Return(makeSettlement(), nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/seller/1/settlements", bytes.NewBufferString(`{"from":"2022-07-01","to":"2022-07-31"}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Equal(t, "{\"id\":1,\"seller_id\":1,\"period_start\":\"2022-07-01\",\"period_end\":\"2022-07-31\",\"status\":\"draft\",\"total_amount\":7.50,\"created_at\":\"2022-08-01 10:00:00\",\"approved_at\":null,\"paid_at\":null,\"lines\":[{\"id\":1,\"order_details_id\":1,\"purchase_order_id\":1,\"order_date\":\"2022-07-01\",\"product_id\":1,\"description\":\"Cafe\",\"quantity\":3,\"unit_price\":2.50,\"amount\":7.50}]}", res.Body.String())
+	})
+}
+
+func TestGetAllSettlements(t *testing.T) {
+	mockService := mocks.NewSettlementService(t)
+	r := setupRouter(mockService)
+
+	t.Run("Should return 404 status if the seller does not exist", func(t *testing.T) {
+		mockService.On("GetAllBySeller", 1).Return(domain.Settlements{}, usecases.ErrSellerNotFound).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/seller/1/settlements", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.Equal(t, "{\"error\":\"seller not found\"}", res.Body.String())
+	})
+
+	t.Run("Should return 200 status and the settlements on success", func(t *testing.T) {
+		mockService.On("GetAllBySeller", 1).Return(domain.Settlements{}, nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/seller/1/settlements", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "[]", res.Body.String())
+	})
+}
+
+func TestGetSettlementById(t *testing.T) {
+	mockService := mocks.NewSettlementService(t)
+	r := setupRouter(mockService)
+
+	t.Run("Should return 400 status if ids or format are invalid", func(t *testing.T) {
+		for _, uri := range []string{"/seller/abc/settlements/1", "/seller/1/settlements/abc", "/seller/1/settlements/1?format=xml"} {
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code, uri)
+		}
+	})
+
+	t.Run("Should return 404 status if the settlement does not exist", func(t *testing.T) {
+		mockService.On("GetById", 1, 2).Return(domain.Settlement{}, usecases.ErrNoElementFound).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/seller/1/settlements/2", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.Equal(t, "{\"error\":\"settlement not found\"}", res.Body.String())
+	})
+
+	t.Run("Should return 200 status and the statement as CSV if format is csv", func(t *testing.T) {
+		mockService.On("GetById", 1, 1).Return(makeSettlement(), nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/seller/1/settlements/1?format=csv", nil)
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", res.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="settlement_1.csv"`, res.Header().Get("Content-Disposition"))
+		assert.Equal(t, "order_details_id,purchase_order_id,order_date,product_id,description,quantity,unit_price,amount\n1,1,2022-07-01,1,Cafe,3,2.50,7.50\ntotal,,,,,,,7.50\n", res.Body.String())
+	})
+}
+
+func TestUpdateSettlementStatus(t *testing.T) {
+	mockService := mocks.NewSettlementService(t)
+	r := setupRouter(mockService)
+
+	t.Run("Should return 400 status if the status is missing", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/seller/1/settlements/1", bytes.NewBufferString(`{}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Should map service errors to status codes", func(t *testing.T) {
+		for err, code := range map[error]int{
+			usecases.ErrInvalidStatus:           http.StatusUnprocessableEntity,
+			usecases.ErrInvalidStatusTransition: http.StatusConflict,
+			usecases.ErrStatusChanged:           http.StatusConflict,
+			usecases.ErrNoElementFound:          http.StatusNotFound,
+		} {
+			mockService.On("UpdateStatus", 1, 1, "paid").Return(domain.Settlement{}, err).Once()
+			res := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPatch, "/seller/1/settlements/1", bytes.NewBufferString(`{"status":"paid"}`))
+			r.ServeHTTP(res, req)
+
+			assert.Equal(t, code, res.Code, err.Error())
+		}
+	})
+
+	t.Run("Should return 200 status and the updated settlement on success", func(t *testing.T) {
+		settlement := makeSettlement()
+		settlement.Status = domain.StatusApproved
+		mockService.On("UpdateStatus", 1, 1, "approved").Return(settlement, nil).Once()
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/seller/1/settlements/1", bytes.NewBufferString(`{"status":"approved"}`))
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Contains(t, res.Body.String(), "\"status\":\"approved\"")
+	})
+}
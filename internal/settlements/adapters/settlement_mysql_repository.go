@@ -0,0 +1,237 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases"
+)
+
+type settlementMysqlRepository struct {
+	db *sql.DB
+}
+
+func NewSettlementMysqlRepository(db *sql.DB) usecases.SettlementRepository {
+	return &settlementMysqlRepository{
+		db: db,
+	}
+}
+
+func (r *settlementMysqlRepository) GetSettleableLines(seller_id int, from string, to string) (domain.SettlementLines, error) {
+	const query = `SELECT od.id, po.id, po.order_date, p.id, p.description, od.quantity, pr.purchase_price FROM order_details od
+	INNER JOIN purchase_order po ON po.id = od.purchase_order_id
+	INNER JOIN product_record pr ON pr.id = od.product_record_id
+	INNER JOIN product p ON p.id = pr.product_id
+	LEFT JOIN settlement_line sl ON sl.order_details_id = od.id
+	WHERE p.seller_id = ? AND po.order_status_id = ? AND DATE(po.order_date) BETWEEN ? AND ? AND sl.id IS NULL
+	ORDER BY po.order_date, od.id`
+
+	rows, err := r.db.Query(query, seller_id, purchase.StatusDelivered, from, to)
+
+	if err != nil {
+		return domain.SettlementLines{}, err
+	}
+
+	defer rows.Close()
+
+	lines := domain.SettlementLines{}
+
+	for rows.Next() {
+		l := domain.SettlementLine{}
+
+		if err := rows.Scan(&l.Order_Details_Id, &l.Purchase_Order_Id, &l.Order_Date, &l.Product_Id, &l.Description, &l.Quantity, &l.Unit_Price); err != nil {
+			return domain.SettlementLines{}, err
+		}
+
+		lines = append(lines, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.SettlementLines{}, err
+	}
+
+	return lines, nil
+}
+
+func (r *settlementMysqlRepository) Create(settlement domain.Settlement) (domain.Settlement, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return domain.Settlement{}, err
+	}
+
+	const query = `INSERT INTO settlement (seller_id, period_start, period_end, status, total_amount, created_at) VALUES (?, ?, ?, ?, ?, ?)`
+
+	res, err := tx.Exec(query, settlement.Seller_Id, settlement.Period_Start, settlement.Period_End, settlement.Status, settlement.Total_Amount, settlement.Created_At)
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Settlement{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Settlement{}, err
+	}
+
+	settlement.Id = int(id)
+
+	const lineQuery = `INSERT INTO settlement_line (settlement_id, order_details_id, quantity, unit_price, amount) VALUES (?, ?, ?, ?, ?)`
+
+	for i, l := range settlement.Lines {
+		res, err := tx.Exec(lineQuery, settlement.Id, l.Order_Details_Id, l.Quantity, l.Unit_Price, l.Amount)
+
+		if isDuplicateOrderLine(err) {
+			_ = tx.Rollback()
+			return domain.Settlement{}, usecases.ErrLinesAlreadySettled
+		}
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Settlement{}, err
+		}
+
+		lineId, err := res.LastInsertId()
+
+		if err != nil {
+			_ = tx.Rollback()
+			return domain.Settlement{}, err
+		}
+
+		settlement.Lines[i].Id = int(lineId)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Settlement{}, err
+	}
+
+	return settlement, nil
+}
+
+// isDuplicateOrderLine reports whether the insert lost the race for an order
+// line to a settlement created concurrently.
+func isDuplicateOrderLine(err error) bool {
+	var mysqlErr *mysql.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 && strings.Contains(mysqlErr.Message, "order_details_id_UNIQUE")
+}
+
+const settlementColumns = `id, seller_id, period_start, period_end, status, total_amount, created_at, approved_at, paid_at`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSettlement(row scanner) (domain.Settlement, error) {
+	s := domain.Settlement{}
+
+	err := row.Scan(&s.Id, &s.Seller_Id, &s.Period_Start, &s.Period_End, &s.Status, &s.Total_Amount, &s.Created_At, &s.Approved_At, &s.Paid_At)
+
+	return s, err
+}
+
+func (r *settlementMysqlRepository) GetAllBySeller(seller_id int) (domain.Settlements, error) {
+	rows, err := r.db.Query(`SELECT `+settlementColumns+` FROM settlement WHERE seller_id=? ORDER BY id`, seller_id)
+
+	if err != nil {
+		return domain.Settlements{}, err
+	}
+
+	defer rows.Close()
+
+	settlements := domain.Settlements{}
+
+	for rows.Next() {
+		s, err := scanSettlement(rows)
+
+		if err != nil {
+			return domain.Settlements{}, err
+		}
+
+		settlements = append(settlements, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Settlements{}, err
+	}
+
+	return settlements, nil
+}
+
+func (r *settlementMysqlRepository) GetById(seller_id int, id int) (domain.Settlement, error) {
+	settlement, err := scanSettlement(r.db.QueryRow(`SELECT `+settlementColumns+` FROM settlement WHERE seller_id=? AND id=?`, seller_id, id))
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Settlement{}, usecases.ErrNoElementFound
+	}
+
+	if err != nil {
+		return domain.Settlement{}, err
+	}
+
+	const query = `SELECT sl.id, od.id, po.id, po.order_date, p.id, p.description, sl.quantity, sl.unit_price, sl.amount FROM settlement_line sl
+	INNER JOIN order_details od ON od.id = sl.order_details_id
+	INNER JOIN purchase_order po ON po.id = od.purchase_order_id
+	INNER JOIN product_record pr ON pr.id = od.product_record_id
+	INNER JOIN product p ON p.id = pr.product_id
+	WHERE sl.settlement_id = ? ORDER BY sl.id`
+
+	rows, err := r.db.Query(query, id)
+
+	if err != nil {
+		return domain.Settlement{}, err
+	}
+
+	defer rows.Close()
+
+	settlement.Lines = domain.SettlementLines{}
+
+	for rows.Next() {
+		l := domain.SettlementLine{}
+
+		if err := rows.Scan(&l.Id, &l.Order_Details_Id, &l.Purchase_Order_Id, &l.Order_Date, &l.Product_Id, &l.Description, &l.Quantity, &l.Unit_Price, &l.Amount); err != nil {
+			return domain.Settlement{}, err
+		}
+
+		settlement.Lines = append(settlement.Lines, l)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Settlement{}, err
+	}
+
+	return settlement, nil
+}
+
+// UpdateStatus only moves the settlement if it is still in the from status.
+func (r *settlementMysqlRepository) UpdateStatus(id int, from string, to string, at string) error {
+	query := `UPDATE settlement SET status=?, approved_at=? WHERE id=? AND status=?`
+
+	if to == domain.StatusPaid {
+		query = `UPDATE settlement SET status=?, paid_at=? WHERE id=? AND status=?`
+	}
+
+	res, err := r.db.Exec(query, to, at, id, from)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return usecases.ErrStatusChanged
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package adapters_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+var settlementColumns = []string{"id", "seller_id", "period_start", "period_end", "status", "total_amount", "created_at", "approved_at", "paid_at"}
+
+func TestGetSettleableLines(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewSettlementMysqlRepository(db)
+
+	t.Run("Should return an error if query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT (.+) FROM order_details od").WillReturnError(errors.New("query_error"))
+
+		result, err := sut.GetSettleableLines(1, "2022-07-01", "2022-07-31")
+
+		assert.Equal(t, domain.SettlementLines{}, result)
+		assert.EqualError(t, err, "query_error")
+	})
+
+	t.Run("Should return only delivered lines not yet settled", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"od.id", "po.id", "po.order_date", "p.id", "p.description", "od.quantity", "pr.purchase_price"})
+		rows.AddRow(1, 1, "2022-07-01", 1, "Cafe", 3, "2.50")
+		mock.ExpectQuery("po.order_status_id = \\? AND DATE\\(po.order_date\\) BETWEEN \\? AND \\? AND sl.id IS NULL").
+			WithArgs(1, purchase.StatusDelivered, "2022-07-01", "2022-07-31").
+			WillReturnRows(rows)
+
+		result, err := sut.GetSettleableLines(1, "2022-07-01", "2022-07-31")
+
+		expected := domain.SettlementLines{
+			{Order_Details_Id: 1, Purchase_Order_Id: 1, Order_Date: "2022-07-01", Product_Id: 1, Description: "Cafe", Quantity: 3, Unit_Price: money.FromCents(250)},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestCreateSettlementRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewSettlementMysqlRepository(db)
+
+	settlement := makeSettlement()
+	settlement.Id = 0
+	settlement.Lines[0].Id = 0
+
+	t.Run("Should rollback if a line insert fails", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO settlement \\(").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO settlement_line").WillReturnError(errors.New("exec_error"))
+		mock.ExpectRollback()
+
+		result, err := sut.Create(settlement)
+
+		assert.Equal(t, domain.Settlement{}, result)
+		assert.EqualError(t, err, "exec_error")
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return ErrLinesAlreadySettled if another settlement took a line", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO settlement \\(").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO settlement_line").
+			WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1' for key 'order_details_id_UNIQUE'"})
+		mock.ExpectRollback()
+
+		result, err := sut.Create(settlement)
+
+		assert.Equal(t, domain.Settlement{}, result)
+		assert.ErrorIs(t, err, usecases.ErrLinesAlreadySettled)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should insert the settlement and its lines on success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO settlement \\(").
+			WithArgs(1, "2022-07-01", "2022-07-31", "draft", money.FromCents(750), "2022-08-01 10:00:00").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO settlement_line").
+			WithArgs(1, 1, 3, money.FromCents(250), money.FromCents(750)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		result, err := sut.Create(settlement)
+
+		assert.Equal(t, makeSettlement(), result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetSettlementByIdRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewSettlementMysqlRepository(db)
+
+	t.Run("Should return ErrNoElementFound if there is no settlement", func(t *testing.T) {
+		mock.ExpectQuery("FROM settlement WHERE seller_id=\\? AND id=\\?").WithArgs(1, 2).WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetById(1, 2)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
+
+	t.Run("Should return the settlement with its lines on success", func(t *testing.T) {
+		approved := "2022-08-02 10:00:00"
+		mock.ExpectQuery("FROM settlement WHERE seller_id=\\? AND id=\\?").
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows(settlementColumns).AddRow(1, 1, "2022-07-01", "2022-07-31", "approved", "7.50", "2022-08-01 10:00:00", approved, nil))
+		mock.ExpectQuery("FROM settlement_line sl").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"sl.id", "od.id", "po.id", "po.order_date", "p.id", "p.description", "sl.quantity", "sl.unit_price", "sl.amount"}).
+				AddRow(1, 1, 1, "2022-07-01", 1, "Cafe", 3, "2.50", "7.50"))
+
+		result, err := sut.GetById(1, 1)
+
+		expected := makeSettlement()
+		expected.Status = domain.StatusApproved
+		expected.Approved_At = &approved
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
+func TestUpdateStatusRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	sut := adapters.NewSettlementMysqlRepository(db)
+
+	t.Run("Should set paid_at when paying a settlement", func(t *testing.T) {
+		mock.ExpectExec("UPDATE settlement SET status=\\?, paid_at=\\? WHERE id=\\? AND status=\\?").
+			WithArgs("paid", "2022-08-03 10:00:00", 1, "approved").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := sut.UpdateStatus(1, "approved", "paid", "2022-08-03 10:00:00")
+
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return ErrStatusChanged if the settlement left the expected status", func(t *testing.T) {
+		mock.ExpectExec("UPDATE settlement SET status=\\?, approved_at=\\? WHERE id=\\? AND status=\\?").
+			WithArgs("approved", "2022-08-03 10:00:00", 1, "draft").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := sut.UpdateStatus(1, "draft", "approved", "2022-08-03 10:00:00")
+
+		assert.ErrorIs(t, err, usecases.ErrStatusChanged)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+
+const (
+	StatusDraft    = "draft"
+	StatusApproved = "approved"
+	StatusPaid     = "paid"
+)
+
+type SettlementLine struct {
+	Id                int         `json:"id"`
+	Order_Details_Id  int         `json:"order_details_id"`
+	Purchase_Order_Id int         `json:"purchase_order_id"`
+	Order_Date        string      `json:"order_date"`
+	Product_Id        int         `json:"product_id"`
+	Description       string      `json:"description"`
+	Quantity          int         `json:"quantity"`
+	Unit_Price        money.Money `json:"unit_price"`
+	Amount            money.Money `json:"amount"`
+}
+
+type SettlementLines []SettlementLine
+
+type Settlement struct {
+	Id           int             `json:"id"`
+	Seller_Id    int             `json:"seller_id"`
+	Period_Start string          `json:"period_start"`
+	Period_End   string          `json:"period_end"`
+	Status       string          `json:"status"`
+	Total_Amount money.Money     `json:"total_amount"`
+	Created_At   string          `json:"created_at"`
+	Approved_At  *string         `json:"approved_at"`
+	Paid_At      *string         `json:"paid_at"`
+	Lines        SettlementLines `json:"lines,omitempty"`
+}
+
+type Settlements []Settlement
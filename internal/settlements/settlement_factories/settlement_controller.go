@@ -0,0 +1,16 @@
+package settlement_factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases"
+)
+
+func MakeSettlementController() *adapters.SettlementController {
+	sr := adapters.NewSettlementMysqlRepository(db.GetInstance())
+	slr := adapters.NewSellerMysqlRepository(db.GetInstance())
+	ss := usecases.NewSettlementService(sr, slr)
+	sc := adapters.NewSettlementController(ss)
+
+	return sc
+}
@@ -0,0 +1,19 @@
+package usecases
+
+import "errors"
+
+var ErrNoElementFound = errors.New("settlement not found")
+
+var ErrSellerNotFound = errors.New("seller not found")
+
+var ErrInvalidDateRange = errors.New("from date must be before or equal to to date")
+
+var ErrNothingToSettle = errors.New("there are no delivered order lines to settle in this period")
+
+var ErrInvalidStatus = errors.New("status must be draft, approved or paid")
+
+var ErrInvalidStatusTransition = errors.New("settlements can only move from draft to approved and from approved to paid")
+
+var ErrStatusChanged = errors.New("settlement status was changed by another request")
+
+var ErrLinesAlreadySettled = errors.New("some order lines were settled by another request")
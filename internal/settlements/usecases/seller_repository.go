@@ -0,0 +1,5 @@
+package usecases
+
+type SellerRepository interface {
+	Exists(id int) (bool, error)
+}
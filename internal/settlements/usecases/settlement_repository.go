@@ -0,0 +1,11 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/domain"
+
+type SettlementRepository interface {
+	GetSettleableLines(seller_id int, from string, to string) (domain.SettlementLines, error)
+	Create(settlement domain.Settlement) (domain.Settlement, error)
+	GetAllBySeller(seller_id int) (domain.Settlements, error)
+	GetById(seller_id int, id int) (domain.Settlement, error)
+	UpdateStatus(id int, from string, to string, at string) error
+}
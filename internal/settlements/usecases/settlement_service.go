@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+)
+
+type SettlementService interface {
+	Create(seller_id int, from string, to string) (domain.Settlement, error)
+	GetAllBySeller(seller_id int) (domain.Settlements, error)
+	GetById(seller_id int, id int) (domain.Settlement, error)
+	UpdateStatus(seller_id int, id int, status string) (domain.Settlement, error)
+}
+
+type settlementService struct {
+	settlementRepository SettlementRepository
+	sellerRepository     SellerRepository
+}
+
+func NewSettlementService(r SettlementRepository, s SellerRepository) SettlementService {
+	return &settlementService{
+		settlementRepository: r,
+		sellerRepository:     s,
+	}
+}
+
+// next holds the only status each status may move to.
+var next = map[string]string{
+	domain.StatusDraft:    domain.StatusApproved,
+	domain.StatusApproved: domain.StatusPaid,
+}
+
+func (s *settlementService) checkSeller(seller_id int) error {
+	exists, err := s.sellerRepository.Exists(seller_id)
+
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrSellerNotFound
+	}
+
+	return nil
+}
+
+func (s *settlementService) Create(seller_id int, from string, to string) (domain.Settlement, error) {
+	if from > to {
+		return domain.Settlement{}, ErrInvalidDateRange
+	}
+
+	if err := s.checkSeller(seller_id); err != nil {
+		return domain.Settlement{}, err
+	}
+
+	lines, err := s.settlementRepository.GetSettleableLines(seller_id, from, to)
+
+	if err != nil {
+		return domain.Settlement{}, err
+	}
+
+	if len(lines) == 0 {
+		return domain.Settlement{}, ErrNothingToSettle
+	}
+
+	total := money.Money{}
+
+	for i := range lines {
+		lines[i].Amount = lines[i].Unit_Price.Mul(int64(lines[i].Quantity))
+		total = total.Add(lines[i].Amount)
+	}
+
+	settlement, err := s.settlementRepository.Create(domain.Settlement{
+		Seller_Id:    seller_id,
+		Period_Start: from,
+		Period_End:   to,
+		Status:       domain.StatusDraft,
+		Total_Amount: total,
+		Created_At:   time.Now().Format("2006-01-02 15:04:05"),
+		Lines:        lines,
+	})
+
+	if err != nil {
+		return domain.Settlement{}, err
+	}
+
+	return settlement, nil
+}
+
+func (s *settlementService) GetAllBySeller(seller_id int) (domain.Settlements, error) {
+	if err := s.checkSeller(seller_id); err != nil {
+		return domain.Settlements{}, err
+	}
+
+	return s.settlementRepository.GetAllBySeller(seller_id)
+}
+
+func (s *settlementService) GetById(seller_id int, id int) (domain.Settlement, error) {
+	return s.settlementRepository.GetById(seller_id, id)
+}
+
+func (s *settlementService) UpdateStatus(seller_id int, id int, status string) (domain.Settlement, error) {
+	if status != domain.StatusDraft && status != domain.StatusApproved && status != domain.StatusPaid {
+		return domain.Settlement{}, ErrInvalidStatus
+	}
+
+	settlement, err := s.settlementRepository.GetById(seller_id, id)
+
+	if err != nil {
+		return domain.Settlement{}, err
+	}
+
+	if next[settlement.Status] != status {
+		return domain.Settlement{}, ErrInvalidStatusTransition
+	}
+
+	at := time.Now().Format("2006-01-02 15:04:05")
+
+	if err := s.settlementRepository.UpdateStatus(id, settlement.Status, status, at); err != nil {
+		return domain.Settlement{}, err
+	}
+
+	settlement.Status = status
+
+	if status == domain.StatusApproved {
+		settlement.Approved_At = &at
+	} else {
+		settlement.Paid_At = &at
+	}
+
+	return settlement, nil
+}
@@ -0,0 +1,184 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/settlements/usecases/mocks"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeLines() domain.SettlementLines {
+	return domain.SettlementLines{
+		{Order_Details_Id: 1, Purchase_Order_Id: 1, Order_Date: "2022-07-01", Product_Id: 1, Description: "Cafe", Quantity: 3, Unit_Price: money.FromCents(250)},
+		{Order_Details_Id: 2, Purchase_Order_Id: 2, Order_Date: "2022-07-05", Product_Id: 2, Description: "Leite", Quantity: 2, Unit_Price: money.FromCents(199)},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("Should return ErrInvalidDateRange if from is after to", func(t *testing.T) {
+		service := usecases.NewSettlementService(mocks.NewSettlementRepository(t), mocks.NewSellerRepository(t))
+
+		_, err := service.Create(1, "2022-08-01", "2022-07-01")
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidDateRange)
+	})
+
+	t.Run("Should return ErrSellerNotFound if the seller does not exist", func(t *testing.T) {
+		mockSellerRepository := mocks.NewSellerRepository(t)
+		service := usecases.NewSettlementService(mocks.NewSettlementRepository(t), mockSellerRepository)
+		mockSellerRepository.On("Exists", 1).Return(false, nil).Once()
+
+		_, err := service.Create(1, "2022-07-01", "2022-07-31")
+
+		assert.ErrorIs(t, err, usecases.ErrSellerNotFound)
+	})
+
+	t.Run("Should return ErrNothingToSettle if there are no settleable lines", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		mockSellerRepository := mocks.NewSellerRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mockSellerRepository)
+		mockSellerRepository.On("Exists", 1).Return(true, nil).Once()
+		mockRepository.On("GetSettleableLines", 1, "2022-07-01", "2022-07-31").Return(domain.SettlementLines{}, nil).Once()
+
+		_, err := service.Create(1, "2022-07-01", "2022-07-31")
+
+		assert.ErrorIs(t, err, usecases.ErrNothingToSettle)
+	})
+
+	t.Run("Should return an error if Create from Settlement Repository returns an error", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		mockSellerRepository := mocks.NewSellerRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mockSellerRepository)
+		mockSellerRepository.On("Exists", 1).Return(true, nil).Once()
+		mockRepository.On("GetSettleableLines", 1, "2022-07-01", "2022-07-31").Return(makeLines(), nil).Once()
+		mockRepository.On("Create", mock.Anything).Return(domain.Settlement{}, errors.New("any_error")).Once()
+
+		_, err := service.Create(1, "2022-07-01", "2022-07-31")
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should create a draft settlement with the computed amounts on success", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		mockSellerRepository := mocks.NewSellerRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mockSellerRepository)
+		mockSellerRepository.On("Exists", 1).Return(true, nil).Once()
+		mockRepository.On("GetSettleableLines", 1, "2022-07-01", "2022-07-31").Return(makeLines(), nil).Once()
+		mockRepository.On("Create", mock.MatchedBy(func(s domain.Settlement) bool {
+			return s.Status == domain.StatusDraft &&
+				s.Total_Amount == money.FromCents(1148) &&
+				s.Lines[0].Amount == money.FromCents(750) &&
+				s.Lines[1].Amount == money.FromCents(398) &&
+				s.Created_At != ""
+		})).Return(domain.Settlement{Id: 1, Status: domain.StatusDraft, Total_Amount: money.FromCents(1148)}, nil).Once()
+
+		result, err := service.Create(1, "2022-07-01", "2022-07-31")
+
+		assert.Equal(t, domain.Settlement{Id: 1, Status: domain.StatusDraft, Total_Amount: money.FromCents(1148)}, result)
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetAllBySeller(t *testing.T) {
+	t.Run("Should return ErrSellerNotFound if the seller does not exist", func(t *testing.T) {
+		mockSellerRepository := mocks.NewSellerRepository(t)
+		service := usecases.NewSettlementService(mocks.NewSettlementRepository(t), mockSellerRepository)
+		mockSellerRepository.On("Exists", 1).Return(false, nil).Once()
+
+		_, err := service.GetAllBySeller(1)
+
+		assert.ErrorIs(t, err, usecases.ErrSellerNotFound)
+	})
+
+	t.Run("Should return the seller settlements on success", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		mockSellerRepository := mocks.NewSellerRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mockSellerRepository)
+		mockSellerRepository.On("Exists", 1).Return(true, nil).Once()
+		mockRepository.On("GetAllBySeller", 1).Return(domain.Settlements{{Id: 1, Seller_Id: 1}}, nil).Once()
+
+		result, err := service.GetAllBySeller(1)
+
+		assert.Equal(t, domain.Settlements{{Id: 1, Seller_Id: 1}}, result)
+		assert.Nil(t, err)
+	})
+}
+
+func TestUpdateStatus(t *testing.T) {
+	t.Run("Should return ErrInvalidStatus if the status is unknown", func(t *testing.T) {
+		service := usecases.NewSettlementService(mocks.NewSettlementRepository(t), mocks.NewSellerRepository(t))
+
+		_, err := service.UpdateStatus(1, 1, "cancelled")
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidStatus)
+	})
+
+	t.Run("Should return ErrNoElementFound if the settlement does not exist", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mocks.NewSellerRepository(t))
+		mockRepository.On("GetById", 1, 1).Return(domain.Settlement{}, usecases.ErrNoElementFound).Once()
+
+		_, err := service.UpdateStatus(1, 1, domain.StatusApproved)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
+
+	t.Run("Should return ErrInvalidStatusTransition if the transition is not allowed", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mocks.NewSellerRepository(t))
+
+		for _, tc := range []struct{ from, to string }{
+			{domain.StatusDraft, domain.StatusPaid},
+			{domain.StatusApproved, domain.StatusDraft},
+			{domain.StatusPaid, domain.StatusApproved},
+			{domain.StatusApproved, domain.StatusApproved},
+		} {
+			mockRepository.On("GetById", 1, 1).Return(domain.Settlement{Id: 1, Status: tc.from}, nil).Once()
+
+			_, err := service.UpdateStatus(1, 1, tc.to)
+
+			assert.ErrorIs(t, err, usecases.ErrInvalidStatusTransition, tc.from+" -> "+tc.to)
+		}
+	})
+
+	t.Run("Should approve a draft settlement", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mocks.NewSellerRepository(t))
+		mockRepository.On("GetById", 1, 1).Return(domain.Settlement{Id: 1, Status: domain.StatusDraft}, nil).Once()
+		mockRepository.On("UpdateStatus", 1, domain.StatusDraft, domain.StatusApproved, mock.AnythingOfType("string")).Return(nil).Once()
+
+		result, err := service.UpdateStatus(1, 1, domain.StatusApproved)
+
+		assert.Equal(t, domain.StatusApproved, result.Status)
+		assert.NotNil(t, result.Approved_At)
+		assert.Nil(t, result.Paid_At)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should pay an approved settlement", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mocks.NewSellerRepository(t))
+		mockRepository.On("GetById", 1, 1).Return(domain.Settlement{Id: 1, Status: domain.StatusApproved}, nil).Once()
+		mockRepository.On("UpdateStatus", 1, domain.StatusApproved, domain.StatusPaid, mock.AnythingOfType("string")).Return(errors.New("any_error")).Once()
+
+		_, err := service.UpdateStatus(1, 1, domain.StatusPaid)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should return ErrStatusChanged if another request moved the settlement first", func(t *testing.T) {
+		mockRepository := mocks.NewSettlementRepository(t)
+		service := usecases.NewSettlementService(mockRepository, mocks.NewSellerRepository(t))
+		mockRepository.On("GetById", 1, 1).Return(domain.Settlement{Id: 1, Status: domain.StatusDraft}, nil).Once()
+		mockRepository.On("UpdateStatus", 1, domain.StatusDraft, domain.StatusApproved, mock.AnythingOfType("string")).Return(usecases.ErrStatusChanged).Once()
+
+		_, err := service.UpdateStatus(1, 1, domain.StatusApproved)
+
+		assert.ErrorIs(t, err, usecases.ErrStatusChanged)
+	})
+}
@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases"
+)
+
+const dateTimeLayout = "2006-01-02 15:04:05"
+
+type TrackingController struct {
+	service usecases.TrackingService
+}
+
+func CreateTrackingController(ts usecases.TrackingService) *TrackingController {
+	return &TrackingController{
+		service: ts,
+	}
+}
+
+func (tc *TrackingController) CreateEvent(ctx *gin.Context) {
+	var req eventRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	occurredAt := strings.TrimSpace(req.OccurredAt)
+
+	if occurredAt == "" {
+		occurredAt = time.Now().Format(dateTimeLayout)
+	}
+
+	if _, err := time.Parse(dateTimeLayout, occurredAt); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "occurred_at must be in the format yyyy-mm-dd hh:mm:ss",
+		})
+		return
+	}
+
+	event, err := tc.service.AddEvent(ctx.Param("code"), req.EventType, occurredAt, req.Location, req.Description)
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"data": event,
+	})
+}
+
+func (tc *TrackingController) GetTimeline(ctx *gin.Context) {
+	timeline, err := tc.service.GetTimeline(ctx.Param("code"))
+
+	if err != nil {
+		respondError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data": timeline,
+	})
+}
+
+func respondError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecases.ErrInvalidTrackingCode):
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrOrderNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrOrderDelivered):
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, usecases.ErrInvalidEventType):
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	}
+}
+
+type eventRequest struct {
+	EventType   string  `json:"event_type" binding:"required"`
+	OccurredAt  string  `json:"occurred_at"`
+	Location    *string `json:"location"`
+	Description *string `json:"description"`
+}
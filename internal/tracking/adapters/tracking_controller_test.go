@@ -0,0 +1,114 @@
+package adapters_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeControllerSut(t *testing.T) (*gin.Engine, *mocks.TrackingService) {
+	gin.SetMode(gin.TestMode)
+	mockTrackingService := mocks.NewTrackingService(t)
+	sut := adapters.CreateTrackingController(mockTrackingService)
+	server := gin.Default()
+	server.GET("/tracking/:code", sut.GetTimeline)
+	server.POST("/tracking/:code/events", sut.CreateEvent)
+	return server, mockTrackingService
+}
+
+func TestCreateEvent(t *testing.T) {
+	t.Run("Should return 422 if event_type is missing", func(t *testing.T) {
+		server, _ := makeControllerSut(t)
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/tracking/MF000000014BR/events", bytes.NewBuffer([]byte(`{"location": "sao paulo"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Should return 400 if occurred_at is malformed", func(t *testing.T) {
+		server, _ := makeControllerSut(t)
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/tracking/MF000000014BR/events", bytes.NewBuffer([]byte(`{"event_type": "delivered", "occurred_at": "01/07/2022"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Should default occurred_at to now", func(t *testing.T) {
+		server, mockTrackingService := makeControllerSut(t)
+		mockTrackingService.On("AddEvent", "MF000000014BR", "picked_up", mock.AnythingOfType("string"), (*string)(nil), (*string)(nil)).Return(domain.Event{Id: 1}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/tracking/MF000000014BR/events", bytes.NewBuffer([]byte(`{"event_type": "picked_up"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Should map service errors to status codes", func(t *testing.T) {
+		cases := map[error]int{
+			usecases.ErrInvalidTrackingCode: http.StatusBadRequest,
+			usecases.ErrOrderNotFound:       http.StatusNotFound,
+			usecases.ErrOrderDelivered:      http.StatusConflict,
+			usecases.ErrInvalidEventType:    http.StatusUnprocessableEntity,
+			errors.New("any_error"):         http.StatusInternalServerError,
+		}
+
+		for err, status := range cases {
+			server, mockTrackingService := makeControllerSut(t)
+			mockTrackingService.On("AddEvent", "MF000000014BR", "delivered", "2022-07-01 10:00:00", mock.Anything, mock.Anything).Return(domain.Event{}, err).Once()
+			rr := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/tracking/MF000000014BR/events", bytes.NewBuffer([]byte(`{"event_type": "delivered", "occurred_at": "2022-07-01 10:00:00"}`)))
+			server.ServeHTTP(rr, req)
+
+			assert.Equal(t, status, rr.Code, err.Error())
+		}
+	})
+
+	t.Run("Should return 201 and the event on success", func(t *testing.T) {
+		server, mockTrackingService := makeControllerSut(t)
+		location := "sao paulo"
+		event := domain.Event{Id: 1, PurchaseOrderId: 1, EventType: "delivered", Location: &location, OccurredAt: "2022-07-01 10:00:00"}
+		mockTrackingService.On("AddEvent", "MF000000014BR", "delivered", "2022-07-01 10:00:00", &location, (*string)(nil)).Return(event, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/tracking/MF000000014BR/events", bytes.NewBuffer([]byte(`{"event_type": "delivered", "occurred_at": "2022-07-01 10:00:00", "location": "sao paulo"}`)))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Equal(t, "{\"data\":{\"id\":1,\"purchase_order_id\":1,\"event_type\":\"delivered\",\"location\":\"sao paulo\",\"description\":null,\"occurred_at\":\"2022-07-01 10:00:00\"}}", rr.Body.String())
+	})
+}
+
+func TestGetTimeline(t *testing.T) {
+	t.Run("Should return 404 if no order has the tracking code", func(t *testing.T) {
+		server, mockTrackingService := makeControllerSut(t)
+		mockTrackingService.On("GetTimeline", "MF000000014BR").Return(domain.Timeline{}, usecases.ErrOrderNotFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/tracking/MF000000014BR", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should return 200 and the timeline on success", func(t *testing.T) {
+		server, mockTrackingService := makeControllerSut(t)
+		timeline := domain.Timeline{TrackingCode: "MF000000014BR", PurchaseOrderId: 1, CarrierId: 2, OrderStatus: "shipped", Events: domain.Events{}}
+		mockTrackingService.On("GetTimeline", "MF000000014BR").Return(timeline, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/tracking/MF000000014BR", nil)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":{\"tracking_code\":\"MF000000014BR\",\"purchase_order_id\":1,\"carrier_id\":2,\"order_status\":\"shipped\",\"events\":[]}}", rr.Body.String())
+	})
+}
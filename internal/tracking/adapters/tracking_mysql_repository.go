@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases"
+)
+
+type trackingMySQLRepositoryAdapter struct {
+	db *sql.DB
+}
+
+func CreateTrackingMySQLRepository(db *sql.DB) usecases.TrackingRepository {
+	return &trackingMySQLRepositoryAdapter{
+		db: db,
+	}
+}
+
+func (r *trackingMySQLRepositoryAdapter) GetOrder(trackingCode string) (domain.Order, error) {
+	const query = `SELECT po.id, po.tracking_code, po.carrier_id, po.order_status_id, os.description FROM purchase_order po
+	INNER JOIN order_status os ON os.id = po.order_status_id
+	WHERE po.tracking_code=?`
+
+	o := domain.Order{}
+	err := r.db.QueryRow(query, trackingCode).Scan(&o.Id, &o.TrackingCode, &o.CarrierId, &o.OrderStatusId, &o.OrderStatus)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Order{}, usecases.ErrOrderNotFound
+	}
+
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	return o, nil
+}
+
+func (r *trackingMySQLRepositoryAdapter) GetEvents(purchaseOrderId int) (domain.Events, error) {
+	const query = `SELECT id, purchase_order_id, event_type, location, description, DATE_FORMAT(occurred_at, '%Y-%m-%d %H:%i:%s') FROM tracking_event
+	WHERE purchase_order_id=? ORDER BY occurred_at, id`
+
+	rows, err := r.db.Query(query, purchaseOrderId)
+
+	if err != nil {
+		return domain.Events{}, err
+	}
+
+	defer rows.Close()
+
+	es := domain.Events{}
+
+	for rows.Next() {
+		e := domain.Event{}
+
+		if err := rows.Scan(&e.Id, &e.PurchaseOrderId, &e.EventType, &e.Location, &e.Description, &e.OccurredAt); err != nil {
+			return domain.Events{}, err
+		}
+
+		es = append(es, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Events{}, err
+	}
+
+	return es, nil
+}
+
+// CreateEvent also moves the order to orderStatusId in the same transaction,
+// unless it is 0.
+func (r *trackingMySQLRepositoryAdapter) CreateEvent(event domain.Event, orderStatusId int) (domain.Event, error) {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	const query = `INSERT INTO tracking_event (purchase_order_id, event_type, location, description, occurred_at) VALUES (?, ?, ?, ?, ?)`
+
+	res, err := tx.Exec(query, event.PurchaseOrderId, event.EventType, event.Location, event.Description, event.OccurredAt)
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Event{}, err
+	}
+
+	id, err := res.LastInsertId()
+
+	if err != nil {
+		_ = tx.Rollback()
+		return domain.Event{}, err
+	}
+
+	if orderStatusId != 0 {
+		const statusQuery = `UPDATE purchase_order SET order_status_id=? WHERE id=?`
+
+		if _, err = tx.Exec(statusQuery, orderStatusId, event.PurchaseOrderId); err != nil {
+			_ = tx.Rollback()
+			return domain.Event{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Event{}, err
+	}
+
+	event.Id = int(id)
+
+	return event, nil
+}
@@ -0,0 +1,88 @@
+package adapters_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeRepositorySut(t *testing.T) (usecases.TrackingRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	return adapters.CreateTrackingMySQLRepository(db), mock
+}
+
+func TestRepositoryGetOrder(t *testing.T) {
+	t.Run("Should return ErrOrderNotFound if no order has the tracking code", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectQuery("FROM purchase_order po").WithArgs("MF000000014BR").WillReturnError(sql.ErrNoRows)
+
+		_, err := sut.GetOrder("MF000000014BR")
+
+		assert.ErrorIs(t, err, usecases.ErrOrderNotFound)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should return the order with its status description", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		rows := sqlmock.NewRows([]string{"id", "tracking_code", "carrier_id", "order_status_id", "description"}).AddRow(1, "MF000000014BR", 2, 1, "pending")
+		mock.ExpectQuery("FROM purchase_order po").WithArgs("MF000000014BR").WillReturnRows(rows)
+
+		result, err := sut.GetOrder("MF000000014BR")
+
+		assert.Equal(t, domain.Order{Id: 1, TrackingCode: "MF000000014BR", CarrierId: 2, OrderStatusId: 1, OrderStatus: "pending"}, result)
+		assert.Nil(t, err)
+	})
+}
+
+func TestRepositoryCreateEvent(t *testing.T) {
+	event := domain.Event{PurchaseOrderId: 1, EventType: "delivered", OccurredAt: "2022-07-01 10:00:00"}
+
+	t.Run("Should insert the event and update the order status in one transaction", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO tracking_event").WithArgs(1, "delivered", nil, nil, "2022-07-01 10:00:00").WillReturnResult(sqlmock.NewResult(7, 1))
+		mock.ExpectExec("UPDATE purchase_order SET order_status_id").WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		result, err := sut.CreateEvent(event, 3)
+
+		assert.Equal(t, 7, result.Id)
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should not touch the order when the status is 0", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO tracking_event").WillReturnResult(sqlmock.NewResult(8, 1))
+		mock.ExpectCommit()
+
+		_, err := sut.CreateEvent(event, 0)
+
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Should rollback if the status update fails", func(t *testing.T) {
+		sut, mock := makeRepositorySut(t)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO tracking_event").WillReturnResult(sqlmock.NewResult(9, 1))
+		mock.ExpectExec("UPDATE purchase_order SET order_status_id").WillReturnError(errors.New("any_error"))
+		mock.ExpectRollback()
+
+		_, err := sut.CreateEvent(event, 3)
+
+		assert.EqualError(t, err, "any_error")
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
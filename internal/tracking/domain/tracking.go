@@ -0,0 +1,36 @@
+package domain
+
+const (
+	PickedUp       = "picked_up"
+	InTransit      = "in_transit"
+	OutForDelivery = "out_for_delivery"
+	Delivered      = "delivered"
+	FailedAttempt  = "failed_attempt"
+)
+
+type Event struct {
+	Id              int     `json:"id"`
+	PurchaseOrderId int     `json:"purchase_order_id"`
+	EventType       string  `json:"event_type"`
+	Location        *string `json:"location"`
+	Description     *string `json:"description"`
+	OccurredAt      string  `json:"occurred_at"`
+}
+
+type Events []Event
+
+type Order struct {
+	Id            int
+	TrackingCode  string
+	CarrierId     int
+	OrderStatusId int
+	OrderStatus   string
+}
+
+type Timeline struct {
+	TrackingCode    string `json:"tracking_code"`
+	PurchaseOrderId int    `json:"purchase_order_id"`
+	CarrierId       int    `json:"carrier_id"`
+	OrderStatus     string `json:"order_status"`
+	Events          Events `json:"events"`
+}
@@ -0,0 +1,15 @@
+package factories
+
+import (
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/db"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/adapters"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases"
+)
+
+func MakeTrackingController() *adapters.TrackingController {
+	tr := adapters.CreateTrackingMySQLRepository(db.GetInstance())
+	ts := usecases.CreateTrackingService(tr)
+	tc := adapters.CreateTrackingController(ts)
+
+	return tc
+}
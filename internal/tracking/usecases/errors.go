@@ -0,0 +1,11 @@
+package usecases
+
+import "errors"
+
+var ErrInvalidTrackingCode = errors.New("tracking code is invalid")
+
+var ErrInvalidEventType = errors.New("event_type must be picked_up, in_transit, out_for_delivery, delivered or failed_attempt")
+
+var ErrOrderNotFound = errors.New("there is no purchase order with this tracking code")
+
+var ErrOrderDelivered = errors.New("purchase order was already delivered")
@@ -0,0 +1,9 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/domain"
+
+type TrackingRepository interface {
+	GetOrder(trackingCode string) (domain.Order, error)
+	GetEvents(purchaseOrderId int) (domain.Events, error)
+	CreateEvent(event domain.Event, orderStatusId int) (domain.Event, error)
+}
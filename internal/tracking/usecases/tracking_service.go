@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/tracking"
+)
+
+type TrackingService interface {
+	AddEvent(trackingCode string, eventType string, occurredAt string, location *string, description *string) (domain.Event, error)
+	GetTimeline(trackingCode string) (domain.Timeline, error)
+}
+
+type trackingService struct {
+	trackingRepository TrackingRepository
+}
+
+func CreateTrackingService(r TrackingRepository) TrackingService {
+	return &trackingService{
+		trackingRepository: r,
+	}
+}
+
+// statusFor returns the order status an event implies, or 0 for events that
+// don't move the order, like a failed delivery attempt.
+func statusFor(eventType string) (int, error) {
+	switch eventType {
+	case domain.PickedUp, domain.InTransit, domain.OutForDelivery:
+		return purchase.StatusShipped, nil
+	case domain.Delivered:
+		return purchase.StatusDelivered, nil
+	case domain.FailedAttempt:
+		return 0, nil
+	}
+
+	return 0, ErrInvalidEventType
+}
+
+func (s *trackingService) order(trackingCode string) (domain.Order, error) {
+	code, err := tracking.Normalize(trackingCode)
+
+	if err != nil {
+		return domain.Order{}, ErrInvalidTrackingCode
+	}
+
+	return s.trackingRepository.GetOrder(code)
+}
+
+// AddEvent records the event and advances the order to the status it implies,
+// never moving it back. Delivered orders take no further events.
+func (s *trackingService) AddEvent(trackingCode string, eventType string, occurredAt string, location *string, description *string) (domain.Event, error) {
+	status, err := statusFor(eventType)
+
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	order, err := s.order(trackingCode)
+
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	if order.OrderStatusId == purchase.StatusDelivered {
+		return domain.Event{}, ErrOrderDelivered
+	}
+
+	if status <= order.OrderStatusId {
+		status = 0
+	}
+
+	event := domain.Event{
+		PurchaseOrderId: order.Id,
+		EventType:       eventType,
+		Location:        location,
+		Description:     description,
+		OccurredAt:      occurredAt,
+	}
+
+	return s.trackingRepository.CreateEvent(event, status)
+}
+
+func (s *trackingService) GetTimeline(trackingCode string) (domain.Timeline, error) {
+	order, err := s.order(trackingCode)
+
+	if err != nil {
+		return domain.Timeline{}, err
+	}
+
+	events, err := s.trackingRepository.GetEvents(order.Id)
+
+	if err != nil {
+		return domain.Timeline{}, err
+	}
+
+	return domain.Timeline{
+		TrackingCode:    order.TrackingCode,
+		PurchaseOrderId: order.Id,
+		CarrierId:       order.CarrierId,
+		OrderStatus:     order.OrderStatus,
+		Events:          events,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	purchase "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/purchase_orders/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/domain"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/tracking/usecases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const trackingCode = "MF000000014BR"
+
+func makeOrder(statusId int) domain.Order {
+	return domain.Order{
+		Id:            1,
+		TrackingCode:  trackingCode,
+		CarrierId:     2,
+		OrderStatusId: statusId,
+		OrderStatus:   "pending",
+	}
+}
+
+func makeEvent(eventType string) domain.Event {
+	return domain.Event{
+		PurchaseOrderId: 1,
+		EventType:       eventType,
+		OccurredAt:      "2022-07-01 10:00:00",
+	}
+}
+
+func TestAddEvent(t *testing.T) {
+	makeSut := func() (usecases.TrackingService, *mocks.TrackingRepository) {
+		mockTrackingRepository := mocks.NewTrackingRepository(t)
+		sut := usecases.CreateTrackingService(mockTrackingRepository)
+		return sut, mockTrackingRepository
+	}
+
+	t.Run("Should return ErrInvalidEventType on unknown event types", func(t *testing.T) {
+		sut, _ := makeSut()
+
+		_, err := sut.AddEvent(trackingCode, "lost", "2022-07-01 10:00:00", nil, nil)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidEventType)
+	})
+
+	t.Run("Should return ErrInvalidTrackingCode if the check digit is wrong", func(t *testing.T) {
+		sut, _ := makeSut()
+
+		_, err := sut.AddEvent("MF000000015BR", domain.PickedUp, "2022-07-01 10:00:00", nil, nil)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidTrackingCode)
+	})
+
+	t.Run("Should return ErrOrderNotFound if no order has the tracking code", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(domain.Order{}, usecases.ErrOrderNotFound).Once()
+
+		_, err := sut.AddEvent(" mf000000014br", domain.PickedUp, "2022-07-01 10:00:00", nil, nil)
+
+		assert.ErrorIs(t, err, usecases.ErrOrderNotFound)
+	})
+
+	t.Run("Should return ErrOrderDelivered if the order was already delivered", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusDelivered), nil).Once()
+
+		_, err := sut.AddEvent(trackingCode, domain.FailedAttempt, "2022-07-01 10:00:00", nil, nil)
+
+		assert.ErrorIs(t, err, usecases.ErrOrderDelivered)
+	})
+
+	t.Run("Should move a pending order to shipped on pick up", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusPending), nil).Once()
+		mockTrackingRepository.On("CreateEvent", makeEvent(domain.PickedUp), purchase.StatusShipped).Return(domain.Event{Id: 1}, nil).Once()
+
+		result, err := sut.AddEvent(trackingCode, domain.PickedUp, "2022-07-01 10:00:00", nil, nil)
+
+		assert.Equal(t, domain.Event{Id: 1}, result)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should keep the status of a shipped order on transit events", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusShipped), nil).Once()
+		mockTrackingRepository.On("CreateEvent", makeEvent(domain.InTransit), 0).Return(domain.Event{Id: 2}, nil).Once()
+
+		_, err := sut.AddEvent(trackingCode, domain.InTransit, "2022-07-01 10:00:00", nil, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should not move the order on failed attempts", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusPending), nil).Once()
+		mockTrackingRepository.On("CreateEvent", makeEvent(domain.FailedAttempt), 0).Return(domain.Event{Id: 3}, nil).Once()
+
+		_, err := sut.AddEvent(trackingCode, domain.FailedAttempt, "2022-07-01 10:00:00", nil, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should mark the order as delivered on delivery", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusShipped), nil).Once()
+		mockTrackingRepository.On("CreateEvent", makeEvent(domain.Delivered), purchase.StatusDelivered).Return(domain.Event{Id: 4}, nil).Once()
+
+		_, err := sut.AddEvent(trackingCode, domain.Delivered, "2022-07-01 10:00:00", nil, nil)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return an error if CreateEvent from Tracking Repository returns an error", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusPending), nil).Once()
+		mockTrackingRepository.On("CreateEvent", mock.Anything, purchase.StatusDelivered).Return(domain.Event{}, errors.New("any_error")).Once()
+
+		_, err := sut.AddEvent(trackingCode, domain.Delivered, "2022-07-01 10:00:00", nil, nil)
+
+		assert.EqualError(t, err, "any_error")
+	})
+}
+
+func TestGetTimeline(t *testing.T) {
+	makeSut := func() (usecases.TrackingService, *mocks.TrackingRepository) {
+		mockTrackingRepository := mocks.NewTrackingRepository(t)
+		sut := usecases.CreateTrackingService(mockTrackingRepository)
+		return sut, mockTrackingRepository
+	}
+
+	t.Run("Should return ErrInvalidTrackingCode on malformed codes", func(t *testing.T) {
+		sut, _ := makeSut()
+
+		_, err := sut.GetTimeline("123")
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidTrackingCode)
+	})
+
+	t.Run("Should return an error if GetEvents from Tracking Repository returns an error", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusPending), nil).Once()
+		mockTrackingRepository.On("GetEvents", 1).Return(nil, errors.New("any_error")).Once()
+
+		_, err := sut.GetTimeline(trackingCode)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should return the order status and its events", func(t *testing.T) {
+		sut, mockTrackingRepository := makeSut()
+		events := domain.Events{makeEvent(domain.PickedUp)}
+		mockTrackingRepository.On("GetOrder", trackingCode).Return(makeOrder(purchase.StatusPending), nil).Once()
+		mockTrackingRepository.On("GetEvents", 1).Return(events, nil).Once()
+
+		result, err := sut.GetTimeline(trackingCode)
+
+		expected := domain.Timeline{
+			TrackingCode:    trackingCode,
+			PurchaseOrderId: 1,
+			CarrierId:       2,
+			OrderStatus:     "pending",
+			Events:          events,
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+	})
+}
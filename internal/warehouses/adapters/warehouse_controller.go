@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/warehouses/usecases"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/web"
 )
 
 type WarehouseController struct {
@@ -37,7 +38,7 @@ func (wc *WarehouseController) CreateWarehouse(ctx *gin.Context) {
 		return
 	}
 
-	warehouse, err := wc.service.Create(req.WarehouseCode, req.Address, req.Telephone, req.MinimumCapacity, req.MinimumTemperature)
+	warehouse, err := wc.service.Create(req.WarehouseCode, req.Address, req.Telephone, req.MinimumCapacity, req.MinimumTemperature, req.LocalityId)
 
 	if err == nil {
 		ctx.JSON(http.StatusCreated, gin.H{
@@ -53,13 +54,29 @@ func (wc *WarehouseController) CreateWarehouse(ctx *gin.Context) {
 		return
 	}
 
+	if errors.Is(err, usecases.ErrInvalidLocalityId) {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
 	ctx.JSON(http.StatusInternalServerError, gin.H{
 		"error": "internal server error",
 	})
 }
 
 func (wc *WarehouseController) GetAllWarehouses(ctx *gin.Context) {
-	warehouses, err := wc.service.GetAll()
+	includeDeleted, err := web.QueryBool(ctx, "include_deleted")
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "include_deleted must be true or false",
+		})
+		return
+	}
+
+	warehouses, err := wc.service.GetAll(includeDeleted)
 
 	if err == nil {
 		ctx.JSON(http.StatusOK, gin.H{
@@ -129,7 +146,7 @@ func (wc *WarehouseController) UpdateByIdWarehouse(ctx *gin.Context) {
 		return
 	}
 
-	warehouse, err := wc.service.UpdateById(id, req.WarehouseCode, req.Address, req.Telephone, req.MinimumCapacity, req.MinimumTemperature)
+	warehouse, err := wc.service.UpdateById(id, req.WarehouseCode, req.Address, req.Telephone, req.MinimumCapacity, req.MinimumTemperature, req.LocalityId)
 
 	if err == nil {
 		ctx.JSON(http.StatusOK, gin.H{
@@ -145,6 +162,13 @@ func (wc *WarehouseController) UpdateByIdWarehouse(ctx *gin.Context) {
 		return
 	}
 
+	if errors.Is(err, usecases.ErrInvalidLocalityId) {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
 	if errors.Is(err, usecases.ErrNoElementFound) {
 		ctx.JSON(http.StatusNotFound, gin.H{
 			"error": err.Error(),
@@ -167,7 +191,16 @@ func (wc *WarehouseController) DeleteByIdWarehouse(ctx *gin.Context) {
 		return
 	}
 
-	err = wc.service.DeleteById(id)
+	hard, err := web.QueryBool(ctx, "hard")
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "hard must be true or false",
+		})
+		return
+	}
+
+	err = wc.service.DeleteById(id, hard)
 
 	if err == nil {
 		ctx.JSON(http.StatusNoContent, gin.H{})
@@ -181,6 +214,75 @@ func (wc *WarehouseController) DeleteByIdWarehouse(ctx *gin.Context) {
 		return
 	}
 
+	if errors.Is(err, usecases.ErrWarehouseHasDependencies) {
+		ctx.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": "internal server error",
+	})
+}
+
+func (wc *WarehouseController) RestoreByIdWarehouse(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	warehouse, err := wc.service.RestoreById(id)
+
+	if err == nil {
+		ctx.JSON(http.StatusOK, gin.H{
+			"data": warehouse,
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrNoElementFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusInternalServerError, gin.H{
+		"error": "internal server error",
+	})
+}
+
+func (wc *WarehouseController) GetByLocalityIdWarehouses(ctx *gin.Context) {
+	localityId, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid id",
+		})
+		return
+	}
+
+	warehouses, err := wc.service.GetByLocalityId(localityId)
+
+	if err == nil {
+		ctx.JSON(http.StatusOK, gin.H{
+			"data": warehouses,
+		})
+		return
+	}
+
+	if errors.Is(err, usecases.ErrNoElementFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"error": "locality not found",
+		})
+		return
+	}
+
 	ctx.JSON(http.StatusInternalServerError, gin.H{
 		"error": "internal server error",
 	})
@@ -192,6 +294,7 @@ type warehouseRequest struct {
 	Telephone          string  `json:"telephone" binding:"required"`
 	MinimumCapacity    int     `json:"minimum_capacity" binding:"required"`
 	MinimumTemperature float64 `json:"minimum_temperature" binding:"required"`
+	LocalityId         int     `json:"locality_id" binding:"required"`
 }
 
 func (wr *warehouseRequest) Validate() error {
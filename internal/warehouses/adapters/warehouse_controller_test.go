@@ -41,7 +41,8 @@ func TestCreateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "(44) 9999-9999",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"warehouse_code can't be empty\"}",
@@ -53,7 +54,8 @@ func TestCreateWarehouse(t *testing.T) {
 					"address": "    ",
 					"telephone": "(44) 9999-9999",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"address can't be empty\"}",
@@ -65,7 +67,8 @@ func TestCreateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "  ",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"telephone can't be empty\"}",
@@ -77,7 +80,8 @@ func TestCreateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "999",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"telephone must respect the pattern (xx) xxxxx-xxxx or (xx) xxxx-xxxx\"}",
@@ -89,7 +93,8 @@ func TestCreateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "(44) 9999-9999",
 					"minimum_capacity": -10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"minimum_capacity must be greater than 0\"}",
@@ -104,7 +109,8 @@ func TestCreateWarehouse(t *testing.T) {
 			"address": "valid_address",
 			"telephone": "(44) 99909-9999",
 			"minimum_capacity": 10,
-			"minimum_temperature": 8.7
+			"minimum_temperature": 8.7,
+			"locality_id": 1
 		}
 	`))
 	}
@@ -113,7 +119,8 @@ func TestCreateWarehouse(t *testing.T) {
 			{
 				"warehouse_code": "XPTO",
 				"minimum_capacity": 10,
-				"minimum_temperature": 8.7
+				"minimum_temperature": 8.7,
+				"locality_id": 1
 			}
 		`))
 	}
@@ -125,6 +132,7 @@ func TestCreateWarehouse(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
 	t.Run("Should return an error and 422 status if body request contains unprocessable data", func(t *testing.T) {
@@ -151,17 +159,17 @@ func TestCreateWarehouse(t *testing.T) {
 
 	t.Run("Should call Create from Warehouse Service with correct values", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(makeDBWarehouse(), nil).Once()
+		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(makeDBWarehouse(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/warehouses", makeValidCreateBody())
 		r.ServeHTTP(rr, req)
 
-		mockWarehouseService.AssertCalled(t, "Create", "valid_code", "valid_address", "(44) 99909-9999", 10, 8.7)
+		mockWarehouseService.AssertCalled(t, "Create", "valid_code", "valid_address", "(44) 99909-9999", 10, 8.7, 1)
 	})
 
 	t.Run("Should return an error and 409 status if Warehouse code is in use", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(domain.Warehouse{}, usecases.ErrWarehouseCodeInUse).Once()
+		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(domain.Warehouse{}, usecases.ErrWarehouseCodeInUse).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/warehouses", makeValidCreateBody())
 		r.ServeHTTP(rr, req)
@@ -170,9 +178,20 @@ func TestCreateWarehouse(t *testing.T) {
 		assert.Equal(t, "{\"error\":\"this warehouse_code is already in use\"}", rr.Body.String())
 	})
 
+	t.Run("Should return an error and 422 status if locality_id is invalid", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(domain.Warehouse{}, usecases.ErrInvalidLocalityId).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses", makeValidCreateBody())
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "{\"error\":\"this locality_id is invalid\"}", rr.Body.String())
+	})
+
 	t.Run("Should return an error and 500 status if Create from Warehouse Service did not returns an custom error", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(domain.Warehouse{}, errors.New("any_message")).Once()
+		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(domain.Warehouse{}, errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/warehouses", makeValidCreateBody())
 		r.ServeHTTP(rr, req)
@@ -183,13 +202,13 @@ func TestCreateWarehouse(t *testing.T) {
 
 	t.Run("Should 201 status and data on success", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(makeDBWarehouse(), nil).Once()
+		mockWarehouseService.On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(makeDBWarehouse(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPost, "/warehouses", makeValidCreateBody())
 		r.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusCreated, rr.Code)
-		assert.Equal(t, "{\"data\":{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"valid_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5}}", rr.Body.String())
+		assert.Equal(t, "{\"data\":{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"valid_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5,\"locality_id\":1}}", rr.Body.String())
 	})
 }
 
@@ -213,11 +232,12 @@ func TestGetAllWarehouse(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
 	t.Run("Should call GetAll from Warehouse Service", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("GetAll").Return(domain.Warehouses{makeDBWarehouse()}, nil).Once()
+		mockWarehouseService.On("GetAll", false).Return(domain.Warehouses{makeDBWarehouse()}, nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/warehouses", nil)
 		r.ServeHTTP(rr, req)
@@ -227,7 +247,7 @@ func TestGetAllWarehouse(t *testing.T) {
 
 	t.Run("Should return an error and 500 status if GetAll from Warehouse Service returns an error", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("GetAll").Return(domain.Warehouses{}, errors.New("any_message")).Once()
+		mockWarehouseService.On("GetAll", false).Return(domain.Warehouses{}, errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/warehouses", nil)
 		r.ServeHTTP(rr, req)
@@ -238,13 +258,13 @@ func TestGetAllWarehouse(t *testing.T) {
 
 	t.Run("Should 200 status and data on success", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("GetAll").Return(domain.Warehouses{makeDBWarehouse()}, nil).Once()
+		mockWarehouseService.On("GetAll", false).Return(domain.Warehouses{makeDBWarehouse()}, nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodGet, "/warehouses", nil)
 		r.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, "{\"data\":[{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"valid_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5}]}", rr.Body.String())
+		assert.Equal(t, "{\"data\":[{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"valid_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5,\"locality_id\":1}]}", rr.Body.String())
 	})
 }
 
@@ -268,6 +288,7 @@ func TestGetByIdWarehouse(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
 	t.Run("Should return an error and 400 status if a invalid id is provided", func(t *testing.T) {
@@ -320,7 +341,7 @@ func TestGetByIdWarehouse(t *testing.T) {
 		r.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, "{\"data\":{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"valid_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5}}", rr.Body.String())
+		assert.Equal(t, "{\"data\":{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"valid_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5,\"locality_id\":1}}", rr.Body.String())
 	})
 }
 
@@ -349,7 +370,8 @@ func TestUpdateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "(44) 9999-9999",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"warehouse_code can't be empty\"}",
@@ -361,7 +383,8 @@ func TestUpdateWarehouse(t *testing.T) {
 					"address": "    ",
 					"telephone": "(44) 9999-9999",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"address can't be empty\"}",
@@ -373,7 +396,8 @@ func TestUpdateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "  ",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"telephone can't be empty\"}",
@@ -385,7 +409,8 @@ func TestUpdateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "999",
 					"minimum_capacity": 10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"telephone must respect the pattern (xx) xxxxx-xxxx or (xx) xxxx-xxxx\"}",
@@ -397,7 +422,8 @@ func TestUpdateWarehouse(t *testing.T) {
 					"address": "Rua Brasil 870",
 					"telephone": "(44) 9999-9999",
 					"minimum_capacity": -10,
-					"minimum_temperature": 8.7
+					"minimum_temperature": 8.7,
+					"locality_id": 1
 				}
 				`,
 				ExpectedResponseBody: "{\"error\":\"minimum_capacity must be greater than 0\"}",
@@ -412,6 +438,7 @@ func TestUpdateWarehouse(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
 	makeValidUpdateBody := func() *bytes.Buffer {
@@ -421,7 +448,8 @@ func TestUpdateWarehouse(t *testing.T) {
 			"address": "updated_address",
 			"telephone": "(44) 99909-9999",
 			"minimum_capacity": 10,
-			"minimum_temperature": 8.7
+			"minimum_temperature": 8.7,
+			"locality_id": 1
 		}
 	`))
 	}
@@ -430,7 +458,8 @@ func TestUpdateWarehouse(t *testing.T) {
 			{
 				"warehouse_code": "XPTO",
 				"minimum_capacity": 10,
-				"minimum_temperature": 8.7
+				"minimum_temperature": 8.7,
+				"locality_id": 1
 			}
 		`))
 	}
@@ -468,17 +497,17 @@ func TestUpdateWarehouse(t *testing.T) {
 
 	t.Run("Should call UpdateById from Warehouse Service with correct values", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(makeUpdatedDBWarehouse(), nil).Once()
+		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(makeUpdatedDBWarehouse(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/warehouses/1", makeValidUpdateBody())
 		r.ServeHTTP(rr, req)
 
-		mockWarehouseService.AssertCalled(t, "UpdateById", 1, "valid_code", "updated_address", "(44) 99909-9999", 10, 8.7)
+		mockWarehouseService.AssertCalled(t, "UpdateById", 1, "valid_code", "updated_address", "(44) 99909-9999", 10, 8.7, 1)
 	})
 
 	t.Run("Should return an error and 409 if warehouse_code is in use", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(domain.Warehouse{}, usecases.ErrWarehouseCodeInUse).Once()
+		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(domain.Warehouse{}, usecases.ErrWarehouseCodeInUse).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/warehouses/1", makeValidUpdateBody())
 		r.ServeHTTP(rr, req)
@@ -489,7 +518,7 @@ func TestUpdateWarehouse(t *testing.T) {
 
 	t.Run("Should return an error and 404 if can't find warehouse", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(domain.Warehouse{}, usecases.ErrNoElementFound).Once()
+		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(domain.Warehouse{}, usecases.ErrNoElementFound).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/warehouses/1", makeValidUpdateBody())
 		r.ServeHTTP(rr, req)
@@ -498,9 +527,20 @@ func TestUpdateWarehouse(t *testing.T) {
 		assert.Equal(t, "{\"error\":\"can't find element\"}", rr.Body.String())
 	})
 
+	t.Run("Should return an error and 422 status if locality_id is invalid", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(domain.Warehouse{}, usecases.ErrInvalidLocalityId).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPatch, "/warehouses/1", makeValidUpdateBody())
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "{\"error\":\"this locality_id is invalid\"}", rr.Body.String())
+	})
+
 	t.Run("Should return an error and 500 status if UpdateById from Warehouse Service did not returns an custom error", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(domain.Warehouse{}, errors.New("any_message")).Once()
+		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(domain.Warehouse{}, errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/warehouses/1", makeValidUpdateBody())
 		r.ServeHTTP(rr, req)
@@ -511,13 +551,13 @@ func TestUpdateWarehouse(t *testing.T) {
 
 	t.Run("Should 200 status and data on success", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).Return(makeUpdatedDBWarehouse(), nil).Once()
+		mockWarehouseService.On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).Return(makeUpdatedDBWarehouse(), nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodPatch, "/warehouses/1", makeValidUpdateBody())
 		r.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, "{\"data\":{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"updated_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5}}", rr.Body.String())
+		assert.Equal(t, "{\"data\":{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"updated_address\",\"telephone\":\"(99) 99999-9999\",\"minimum_capacity\":10,\"minimum_temperature\":5,\"locality_id\":1}}", rr.Body.String())
 	})
 }
 
@@ -546,17 +586,17 @@ func TestDeleteByIdWarehouse(t *testing.T) {
 
 	t.Run("Should call DeleteById from Warehouse Service with correct id", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int")).Return(nil).Once()
+		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int"), false).Return(nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/warehouses/1", nil)
 		r.ServeHTTP(rr, req)
 
-		mockWarehouseService.AssertCalled(t, "DeleteById", 1)
+		mockWarehouseService.AssertCalled(t, "DeleteById", 1, false)
 	})
 
 	t.Run("Should return an error and 404 status if DeleteById from Warehouse Service returns not find the correspondent element", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int")).Return(usecases.ErrNoElementFound).Once()
+		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int"), false).Return(usecases.ErrNoElementFound).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/warehouses/404", nil)
 		r.ServeHTTP(rr, req)
@@ -567,7 +607,7 @@ func TestDeleteByIdWarehouse(t *testing.T) {
 
 	t.Run("Should return an error and 500 status if DeleteById from Warehouse Service returns an error", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int")).Return(errors.New("any_message")).Once()
+		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int"), false).Return(errors.New("any_message")).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/warehouses/1", nil)
 		r.ServeHTTP(rr, req)
@@ -578,7 +618,7 @@ func TestDeleteByIdWarehouse(t *testing.T) {
 
 	t.Run("Should 200 status and data on success", func(t *testing.T) {
 		r, mockWarehouseService := makeSut()
-		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int")).Return(nil).Once()
+		mockWarehouseService.On("DeleteById", mock.AnythingOfType("int"), false).Return(nil).Once()
 		rr := httptest.NewRecorder()
 		req, _ := http.NewRequest(http.MethodDelete, "/warehouses/1", nil)
 		r.ServeHTTP(rr, req)
@@ -586,4 +626,115 @@ func TestDeleteByIdWarehouse(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, rr.Code)
 		assert.Empty(t, rr.Body.String())
 	})
+
+	t.Run("Should return an error and 409 status on a hard delete of a referenced warehouse", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("DeleteById", 1, true).Return(usecases.ErrWarehouseHasDependencies).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/warehouses/1?hard=true", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Should return an error and 400 status if hard is not a boolean", func(t *testing.T) {
+		r, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/warehouses/1?hard=yes", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"hard must be true or false\"}", rr.Body.String())
+	})
+}
+
+func TestRestoreByIdWarehouse(t *testing.T) {
+	makeSut := func() (*gin.Engine, *mocks.WarehouseService) {
+		gin.SetMode(gin.TestMode)
+
+		mockWarehouseService := mocks.NewWarehouseService(t)
+		sut := adapters.CreateWarehouseController(mockWarehouseService)
+
+		r := gin.Default()
+		r.POST("/warehouses/:id/restore", sut.RestoreByIdWarehouse)
+
+		return r, mockWarehouseService
+	}
+
+	t.Run("Should return an error and 404 status if there is no deleted warehouse", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("RestoreById", 1).Return(domain.Warehouse{}, usecases.ErrNoElementFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses/1/restore", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Should 200 status and data on success", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("RestoreById", 1).Return(domain.Warehouse{Id: 1, WarehouseCode: "valid_code"}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/warehouses/1/restore", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestGetByLocalityIdWarehouses(t *testing.T) {
+	makeSut := func() (*gin.Engine, *mocks.WarehouseService) {
+		gin.SetMode(gin.TestMode)
+
+		mockWarehouseService := mocks.NewWarehouseService(t)
+		sut := adapters.CreateWarehouseController(mockWarehouseService)
+
+		r := gin.Default()
+		r.GET("/localities/:id/warehouses", sut.GetByLocalityIdWarehouses)
+
+		return r, mockWarehouseService
+	}
+
+	t.Run("Should return an error and 400 status if a invalid id is provided", func(t *testing.T) {
+		r, _ := makeSut()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/localities/invalid_id/warehouses", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Equal(t, "{\"error\":\"invalid id\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 404 status if locality does not exist", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("GetByLocalityId", 1).Return(domain.Warehouses{}, usecases.ErrNoElementFound).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/localities/1/warehouses", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Equal(t, "{\"error\":\"locality not found\"}", rr.Body.String())
+	})
+
+	t.Run("Should return an error and 500 status if GetByLocalityId from Warehouse Service returns an error", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("GetByLocalityId", 1).Return(domain.Warehouses{}, errors.New("any_message")).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/localities/1/warehouses", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "{\"error\":\"internal server error\"}", rr.Body.String())
+	})
+
+	t.Run("Should 200 status and data on success", func(t *testing.T) {
+		r, mockWarehouseService := makeSut()
+		mockWarehouseService.On("GetByLocalityId", 1).Return(domain.Warehouses{{Id: 1, WarehouseCode: "valid_code", LocalityId: 1}}, nil).Once()
+		rr := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/localities/1/warehouses", nil)
+		r.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "{\"data\":[{\"id\":1,\"warehouse_code\":\"valid_code\",\"address\":\"\",\"telephone\":\"\",\"minimum_capacity\":0,\"minimum_temperature\":0,\"locality_id\":1}]}", rr.Body.String())
+	})
 }
@@ -1,6 +1,8 @@
 package adapters
 
 import (
+	"time"
+
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/warehouses/domain"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/warehouses/usecases"
 	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/store"
@@ -16,7 +18,7 @@ func CreateWarehouseFileRepository(file store.Store) usecases.WarehouseRepositor
 	}
 }
 
-func (r *warehouseFileRepositoryAdapter) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
+func (r *warehouseFileRepositoryAdapter) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
 	var ws domain.Warehouses
 	if err := r.file.Read(&ws); err != nil {
 		return domain.Warehouse{}, err
@@ -35,6 +37,7 @@ func (r *warehouseFileRepositoryAdapter) Create(warehouseCode string, address st
 		Telephone:          telephone,
 		MinimumCapacity:    minimumCapacity,
 		MinimumTemperature: minimumTemperature,
+		LocalityId:         localityId,
 	}
 	ws = append(ws, w)
 
@@ -44,12 +47,38 @@ func (r *warehouseFileRepositoryAdapter) Create(warehouseCode string, address st
 	return w, nil
 }
 
-func (r *warehouseFileRepositoryAdapter) GetAll() (domain.Warehouses, error) {
+func (r *warehouseFileRepositoryAdapter) GetAll(includeDeleted bool) (domain.Warehouses, error) {
 	var ws domain.Warehouses
 	if err := r.file.Read(&ws); err != nil {
 		return domain.Warehouses{}, err
 	}
-	return ws, nil
+
+	if includeDeleted {
+		return ws, nil
+	}
+
+	result := domain.Warehouses{}
+	for _, w := range ws {
+		if w.DeletedAt == nil {
+			result = append(result, w)
+		}
+	}
+	return result, nil
+}
+
+func (r *warehouseFileRepositoryAdapter) GetByLocalityId(localityId int) (domain.Warehouses, error) {
+	var ws domain.Warehouses
+	if err := r.file.Read(&ws); err != nil {
+		return domain.Warehouses{}, err
+	}
+
+	result := domain.Warehouses{}
+	for _, w := range ws {
+		if w.LocalityId == localityId && w.DeletedAt == nil {
+			result = append(result, w)
+		}
+	}
+	return result, nil
 }
 
 func (r *warehouseFileRepositoryAdapter) GetById(id int) (domain.Warehouse, error) {
@@ -60,7 +89,7 @@ func (r *warehouseFileRepositoryAdapter) GetById(id int) (domain.Warehouse, erro
 	}
 
 	for _, w := range ws {
-		if w.Id == id {
+		if w.Id == id && w.DeletedAt == nil {
 			return w, nil
 		}
 	}
@@ -83,7 +112,7 @@ func (r *warehouseFileRepositoryAdapter) GetByWarehouseCode(code string) (domain
 	return domain.Warehouse{}, usecases.ErrNoElementFound
 }
 
-func (r *warehouseFileRepositoryAdapter) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
+func (r *warehouseFileRepositoryAdapter) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
 	var ws domain.Warehouses
 	if err := r.file.Read(&ws); err != nil {
 		return domain.Warehouse{}, err
@@ -91,7 +120,7 @@ func (r *warehouseFileRepositoryAdapter) UpdateById(id int, warehouseCode string
 
 	result, updated := domain.Warehouse{}, false
 	for i, w := range ws {
-		if w.Id == id {
+		if w.Id == id && w.DeletedAt == nil {
 			ws[i], updated = domain.Warehouse{
 				Id:                 id,
 				WarehouseCode:      warehouseCode,
@@ -99,6 +128,7 @@ func (r *warehouseFileRepositoryAdapter) UpdateById(id int, warehouseCode string
 				Telephone:          telephone,
 				MinimumCapacity:    minimumCapacity,
 				MinimumTemperature: minimumTemperature,
+				LocalityId:         localityId,
 			}, true
 			result = ws[i]
 			break
@@ -124,7 +154,7 @@ func (r *warehouseFileRepositoryAdapter) DeleteById(id int) error {
 
 	deleted := false
 	for i, w := range ws {
-		if w.Id == id {
+		if w.Id == id && w.DeletedAt == nil {
 			newWs := domain.Warehouses{}
 			newWs = append(newWs, ws[:i]...)
 			newWs = append(newWs, ws[i+1:]...)
@@ -145,6 +175,43 @@ func (r *warehouseFileRepositoryAdapter) DeleteById(id int) error {
 	return nil
 }
 
+func (r *warehouseFileRepositoryAdapter) SoftDeleteById(id int) error {
+	deletedAt := time.Now().Format("2006-01-02 15:04:05")
+
+	return r.setDeletedAt(id, &deletedAt)
+}
+
+func (r *warehouseFileRepositoryAdapter) RestoreById(id int) error {
+	return r.setDeletedAt(id, nil)
+}
+
+// HasDependencies is always false as the file storage keeps no related records.
+func (r *warehouseFileRepositoryAdapter) HasDependencies(id int) (bool, error) {
+	return false, nil
+}
+
+func (r *warehouseFileRepositoryAdapter) setDeletedAt(id int, deletedAt *string) error {
+	var ws domain.Warehouses
+	if err := r.file.Read(&ws); err != nil {
+		return err
+	}
+
+	updated := false
+	for i, w := range ws {
+		if w.Id == id && (w.DeletedAt == nil) == (deletedAt != nil) {
+			ws[i].DeletedAt = deletedAt
+			updated = true
+			break
+		}
+	}
+
+	if !updated {
+		return usecases.ErrNoElementFound
+	}
+
+	return r.file.Write(ws)
+}
+
 func (r *warehouseFileRepositoryAdapter) lastId() (int, error) {
 	var ws domain.Warehouses
 	if err := r.file.Read(&ws); err != nil {
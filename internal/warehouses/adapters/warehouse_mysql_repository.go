@@ -18,16 +18,16 @@ func CreateWarehouseMySQLRepository(db *sql.DB) usecases.WarehouseRepository {
 	}
 }
 
-func (r *warehouseMySQLRepositoryAdapter) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
+func (r *warehouseMySQLRepositoryAdapter) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
 	tx, err := r.db.Begin()
 
 	if err != nil {
 		return domain.Warehouse{}, err
 	}
 
-	const query = `INSERT INTO warehouse (warehouse_code, address, telephone, minimum_capacity, minimum_temperature) VALUES (?, ?, ?, ?, ?)`
+	const query = `INSERT INTO warehouse (warehouse_code, address, telephone, minimum_capacity, minimum_temperature, locality_id) VALUES (?, ?, ?, ?, ?, ?)`
 
-	res, err := tx.Exec(query, warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+	res, err := tx.Exec(query, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 
 	if err != nil {
 		_ = tx.Rollback()
@@ -50,11 +50,16 @@ func (r *warehouseMySQLRepositoryAdapter) Create(warehouseCode string, address s
 		Telephone:          telephone,
 		MinimumCapacity:    minimumCapacity,
 		MinimumTemperature: minimumTemperature,
+		LocalityId:         localityId,
 	}, nil
 }
 
-func (r *warehouseMySQLRepositoryAdapter) GetAll() (domain.Warehouses, error) {
-	const query = `SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse`
+func (r *warehouseMySQLRepositoryAdapter) GetAll(includeDeleted bool) (domain.Warehouses, error) {
+	query := `SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id, deleted_at FROM warehouse`
+
+	if !includeDeleted {
+		query += ` WHERE deleted_at IS NULL`
+	}
 
 	rows, err := r.db.Query(query)
 
@@ -68,7 +73,37 @@ func (r *warehouseMySQLRepositoryAdapter) GetAll() (domain.Warehouses, error) {
 
 	for rows.Next() {
 		w := domain.Warehouse{}
-		rows.Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature)
+		rows.Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature, &w.LocalityId, &w.DeletedAt)
+		ws = append(ws, w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return domain.Warehouses{}, err
+	}
+
+	return ws, nil
+}
+
+func (r *warehouseMySQLRepositoryAdapter) GetByLocalityId(localityId int) (domain.Warehouses, error) {
+	const query = `SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse WHERE locality_id=? AND deleted_at IS NULL ORDER BY id`
+
+	rows, err := r.db.Query(query, localityId)
+
+	if err != nil {
+		return domain.Warehouses{}, err
+	}
+
+	defer rows.Close()
+
+	ws := domain.Warehouses{}
+
+	for rows.Next() {
+		w := domain.Warehouse{}
+
+		if err := rows.Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature, &w.LocalityId); err != nil {
+			return domain.Warehouses{}, err
+		}
+
 		ws = append(ws, w)
 	}
 
@@ -80,10 +115,10 @@ func (r *warehouseMySQLRepositoryAdapter) GetAll() (domain.Warehouses, error) {
 }
 
 func (r *warehouseMySQLRepositoryAdapter) GetById(id int) (domain.Warehouse, error) {
-	const query = `SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse WHERE id=?`
+	const query = `SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse WHERE id=? AND deleted_at IS NULL`
 
 	w := domain.Warehouse{}
-	err := r.db.QueryRow(query, id).Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature)
+	err := r.db.QueryRow(query, id).Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature, &w.LocalityId)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Warehouse{}, usecases.ErrNoElementFound
@@ -97,10 +132,10 @@ func (r *warehouseMySQLRepositoryAdapter) GetById(id int) (domain.Warehouse, err
 }
 
 func (r *warehouseMySQLRepositoryAdapter) GetByWarehouseCode(code string) (domain.Warehouse, error) {
-	const query = `SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse WHERE warehouse_code=?`
+	const query = `SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse WHERE warehouse_code=?`
 
 	w := domain.Warehouse{}
-	err := r.db.QueryRow(query, code).Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature)
+	err := r.db.QueryRow(query, code).Scan(&w.Id, &w.WarehouseCode, &w.Address, &w.Telephone, &w.MinimumCapacity, &w.MinimumTemperature, &w.LocalityId)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return domain.Warehouse{}, usecases.ErrNoElementFound
@@ -113,10 +148,10 @@ func (r *warehouseMySQLRepositoryAdapter) GetByWarehouseCode(code string) (domai
 	return w, nil
 }
 
-func (r *warehouseMySQLRepositoryAdapter) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
-	const query = `UPDATE warehouse SET warehouse_code=?, address=?, telephone=?, minimum_capacity=?, minimum_temperature=? WHERE id=?`
+func (r *warehouseMySQLRepositoryAdapter) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
+	const query = `UPDATE warehouse SET warehouse_code=?, address=?, telephone=?, minimum_capacity=?, minimum_temperature=?, locality_id=? WHERE id=? AND deleted_at IS NULL`
 
-	res, err := r.db.Exec(query, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, id)
+	res, err := r.db.Exec(query, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId, id)
 
 	if err != nil {
 		return domain.Warehouse{}, err
@@ -141,12 +176,47 @@ func (r *warehouseMySQLRepositoryAdapter) UpdateById(id int, warehouseCode strin
 		Telephone:          telephone,
 		MinimumCapacity:    minimumCapacity,
 		MinimumTemperature: minimumTemperature,
+		LocalityId:         localityId,
 	}, nil
 }
 
 func (r *warehouseMySQLRepositoryAdapter) DeleteById(id int) error {
 	const query = `DELETE FROM warehouse WHERE id=?`
 
+	return r.execOnWarehouse(query, id)
+}
+
+func (r *warehouseMySQLRepositoryAdapter) SoftDeleteById(id int) error {
+	const query = `UPDATE warehouse SET deleted_at=NOW() WHERE id=? AND deleted_at IS NULL`
+
+	return r.execOnWarehouse(query, id)
+}
+
+func (r *warehouseMySQLRepositoryAdapter) RestoreById(id int) error {
+	const query = `UPDATE warehouse SET deleted_at=NULL WHERE id=? AND deleted_at IS NOT NULL`
+
+	return r.execOnWarehouse(query, id)
+}
+
+func (r *warehouseMySQLRepositoryAdapter) HasDependencies(id int) (bool, error) {
+	const query = `SELECT
+	(SELECT COUNT(*) FROM section WHERE warehouse_id=?) +
+	(SELECT COUNT(*) FROM employee WHERE warehouse_id=?) +
+	(SELECT COUNT(*) FROM employee_assignment WHERE warehouse_id=?) +
+	(SELECT COUNT(*) FROM shift WHERE warehouse_id=?) +
+	(SELECT COUNT(*) FROM purchase_order WHERE warehouse_id=?) +
+	(SELECT COUNT(*) FROM inbound_order WHERE warehouse_id=?)`
+
+	count := 0
+
+	if err := r.db.QueryRow(query, id, id, id, id, id, id).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *warehouseMySQLRepositoryAdapter) execOnWarehouse(query string, id int) error {
 	res, err := r.db.Exec(query, id)
 
 	if err != nil {
@@ -13,8 +13,8 @@ import (
 )
 
 func TestCreate(t *testing.T) {
-	makeCreateParams := func() (string, string, string, int, float64) {
-		return "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0
+	makeCreateParams := func() (string, string, string, int, float64, int) {
+		return "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1
 	}
 
 	makeWarehouse := func() domain.Warehouse {
@@ -25,6 +25,7 @@ func TestCreate(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
 
@@ -138,6 +139,7 @@ func TestGetAll(t *testing.T) {
 				Telephone:          "valid_phone_1",
 				MinimumCapacity:    11,
 				MinimumTemperature: 1.8,
+				LocalityId:         1,
 			},
 			domain.Warehouse{
 				Id:                 2,
@@ -146,6 +148,7 @@ func TestGetAll(t *testing.T) {
 				Telephone:          "valid_phone_2",
 				MinimumCapacity:    12,
 				MinimumTemperature: 2.8,
+				LocalityId:         1,
 			},
 			domain.Warehouse{
 				Id:                 3,
@@ -154,6 +157,7 @@ func TestGetAll(t *testing.T) {
 				Telephone:          "valid_phone_3",
 				MinimumCapacity:    13,
 				MinimumTemperature: 3.8,
+				LocalityId:         1,
 			},
 		}
 	}
@@ -169,13 +173,13 @@ func TestGetAll(t *testing.T) {
 	}
 	t.Run("Should execute correct query in database", func(t *testing.T) {
 		sut, mock := makeSut()
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"})
-		rows.AddRow(1, "valid_code_1", "valid_address_1", "valid_phone_1", 11, 1.8)
-		rows.AddRow(2, "valid_code_2", "valid_address_2", "valid_phone_2", 12, 2.8)
-		rows.AddRow(3, "valid_code_3", "valid_address_3", "valid_phone_3", 13, 3.8)
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs().WillReturnRows(rows)
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "locality_id", "deleted_at"})
+		rows.AddRow(1, "valid_code_1", "valid_address_1", "valid_phone_1", 11, 1.8, 1, nil)
+		rows.AddRow(2, "valid_code_2", "valid_address_2", "valid_phone_2", 12, 2.8, 1, nil)
+		rows.AddRow(3, "valid_code_3", "valid_address_3", "valid_phone_3", 13, 3.8, 1, nil)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id, deleted_at FROM warehouse WHERE deleted_at IS NULL").WithArgs().WillReturnRows(rows)
 
-		sut.GetAll()
+		sut.GetAll(false)
 
 		err := mock.ExpectationsWereMet()
 		assert.Nil(t, err)
@@ -183,8 +187,8 @@ func TestGetAll(t *testing.T) {
 
 	t.Run("Should return error if query fails", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs().WillReturnError(errors.New("query_error"))
-		result, err := sut.GetAll()
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id, deleted_at FROM warehouse WHERE deleted_at IS NULL").WithArgs().WillReturnError(errors.New("query_error"))
+		result, err := sut.GetAll(false)
 
 		assert.Equal(t, domain.Warehouses{}, result)
 		assert.EqualError(t, err, "query_error")
@@ -195,13 +199,13 @@ func TestGetAll(t *testing.T) {
 
 	t.Run("Should return locality slice on success", func(t *testing.T) {
 		sut, mock := makeSut()
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"})
-		rows.AddRow(1, "valid_code_1", "valid_address_1", "valid_phone_1", 11, 1.8)
-		rows.AddRow(2, "valid_code_2", "valid_address_2", "valid_phone_2", 12, 2.8)
-		rows.AddRow(3, "valid_code_3", "valid_address_3", "valid_phone_3", 13, 3.8)
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs().WillReturnRows(rows)
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "locality_id", "deleted_at"})
+		rows.AddRow(1, "valid_code_1", "valid_address_1", "valid_phone_1", 11, 1.8, 1, nil)
+		rows.AddRow(2, "valid_code_2", "valid_address_2", "valid_phone_2", 12, 2.8, 1, nil)
+		rows.AddRow(3, "valid_code_3", "valid_address_3", "valid_phone_3", 13, 3.8, 1, nil)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id, deleted_at FROM warehouse WHERE deleted_at IS NULL").WithArgs().WillReturnRows(rows)
 
-		result, err := sut.GetAll()
+		result, err := sut.GetAll(false)
 
 		expected := makeGetAllReturn()
 		assert.Equal(t, expected, result)
@@ -212,6 +216,52 @@ func TestGetAll(t *testing.T) {
 	})
 }
 
+func TestGetByLocalityId(t *testing.T) {
+	makeSut := func() (usecases.WarehouseRepository, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+
+		sut := adapters.CreateWarehouseMySQLRepository(db)
+
+		return sut, mock
+	}
+
+	t.Run("Should return error if query fails", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse WHERE locality_id").WithArgs(1).WillReturnError(errors.New("query_error"))
+
+		result, err := sut.GetByLocalityId(1)
+
+		assert.Equal(t, domain.Warehouses{}, result)
+		assert.EqualError(t, err, "query_error")
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return warehouses of the locality on success", func(t *testing.T) {
+		sut, mock := makeSut()
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "locality_id"})
+		rows.AddRow(1, "valid_code_1", "valid_address_1", "valid_phone_1", 11, 1.8, 1)
+		rows.AddRow(3, "valid_code_3", "valid_address_3", "valid_phone_3", 13, 3.8, 1)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse WHERE locality_id").WithArgs(1).WillReturnRows(rows)
+
+		result, err := sut.GetByLocalityId(1)
+
+		expected := domain.Warehouses{
+			{Id: 1, WarehouseCode: "valid_code_1", Address: "valid_address_1", Telephone: "valid_phone_1", MinimumCapacity: 11, MinimumTemperature: 1.8, LocalityId: 1},
+			{Id: 3, WarehouseCode: "valid_code_3", Address: "valid_address_3", Telephone: "valid_phone_3", MinimumCapacity: 13, MinimumTemperature: 3.8, LocalityId: 1},
+		}
+		assert.Equal(t, expected, result)
+		assert.Nil(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+	})
+}
+
 func TestGetById(t *testing.T) {
 	makeWarehouse := func() domain.Warehouse {
 		return domain.Warehouse{
@@ -221,6 +271,7 @@ func TestGetById(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
 	makeSut := func() (usecases.WarehouseRepository, sqlmock.Sqlmock) {
@@ -236,9 +287,9 @@ func TestGetById(t *testing.T) {
 	t.Run("Should execute correct query in database", func(t *testing.T) {
 		sut, mock := makeSut()
 
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"})
-		rows.AddRow(1, "valid_code_1", "valid_address_1", "valid_phone_1", 11, 1.8)
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs(1).WillReturnRows(rows)
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "locality_id"})
+		rows.AddRow(1, "valid_code_1", "valid_address_1", "valid_phone_1", 11, 1.8, 1)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs(1).WillReturnRows(rows)
 
 		sut.GetById(1)
 
@@ -248,7 +299,7 @@ func TestGetById(t *testing.T) {
 
 	t.Run("Should return ErrNoElementFound if can't find element in database", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs(1).WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs(1).WillReturnError(sql.ErrNoRows)
 
 		result, err := sut.GetById(1)
 
@@ -261,7 +312,7 @@ func TestGetById(t *testing.T) {
 
 	t.Run("Should return error if query fails", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs(1).WillReturnError(errors.New("query_error"))
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs(1).WillReturnError(errors.New("query_error"))
 
 		result, err := sut.GetById(1)
 
@@ -274,9 +325,9 @@ func TestGetById(t *testing.T) {
 
 	t.Run("Should return an warehouse on success", func(t *testing.T) {
 		sut, mock := makeSut()
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"})
-		rows.AddRow(1, "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0)
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs(1).WillReturnRows(rows)
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "locality_id"})
+		rows.AddRow(1, "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs(1).WillReturnRows(rows)
 
 		result, err := sut.GetById(1)
 
@@ -297,6 +348,7 @@ func TestGetByWarehouseCode(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
 	makeSut := func() (usecases.WarehouseRepository, sqlmock.Sqlmock) {
@@ -312,9 +364,9 @@ func TestGetByWarehouseCode(t *testing.T) {
 	t.Run("Should execute correct query in database", func(t *testing.T) {
 		sut, mock := makeSut()
 
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"})
-		rows.AddRow(1, "valid_code", "valid_address_1", "valid_phone_1", 11, 1.8)
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs("valid_code").WillReturnRows(rows)
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "locality_id"})
+		rows.AddRow(1, "valid_code", "valid_address_1", "valid_phone_1", 11, 1.8, 1)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs("valid_code").WillReturnRows(rows)
 
 		sut.GetByWarehouseCode("valid_code")
 
@@ -324,7 +376,7 @@ func TestGetByWarehouseCode(t *testing.T) {
 
 	t.Run("Should return ErrNoElementFound if can't find element in database", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs("valid_code").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs("valid_code").WillReturnError(sql.ErrNoRows)
 
 		result, err := sut.GetByWarehouseCode("valid_code")
 
@@ -337,7 +389,7 @@ func TestGetByWarehouseCode(t *testing.T) {
 
 	t.Run("Should return error if query fails", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs("valid_code").WillReturnError(errors.New("query_error"))
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs("valid_code").WillReturnError(errors.New("query_error"))
 
 		result, err := sut.GetByWarehouseCode("valid_code")
 
@@ -350,9 +402,9 @@ func TestGetByWarehouseCode(t *testing.T) {
 
 	t.Run("Should return an warehouse on success", func(t *testing.T) {
 		sut, mock := makeSut()
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"})
-		rows.AddRow(1, "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0)
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WithArgs("valid_code").WillReturnRows(rows)
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "locality_id"})
+		rows.AddRow(1, "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1)
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WithArgs("valid_code").WillReturnRows(rows)
 
 		result, err := sut.GetByWarehouseCode("valid_code")
 
@@ -373,10 +425,11 @@ func TestUpdateById(t *testing.T) {
 			Telephone:          "(99) 99999-9999",
 			MinimumCapacity:    10,
 			MinimumTemperature: 5.0,
+			LocalityId:         1,
 		}
 	}
-	makeUpdateParams := func() (int, string, string, string, int, float64) {
-		return 1, "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0
+	makeUpdateParams := func() (int, string, string, string, int, float64, int) {
+		return 1, "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1
 	}
 	makeSut := func() (usecases.WarehouseRepository, sqlmock.Sqlmock) {
 		db, mock, err := sqlmock.New()
@@ -391,7 +444,7 @@ func TestUpdateById(t *testing.T) {
 
 	t.Run("Should execute correct query in database", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		sut.UpdateById(makeUpdateParams())
 
@@ -401,7 +454,7 @@ func TestUpdateById(t *testing.T) {
 
 	t.Run("Should return an error if query fails", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1).WillReturnError(errors.New("query_error"))
+		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1, 1).WillReturnError(errors.New("query_error"))
 
 		result, updateErr := sut.UpdateById(makeUpdateParams())
 
@@ -414,8 +467,8 @@ func TestUpdateById(t *testing.T) {
 
 	t.Run("Should return ErrNoElementFound if element did not exists in db", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1).WillReturnResult(sqlmock.NewResult(1, 0))
-		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature FROM warehouse").WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1, 1).WillReturnResult(sqlmock.NewResult(1, 0))
+		mock.ExpectQuery("SELECT id, warehouse_code, address, telephone, minimum_capacity,	minimum_temperature, locality_id FROM warehouse").WillReturnError(sql.ErrNoRows)
 
 		result, updateErr := sut.UpdateById(makeUpdateParams())
 
@@ -428,7 +481,7 @@ func TestUpdateById(t *testing.T) {
 
 	t.Run("Should return updated warehouse on success", func(t *testing.T) {
 		sut, mock := makeSut()
-		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE warehouse SET").WithArgs("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		result, updatedErr := sut.UpdateById(makeUpdateParams())
 
@@ -474,6 +527,21 @@ func TestDeleteById(t *testing.T) {
 		assert.EqualError(t, deleteErr, "query_error")
 	})
 
+	t.Run("Should hard delete a warehouse that was already soft deleted", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+
+		sut := adapters.CreateWarehouseMySQLRepository(db)
+		mock.ExpectExec("UPDATE warehouse SET deleted_at=NOW() WHERE id=? AND deleted_at IS NULL").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM warehouse WHERE id=?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.Nil(t, sut.SoftDeleteById(1))
+		assert.Nil(t, sut.DeleteById(1))
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("Should return ErrNoElementFound if element did not exists in db", func(t *testing.T) {
 		sut, mock := makeSut()
 		mock.ExpectExec("DELETE FROM warehouse").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 0))
@@ -498,3 +566,59 @@ func TestDeleteById(t *testing.T) {
 		assert.Nil(t, deleteErr)
 	})
 }
+
+func TestSoftDeleteById(t *testing.T) {
+	makeSut := func() (usecases.WarehouseRepository, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+
+		sut := adapters.CreateWarehouseMySQLRepository(db)
+
+		return sut, mock
+	}
+
+	t.Run("Should set deleted_at in database", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectExec("UPDATE warehouse SET deleted_at=NOW()").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		deleteErr := sut.SoftDeleteById(1)
+
+		err := mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+
+		assert.Nil(t, deleteErr)
+	})
+
+	t.Run("Should return ErrNoElementFound if element did not exists in db", func(t *testing.T) {
+		sut, mock := makeSut()
+		mock.ExpectExec("UPDATE warehouse SET deleted_at=NOW()").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		deleteErr := sut.SoftDeleteById(1)
+
+		err := mock.ExpectationsWereMet()
+		assert.Nil(t, err)
+
+		assert.Equal(t, deleteErr, usecases.ErrNoElementFound)
+	})
+}
+
+func TestHasDependencies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	sut := adapters.CreateWarehouseMySQLRepository(db)
+
+	rows := sqlmock.NewRows([]string{"count"})
+	rows.AddRow(0)
+	mock.ExpectQuery("SELECT (.+) FROM section WHERE warehouse_id").WithArgs(1, 1, 1, 1, 1, 1).WillReturnRows(rows)
+
+	result, err := sut.HasDependencies(1)
+
+	assert.False(t, result)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,7 @@
+package domain
+
+type Locality struct {
+	Id         int    `json:"id"`
+	Name       string `json:"name"`
+	ProvinceId int    `json:"province_id"`
+}
@@ -7,6 +7,8 @@ type Warehouse struct {
 	Telephone          string  `json:"telephone"`
 	MinimumCapacity    int     `json:"minimum_capacity"`
 	MinimumTemperature float64 `json:"minimum_temperature"`
+	LocalityId         int     `json:"locality_id"`
+	DeletedAt          *string `json:"deleted_at,omitempty"`
 }
 
 type Warehouses []Warehouse
@@ -9,7 +9,8 @@ import (
 
 func MakeWarehouseController() *adapters.WarehouseController {
 	wr := adapters.CreateWarehouseMySQLRepository(db.GetInstance())
-	ws := usecases.CreateWarehouseService(wr)
+	lr := adapters.CreateLocalityMySQLRepository(db.GetInstance())
+	ws := usecases.CreateWarehouseService(wr, lr)
 	wc := adapters.CreateWarehouseController(ws)
 
 	return wc
@@ -5,3 +5,7 @@ import "errors"
 var ErrWarehouseCodeInUse = errors.New("this warehouse_code is already in use")
 
 var ErrNoElementFound = errors.New("can't find element")
+
+var ErrInvalidLocalityId = errors.New("this locality_id is invalid")
+
+var ErrWarehouseHasDependencies = errors.New("warehouse has sections, employees or orders and can't be permanently deleted")
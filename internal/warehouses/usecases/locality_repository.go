@@ -0,0 +1,7 @@
+package usecases
+
+import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/warehouses/domain"
+
+type LocalityRepository interface {
+	GetById(id int) (domain.Locality, error)
+}
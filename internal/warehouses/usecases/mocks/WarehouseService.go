@@ -12,20 +12,20 @@ type WarehouseService struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: warehouseCode, address, telephone, minimumCapacity, minimumTemperature
-func (_m *WarehouseService) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
-	ret := _m.Called(warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+// Create provides a mock function with given fields: warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId
+func (_m *WarehouseService) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
+	ret := _m.Called(warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 
 	var r0 domain.Warehouse
-	if rf, ok := ret.Get(0).(func(string, string, string, int, float64) domain.Warehouse); ok {
-		r0 = rf(warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+	if rf, ok := ret.Get(0).(func(string, string, string, int, float64, int) domain.Warehouse); ok {
+		r0 = rf(warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 	} else {
 		r0 = ret.Get(0).(domain.Warehouse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, int, float64) error); ok {
-		r1 = rf(warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+	if rf, ok := ret.Get(1).(func(string, string, string, int, float64, int) error); ok {
+		r1 = rf(warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -33,13 +33,13 @@ func (_m *WarehouseService) Create(warehouseCode string, address string, telepho
 	return r0, r1
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *WarehouseService) DeleteById(id int) error {
-	ret := _m.Called(id)
+// DeleteById provides a mock function with given fields: id, hard
+func (_m *WarehouseService) DeleteById(id int, hard bool) error {
+	ret := _m.Called(id, hard)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(int, bool) error); ok {
+		r0 = rf(id, hard)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -47,13 +47,13 @@ func (_m *WarehouseService) DeleteById(id int) error {
 	return r0
 }
 
-// GetAll provides a mock function with given fields:
-func (_m *WarehouseService) GetAll() (domain.Warehouses, error) {
-	ret := _m.Called()
+// GetAll provides a mock function with given fields: includeDeleted
+func (_m *WarehouseService) GetAll(includeDeleted bool) (domain.Warehouses, error) {
+	ret := _m.Called(includeDeleted)
 
 	var r0 domain.Warehouses
-	if rf, ok := ret.Get(0).(func() domain.Warehouses); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(bool) domain.Warehouses); ok {
+		r0 = rf(includeDeleted)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(domain.Warehouses)
@@ -61,8 +61,8 @@ func (_m *WarehouseService) GetAll() (domain.Warehouses, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(bool) error); ok {
+		r1 = rf(includeDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -91,20 +91,64 @@ func (_m *WarehouseService) GetById(id int) (domain.Warehouse, error) {
 	return r0, r1
 }
 
-// UpdateById provides a mock function with given fields: id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature
-func (_m *WarehouseService) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
-	ret := _m.Called(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+// GetByLocalityId provides a mock function with given fields: localityId
+func (_m *WarehouseService) GetByLocalityId(localityId int) (domain.Warehouses, error) {
+	ret := _m.Called(localityId)
+
+	var r0 domain.Warehouses
+	if rf, ok := ret.Get(0).(func(int) domain.Warehouses); ok {
+		r0 = rf(localityId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.Warehouses)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(localityId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreById provides a mock function with given fields: id
+func (_m *WarehouseService) RestoreById(id int) (domain.Warehouse, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Warehouse
+	if rf, ok := ret.Get(0).(func(int) domain.Warehouse); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Warehouse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateById provides a mock function with given fields: id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId
+func (_m *WarehouseService) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
+	ret := _m.Called(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 
 	var r0 domain.Warehouse
-	if rf, ok := ret.Get(0).(func(int, string, string, string, int, float64) domain.Warehouse); ok {
-		r0 = rf(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+	if rf, ok := ret.Get(0).(func(int, string, string, string, int, float64, int) domain.Warehouse); ok {
+		r0 = rf(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 	} else {
 		r0 = ret.Get(0).(domain.Warehouse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string, string, string, int, float64) error); ok {
-		r1 = rf(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+	if rf, ok := ret.Get(1).(func(int, string, string, string, int, float64, int) error); ok {
+		r1 = rf(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,10 +3,14 @@ package usecases
 import "github.com/natpapa17/MercadoFresco-ASociedadeGo/internal/warehouses/domain"
 
 type WarehouseRepository interface {
-	Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error)
-	GetAll() (domain.Warehouses, error)
+	Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error)
+	GetAll(includeDeleted bool) (domain.Warehouses, error)
 	GetById(id int) (domain.Warehouse, error)
-	UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error)
+	UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error)
 	DeleteById(id int) error
+	SoftDeleteById(id int) error
+	RestoreById(id int) error
+	HasDependencies(id int) (bool, error)
 	GetByWarehouseCode(code string) (domain.Warehouse, error)
+	GetByLocalityId(localityId int) (domain.Warehouses, error)
 }
@@ -7,24 +7,38 @@ import (
 )
 
 type WarehouseService interface {
-	Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error)
-	GetAll() (domain.Warehouses, error)
+	Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error)
+	GetAll(includeDeleted bool) (domain.Warehouses, error)
 	GetById(id int) (domain.Warehouse, error)
-	UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error)
-	DeleteById(id int) error
+	UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error)
+	DeleteById(id int, hard bool) error
+	RestoreById(id int) (domain.Warehouse, error)
+	GetByLocalityId(localityId int) (domain.Warehouses, error)
 }
 
 type warehouseService struct {
 	warehouseRepository WarehouseRepository
+	localityRepository  LocalityRepository
 }
 
-func CreateWarehouseService(r WarehouseRepository) WarehouseService {
+func CreateWarehouseService(r WarehouseRepository, lr LocalityRepository) WarehouseService {
 	return &warehouseService{
 		warehouseRepository: r,
+		localityRepository:  lr,
 	}
 }
 
-func (s *warehouseService) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
+func (s *warehouseService) checkLocality(localityId int) error {
+	_, err := s.localityRepository.GetById(localityId)
+
+	if errors.Is(err, ErrNoElementFound) {
+		return ErrInvalidLocalityId
+	}
+
+	return err
+}
+
+func (s *warehouseService) Create(warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
 	hasWarehouseWithThisCode, err := s.warehouseRepository.GetByWarehouseCode(warehouseCode)
 
 	if hasWarehouseWithThisCode.Id != 0 {
@@ -35,7 +49,11 @@ func (s *warehouseService) Create(warehouseCode string, address string, telephon
 		return domain.Warehouse{}, err
 	}
 
-	warehouse, err := s.warehouseRepository.Create(warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+	if err := s.checkLocality(localityId); err != nil {
+		return domain.Warehouse{}, err
+	}
+
+	warehouse, err := s.warehouseRepository.Create(warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 
 	if err != nil {
 		return domain.Warehouse{}, err
@@ -44,8 +62,8 @@ func (s *warehouseService) Create(warehouseCode string, address string, telephon
 	return warehouse, nil
 }
 
-func (s *warehouseService) GetAll() (domain.Warehouses, error) {
-	warehouses, err := s.warehouseRepository.GetAll()
+func (s *warehouseService) GetAll(includeDeleted bool) (domain.Warehouses, error) {
+	warehouses, err := s.warehouseRepository.GetAll(includeDeleted)
 
 	if err != nil {
 		return domain.Warehouses{}, err
@@ -64,7 +82,7 @@ func (s *warehouseService) GetById(id int) (domain.Warehouse, error) {
 	return warehouse, nil
 }
 
-func (s *warehouseService) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64) (domain.Warehouse, error) {
+func (s *warehouseService) UpdateById(id int, warehouseCode string, address string, telephone string, minimumCapacity int, minimumTemperature float64, localityId int) (domain.Warehouse, error) {
 	hasWarehouseWithThisCode, err := s.warehouseRepository.GetByWarehouseCode(warehouseCode)
 
 	if hasWarehouseWithThisCode.Id != 0 && hasWarehouseWithThisCode.Id != id {
@@ -75,7 +93,11 @@ func (s *warehouseService) UpdateById(id int, warehouseCode string, address stri
 		return domain.Warehouse{}, err
 	}
 
-	warehouse, err := s.warehouseRepository.UpdateById(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature)
+	if err := s.checkLocality(localityId); err != nil {
+		return domain.Warehouse{}, err
+	}
+
+	warehouse, err := s.warehouseRepository.UpdateById(id, warehouseCode, address, telephone, minimumCapacity, minimumTemperature, localityId)
 
 	if err != nil {
 		return domain.Warehouse{}, err
@@ -84,8 +106,23 @@ func (s *warehouseService) UpdateById(id int, warehouseCode string, address stri
 	return warehouse, nil
 }
 
-func (s *warehouseService) DeleteById(id int) error {
-	err := s.warehouseRepository.DeleteById(id)
+// DeleteById refuses a hard delete while other records use the warehouse.
+func (s *warehouseService) DeleteById(id int, hard bool) error {
+	if !hard {
+		return s.warehouseRepository.SoftDeleteById(id)
+	}
+
+	hasDependencies, err := s.warehouseRepository.HasDependencies(id)
+
+	if err != nil {
+		return err
+	}
+
+	if hasDependencies {
+		return ErrWarehouseHasDependencies
+	}
+
+	err = s.warehouseRepository.DeleteById(id)
 
 	if err != nil {
 		return err
@@ -93,3 +130,19 @@ func (s *warehouseService) DeleteById(id int) error {
 
 	return nil
 }
+
+func (s *warehouseService) RestoreById(id int) (domain.Warehouse, error) {
+	if err := s.warehouseRepository.RestoreById(id); err != nil {
+		return domain.Warehouse{}, err
+	}
+
+	return s.warehouseRepository.GetById(id)
+}
+
+func (s *warehouseService) GetByLocalityId(localityId int) (domain.Warehouses, error) {
+	if _, err := s.localityRepository.GetById(localityId); err != nil {
+		return domain.Warehouses{}, err
+	}
+
+	return s.warehouseRepository.GetByLocalityId(localityId)
+}
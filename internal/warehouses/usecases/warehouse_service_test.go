@@ -14,12 +14,14 @@ import (
 func TestCreate(t *testing.T) {
 	makeSut := func() (usecases.WarehouseService, *mocks.WarehouseRepository) {
 		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
-		sut := usecases.CreateWarehouseService(mockWarehouseRepository)
+		mockLocalityRepository := mocks.NewLocalityRepository(t)
+		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(domain.Locality{Id: 1}, nil).Maybe()
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mockLocalityRepository)
 
 		return sut, mockWarehouseRepository
 	}
-	makeCreateParams := func() (string, string, string, int, float64) {
-		return "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0
+	makeCreateParams := func() (string, string, string, int, float64, int) {
+		return "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1
 	}
 	makeWarehouse := func() domain.Warehouse {
 		return domain.Warehouse{
@@ -38,7 +40,7 @@ func TestCreate(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(makeWarehouse(), nil).
 			Once()
 
@@ -72,12 +74,12 @@ func TestCreate(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(makeWarehouse(), nil).Once()
 
 		sut.Create(makeCreateParams())
 
-		mockWarehouseRepository.AssertCalled(t, "Create", "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0)
+		mockWarehouseRepository.AssertCalled(t, "Create", "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1)
 	})
 
 	t.Run("Should return error if Create from Warehouse Repository returns an error", func(t *testing.T) {
@@ -87,7 +89,7 @@ func TestCreate(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(domain.Warehouse{}, errors.New("any_error")).Once()
 		_, err := sut.Create(makeCreateParams())
 
@@ -101,7 +103,7 @@ func TestCreate(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("Create", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(makeWarehouse(), nil).
 			Once()
 
@@ -115,7 +117,7 @@ func TestCreate(t *testing.T) {
 func TestGetAll(t *testing.T) {
 	makeSut := func() (usecases.WarehouseService, *mocks.WarehouseRepository) {
 		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
-		sut := usecases.CreateWarehouseService(mockWarehouseRepository)
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mocks.NewLocalityRepository(t))
 
 		return sut, mockWarehouseRepository
 	}
@@ -132,23 +134,23 @@ func TestGetAll(t *testing.T) {
 	t.Run("Should call GetAll from Warehouse Repository", func(t *testing.T) {
 		sut, mockWarehouseRepository := makeSut()
 		mockWarehouseRepository.
-			On("GetAll").
+			On("GetAll", false).
 			Return(domain.Warehouses{makeWarehouse()}, nil).
 			Once()
 
-		sut.GetAll()
+		sut.GetAll(false)
 
-		mockWarehouseRepository.AssertCalled(t, "GetAll")
+		mockWarehouseRepository.AssertCalled(t, "GetAll", false)
 	})
 
 	t.Run("Should return an error if GetAll from Warehouse Repository returns an error", func(t *testing.T) {
 		sut, mockWarehouseRepository := makeSut()
 		mockWarehouseRepository.
-			On("GetAll").
+			On("GetAll", false).
 			Return(domain.Warehouses{}, errors.New("any_error")).
 			Once()
 
-		_, err := sut.GetAll()
+		_, err := sut.GetAll(false)
 
 		assert.EqualError(t, err, "any_error")
 	})
@@ -156,11 +158,11 @@ func TestGetAll(t *testing.T) {
 	t.Run("Should return an slice of Warehouses on success", func(t *testing.T) {
 		sut, mockWarehouseRepository := makeSut()
 		mockWarehouseRepository.
-			On("GetAll").
+			On("GetAll", false).
 			Return(domain.Warehouses{makeWarehouse()}, nil).
 			Once()
 
-		ws, err := sut.GetAll()
+		ws, err := sut.GetAll(false)
 
 		assert.Equal(t, domain.Warehouses{makeWarehouse()}, ws)
 		assert.Nil(t, err)
@@ -170,7 +172,7 @@ func TestGetAll(t *testing.T) {
 func TestGetById(t *testing.T) {
 	makeSut := func() (usecases.WarehouseService, *mocks.WarehouseRepository) {
 		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
-		sut := usecases.CreateWarehouseService(mockWarehouseRepository)
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mocks.NewLocalityRepository(t))
 
 		return sut, mockWarehouseRepository
 	}
@@ -225,12 +227,14 @@ func TestGetById(t *testing.T) {
 func TestUpdateById(t *testing.T) {
 	makeSut := func() (usecases.WarehouseService, *mocks.WarehouseRepository) {
 		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
-		sut := usecases.CreateWarehouseService(mockWarehouseRepository)
+		mockLocalityRepository := mocks.NewLocalityRepository(t)
+		mockLocalityRepository.On("GetById", mock.AnythingOfType("int")).Return(domain.Locality{Id: 1}, nil).Maybe()
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mockLocalityRepository)
 
 		return sut, mockWarehouseRepository
 	}
-	makeUpdateByIdParams := func() (int, string, string, string, int, float64) {
-		return 1, "valid_code", "updated_address", "(99) 99999-9999", 20, 15.0
+	makeUpdateByIdParams := func() (int, string, string, string, int, float64, int) {
+		return 1, "valid_code", "updated_address", "(99) 99999-9999", 20, 15.0, 1
 	}
 	makeUpdatedWarehouse := func() domain.Warehouse {
 		return domain.Warehouse{
@@ -259,7 +263,7 @@ func TestUpdateById(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(makeUpdatedWarehouse(), nil).
 			Once()
 
@@ -295,12 +299,12 @@ func TestUpdateById(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(makeUpdatedWarehouse(), nil).Once()
 
 		sut.UpdateById(makeUpdateByIdParams())
 
-		mockWarehouseRepository.AssertCalled(t, "UpdateById", 1, "valid_code", "updated_address", "(99) 99999-9999", 20, 15.0)
+		mockWarehouseRepository.AssertCalled(t, "UpdateById", 1, "valid_code", "updated_address", "(99) 99999-9999", 20, 15.0, 1)
 	})
 
 	t.Run("Should return error if UpdateById from Warehouse Repository returns an error", func(t *testing.T) {
@@ -310,7 +314,7 @@ func TestUpdateById(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(domain.Warehouse{}, errors.New("any_error")).Once()
 		_, err := sut.UpdateById(makeUpdateByIdParams())
 
@@ -324,7 +328,7 @@ func TestUpdateById(t *testing.T) {
 			Return(domain.Warehouse{}, usecases.ErrNoElementFound).
 			Once()
 		mockWarehouseRepository.
-			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64")).
+			On("UpdateById", mock.AnythingOfType("int"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("int"), mock.AnythingOfType("float64"), mock.AnythingOfType("int")).
 			Return(makeUpdatedWarehouse(), nil).
 			Once()
 
@@ -338,43 +342,161 @@ func TestUpdateById(t *testing.T) {
 func TestDeleteById(t *testing.T) {
 	makeSut := func() (usecases.WarehouseService, *mocks.WarehouseRepository) {
 		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
-		sut := usecases.CreateWarehouseService(mockWarehouseRepository)
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mocks.NewLocalityRepository(t))
 
 		return sut, mockWarehouseRepository
 	}
-	t.Run("Should call DeleteById from Warehouse Repository with correct id", func(t *testing.T) {
+	t.Run("Should call SoftDeleteById from Warehouse Repository if hard is not set", func(t *testing.T) {
 		sut, mockWarehouseRepository := makeSut()
 		mockWarehouseRepository.
-			On("DeleteById", mock.AnythingOfType("int")).
+			On("SoftDeleteById", mock.AnythingOfType("int")).
 			Return(nil).
 			Once()
 
-		sut.DeleteById(1)
+		sut.DeleteById(1, false)
+
+		mockWarehouseRepository.AssertCalled(t, "SoftDeleteById", 1)
+		mockWarehouseRepository.AssertNotCalled(t, "DeleteById", mock.Anything)
+	})
+
+	t.Run("Should return an error if SoftDeleteById from Warehouse Repository returns an error", func(t *testing.T) {
+		sut, mockWarehouseRepository := makeSut()
+		mockWarehouseRepository.
+			On("SoftDeleteById", mock.AnythingOfType("int")).
+			Return(errors.New("any_error")).
+			Once()
+
+		err := sut.DeleteById(1, false)
+
+		assert.EqualError(t, err, "any_error")
+	})
+
+	t.Run("Should return ErrWarehouseHasDependencies on a hard delete of a referenced warehouse", func(t *testing.T) {
+		sut, mockWarehouseRepository := makeSut()
+		mockWarehouseRepository.On("HasDependencies", 1).Return(true, nil).Once()
+
+		err := sut.DeleteById(1, true)
 
-		mockWarehouseRepository.AssertCalled(t, "DeleteById", 1)
+		assert.ErrorIs(t, err, usecases.ErrWarehouseHasDependencies)
+		mockWarehouseRepository.AssertNotCalled(t, "DeleteById", mock.Anything)
 	})
 
 	t.Run("Should return an error if DeleteById from Warehouse Repository returns an error", func(t *testing.T) {
 		sut, mockWarehouseRepository := makeSut()
+		mockWarehouseRepository.On("HasDependencies", 1).Return(false, nil).Once()
 		mockWarehouseRepository.
 			On("DeleteById", mock.AnythingOfType("int")).
 			Return(errors.New("any_error")).
 			Once()
 
-		err := sut.DeleteById(1)
+		err := sut.DeleteById(1, true)
 
 		assert.EqualError(t, err, "any_error")
 	})
 
 	t.Run("Should return nil on success", func(t *testing.T) {
 		sut, mockWarehouseRepository := makeSut()
+		mockWarehouseRepository.On("HasDependencies", 1).Return(false, nil).Once()
 		mockWarehouseRepository.
 			On("DeleteById", mock.AnythingOfType("int")).
 			Return(nil).
 			Once()
 
-		err := sut.DeleteById(1)
+		err := sut.DeleteById(1, true)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestRestoreById(t *testing.T) {
+	makeSut := func() (usecases.WarehouseService, *mocks.WarehouseRepository) {
+		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mocks.NewLocalityRepository(t))
+
+		return sut, mockWarehouseRepository
+	}
+	t.Run("Should return an error if RestoreById from Warehouse Repository returns an error", func(t *testing.T) {
+		sut, mockWarehouseRepository := makeSut()
+		mockWarehouseRepository.On("RestoreById", 1).Return(usecases.ErrNoElementFound).Once()
+
+		_, err := sut.RestoreById(1)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
+
+	t.Run("Should return the restored warehouse on success", func(t *testing.T) {
+		sut, mockWarehouseRepository := makeSut()
+		mockWarehouseRepository.On("RestoreById", 1).Return(nil).Once()
+		mockWarehouseRepository.On("GetById", 1).Return(domain.Warehouse{Id: 1, WarehouseCode: "valid_code"}, nil).Once()
+
+		w, err := sut.RestoreById(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "valid_code", w.WarehouseCode)
+	})
+}
+
+func TestLocalityValidation(t *testing.T) {
+	makeSut := func() (usecases.WarehouseService, *mocks.WarehouseRepository, *mocks.LocalityRepository) {
+		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
+		mockLocalityRepository := mocks.NewLocalityRepository(t)
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mockLocalityRepository)
+
+		return sut, mockWarehouseRepository, mockLocalityRepository
+	}
+
+	t.Run("Should return ErrInvalidLocalityId on Create if the locality doesn't exist", func(t *testing.T) {
+		sut, mockWarehouseRepository, mockLocalityRepository := makeSut()
+		mockWarehouseRepository.On("GetByWarehouseCode", "valid_code").Return(domain.Warehouse{}, usecases.ErrNoElementFound).Once()
+		mockLocalityRepository.On("GetById", 9).Return(domain.Locality{}, usecases.ErrNoElementFound).Once()
+
+		_, err := sut.Create("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 9)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidLocalityId)
+	})
+
+	t.Run("Should return ErrInvalidLocalityId on UpdateById if the locality doesn't exist", func(t *testing.T) {
+		sut, mockWarehouseRepository, mockLocalityRepository := makeSut()
+		mockWarehouseRepository.On("GetByWarehouseCode", "valid_code").Return(domain.Warehouse{Id: 1}, nil).Once()
+		mockLocalityRepository.On("GetById", 9).Return(domain.Locality{}, usecases.ErrNoElementFound).Once()
+
+		_, err := sut.UpdateById(1, "valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 9)
+
+		assert.ErrorIs(t, err, usecases.ErrInvalidLocalityId)
+	})
+
+	t.Run("Should return error if GetById from Locality Repository fails", func(t *testing.T) {
+		sut, mockWarehouseRepository, mockLocalityRepository := makeSut()
+		mockWarehouseRepository.On("GetByWarehouseCode", "valid_code").Return(domain.Warehouse{}, usecases.ErrNoElementFound).Once()
+		mockLocalityRepository.On("GetById", 1).Return(domain.Locality{}, errors.New("any_error")).Once()
+
+		_, err := sut.Create("valid_code", "valid_address", "(99) 99999-9999", 10, 5.0, 1)
+
+		assert.EqualError(t, err, "any_error")
+	})
+}
+
+func TestGetByLocalityId(t *testing.T) {
+	t.Run("Should return ErrNoElementFound if the locality doesn't exist", func(t *testing.T) {
+		mockLocalityRepository := mocks.NewLocalityRepository(t)
+		mockLocalityRepository.On("GetById", 9).Return(domain.Locality{}, usecases.ErrNoElementFound).Once()
+		sut := usecases.CreateWarehouseService(mocks.NewWarehouseRepository(t), mockLocalityRepository)
+
+		_, err := sut.GetByLocalityId(9)
+
+		assert.ErrorIs(t, err, usecases.ErrNoElementFound)
+	})
+
+	t.Run("Should return the warehouses in the locality", func(t *testing.T) {
+		mockWarehouseRepository := mocks.NewWarehouseRepository(t)
+		mockLocalityRepository := mocks.NewLocalityRepository(t)
+		mockLocalityRepository.On("GetById", 1).Return(domain.Locality{Id: 1}, nil).Once()
+		mockWarehouseRepository.On("GetByLocalityId", 1).Return(domain.Warehouses{{Id: 2, LocalityId: 1}}, nil).Once()
+		sut := usecases.CreateWarehouseService(mockWarehouseRepository, mockLocalityRepository)
+
+		result, err := sut.GetByLocalityId(1)
 
 		assert.Nil(t, err)
+		assert.Equal(t, domain.Warehouses{{Id: 2, LocalityId: 1}}, result)
 	})
 }
@@ -0,0 +1,119 @@
+package document
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Kind tells which Brazilian registry a document number belongs to.
+type Kind string
+
+const (
+	CPF  Kind = "CPF"
+	CNPJ Kind = "CNPJ"
+)
+
+var ErrInvalidDocument = errors.New("document must be a valid CPF (11 digits) or CNPJ (14 characters) with correct check digits")
+
+// punctuation holds the characters Key and KeySQL strip from documents.
+const punctuation = ".-/ "
+
+// Key strips the punctuation of a document number, leaving the form used to
+// compare documents regardless of how they were typed.
+func Key(s string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(punctuation, r) {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(s)))
+}
+
+// KeySQL returns the MySQL expression computing Key over column, so stored
+// documents can be compared with Key(s) whatever their formatting.
+func KeySQL(column string) string {
+	expr := column
+
+	for _, r := range punctuation {
+		expr = fmt.Sprintf("REPLACE(%s, '%c', '')", expr, r)
+	}
+
+	return "UPPER(" + expr + ")"
+}
+
+// Normalize validates s as a CPF or CNPJ, punctuated or not, and returns it
+// in the canonical formatting: 000.000.000-00 or 00.000.000/0000-00.
+// CNPJs may carry letters in their first 12 positions, as issued since 2026.
+func Normalize(s string) (string, Kind, error) {
+	key := Key(s)
+
+	switch {
+	case len(key) == 11 && isDigits(key) && validCPF(key):
+		return key[:3] + "." + key[3:6] + "." + key[6:9] + "-" + key[9:], CPF, nil
+	case len(key) == 14 && isCNPJChars(key[:12]) && isDigits(key[12:]) && validCNPJ(key):
+		return key[:2] + "." + key[2:5] + "." + key[5:8] + "/" + key[8:12] + "-" + key[12:], CNPJ, nil
+	}
+
+	return "", "", ErrInvalidDocument
+}
+
+func validCPF(key string) bool {
+	if repeated(key) {
+		return false
+	}
+
+	return checkDigit(key[:9], []int{10, 9, 8, 7, 6, 5, 4, 3, 2}) == key[9] &&
+		checkDigit(key[:10], []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) == key[10]
+}
+
+func validCNPJ(key string) bool {
+	if repeated(key) {
+		return false
+	}
+
+	return checkDigit(key[:12], []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == key[12] &&
+		checkDigit(key[:13], []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == key[13]
+}
+
+// checkDigit computes the modulo 11 digit shared by CPF and CNPJ. Each
+// character is worth its ASCII code minus 48, so letters count as 17 to 42.
+func checkDigit(s string, weights []int) byte {
+	sum := 0
+
+	for i := 0; i < len(s); i++ {
+		sum += int(s[i]-'0') * weights[i]
+	}
+
+	rest := sum % 11
+
+	if rest < 2 {
+		return '0'
+	}
+
+	return byte('0' + 11 - rest)
+}
+
+func repeated(s string) bool {
+	return strings.Count(s, s[:1]) == len(s)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isCNPJChars(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && (s[i] < 'A' || s[i] > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,55 @@
+package document_test
+
+import (
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/document"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("Should format valid CPFs canonically", func(t *testing.T) {
+		for _, input := range []string{"52998224725", "529.982.247-25", " 529982247-25 "} {
+			result, kind, err := document.Normalize(input)
+
+			assert.Nil(t, err, input)
+			assert.Equal(t, document.CPF, kind, input)
+			assert.Equal(t, "529.982.247-25", result, input)
+		}
+	})
+
+	t.Run("Should format valid CNPJs canonically", func(t *testing.T) {
+		cases := map[string]string{
+			"11222333000181":     "11.222.333/0001-81",
+			"11.222.333/0001-81": "11.222.333/0001-81",
+			"12.abc.345/01de-35": "12.ABC.345/01DE-35",
+		}
+
+		for input, expected := range cases {
+			result, kind, err := document.Normalize(input)
+
+			assert.Nil(t, err, input)
+			assert.Equal(t, document.CNPJ, kind, input)
+			assert.Equal(t, expected, result, input)
+		}
+	})
+
+	t.Run("Should return ErrInvalidDocument on malformed or wrong check digits", func(t *testing.T) {
+		for _, input := range []string{"", "abc", "52998224724", "111.111.111-11", "5299822472", "11222333000182", "00000000000000", "12ABC34501DE3A", "1122233300018X"} {
+			_, _, err := document.Normalize(input)
+
+			assert.ErrorIs(t, err, document.ErrInvalidDocument, input)
+		}
+	})
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, "52998224725", document.Key("529.982.247-25"))
+	assert.Equal(t, "12ABC34501DE35", document.Key(" 12.abc.345/01de-35"))
+}
+
+func TestKeySQL(t *testing.T) {
+	t.Run("Should strip the same punctuation as Key", func(t *testing.T) {
+		assert.Equal(t, "UPPER(REPLACE(REPLACE(REPLACE(REPLACE(cid, '.', ''), '-', ''), '/', ''), ' ', ''))", document.KeySQL("cid"))
+	})
+}
@@ -0,0 +1,246 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact amount with two decimal places, like the DECIMAL(19,2)
+// columns of the database, kept as an integer number of cents.
+type Money struct {
+	cents int64
+}
+
+var ErrInvalidAmount = errors.New("invalid monetary amount")
+
+func FromCents(cents int64) Money {
+	return Money{cents: cents}
+}
+
+// Parse reads amounts like "10", "10.5", "-3.25". More than two fractional
+// digits are rejected instead of being silently rounded.
+func Parse(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" {
+		return Money{}, ErrInvalidAmount
+	}
+
+	negative := false
+
+	if s[0] == '-' || s[0] == '+' {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	units, fraction := s, ""
+
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		units, fraction = s[:i], s[i+1:]
+	}
+
+	if units == "" && fraction == "" || len(fraction) > 2 || !isDigits(units) || !isDigits(fraction) {
+		return Money{}, ErrInvalidAmount
+	}
+
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	if units == "" {
+		units = "0"
+	}
+
+	cents, err := strconv.ParseInt(units+fraction, 10, 64)
+
+	if err != nil {
+		return Money{}, ErrInvalidAmount
+	}
+
+	if negative {
+		cents = -cents
+	}
+
+	return Money{cents: cents}, nil
+}
+
+// FromFloat converts a binary float rounding half away from zero to the
+// nearest cent. Use it only at the edges, where a float is all we are given.
+func FromFloat(f float64) Money {
+	return Money{cents: int64(math.Round(f * 100))}
+}
+
+func (m Money) Cents() int64 {
+	return m.cents
+}
+
+func (m Money) Add(o Money) Money {
+	return Money{cents: m.cents + o.cents}
+}
+
+func (m Money) Sub(o Money) Money {
+	return Money{cents: m.cents - o.cents}
+}
+
+func (m Money) Mul(quantity int64) Money {
+	return Money{cents: m.cents * quantity}
+}
+
+// Div splits the amount in n parts rounding half away from zero to the
+// nearest cent, as averages of prices need.
+func (m Money) Div(n int64) Money {
+	if n == 0 {
+		return Money{}
+	}
+
+	return Money{cents: int64(math.Round(float64(m.cents) / float64(n)))}
+}
+
+// Scale multiplies the amount by num/den rounding half away from zero to the
+// nearest cent, keeping percentages such as discounts exact.
+func (m Money) Scale(num, den int64) Money {
+	if den == 0 {
+		return Money{}
+	}
+
+	if den < 0 {
+		num, den = -num, -den
+	}
+
+	product := m.cents * num
+	cents, rest := product/den, product%den
+
+	if rest < 0 {
+		rest = -rest
+	}
+
+	if 2*rest >= den {
+		if product < 0 {
+			cents--
+		} else {
+			cents++
+		}
+	}
+
+	return Money{cents: cents}
+}
+
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.cents < o.cents:
+		return -1
+	case m.cents > o.cents:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (m Money) LessThan(o Money) bool {
+	return m.cents < o.cents
+}
+
+func (m Money) IsZero() bool {
+	return m.cents == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.cents < 0
+}
+
+// Float64 is meant for statistics such as averages and ratios, never for
+// amounts that are stored or summed again.
+func (m Money) Float64() float64 {
+	return float64(m.cents) / 100
+}
+
+func (m Money) String() string {
+	cents := m.cents
+	sign := ""
+
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON encodes the amount as a number with exactly two decimals.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON accepts both numbers and strings, e.g. 10.5 or "10.50".
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var s string
+
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	} else {
+		s = string(data)
+	}
+
+	parsed, err := Parse(s)
+
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// Scan reads MySQL DECIMAL values, which the driver delivers as text.
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case []byte:
+		parsed, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case int64:
+		*m = Money{cents: v * 100}
+		return nil
+	case float64:
+		*m = FromFloat(v)
+		return nil
+	}
+
+	return fmt.Errorf("can't scan %T into money", src)
+}
+
+// Value stores the amount as a decimal string so MySQL keeps it exact.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,127 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Should parse decimal amounts exactly", func(t *testing.T) {
+		cases := map[string]int64{
+			"10":     1000,
+			"10.5":   1050,
+			"10.05":  1005,
+			"0.1":    10,
+			".99":    99,
+			"-3.25":  -325,
+			" 7.00 ": 700,
+		}
+
+		for input, cents := range cases {
+			m, err := money.Parse(input)
+
+			assert.Nil(t, err, input)
+			assert.Equal(t, cents, m.Cents(), input)
+		}
+	})
+
+	t.Run("Should return ErrInvalidAmount on malformed amounts", func(t *testing.T) {
+		for _, input := range []string{"", ".", "1.234", "abc", "1,50", "--1", "1e3"} {
+			_, err := money.Parse(input)
+
+			assert.ErrorIs(t, err, money.ErrInvalidAmount, input)
+		}
+	})
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Run("Should not lose cents when adding", func(t *testing.T) {
+		total := money.Money{}
+
+		for i := 0; i < 10; i++ {
+			total = total.Add(money.FromCents(10))
+		}
+
+		assert.Equal(t, "1.00", total.String())
+	})
+
+	t.Run("Should subtract, multiply and compare", func(t *testing.T) {
+		price := money.FromCents(1999)
+
+		assert.Equal(t, "59.97", price.Mul(3).String())
+		assert.Equal(t, "-0.01", price.Sub(money.FromCents(2000)).String())
+		assert.True(t, price.LessThan(money.FromCents(2000)))
+		assert.Equal(t, 0, price.Cmp(money.FromCents(1999)))
+	})
+
+	t.Run("Should round divisions to the nearest cent", func(t *testing.T) {
+		assert.Equal(t, "3.33", money.FromCents(1000).Div(3).String())
+		assert.Equal(t, "6.67", money.FromCents(2000).Div(3).String())
+		assert.Equal(t, "0.00", money.FromCents(2000).Div(0).String())
+	})
+
+	t.Run("Should scale by a ratio rounding to the nearest cent", func(t *testing.T) {
+		assert.Equal(t, "9.00", money.FromCents(1000).Scale(90, 100).String())
+		assert.Equal(t, "8.75", money.FromCents(999).Scale(8750, 9990).String())
+		assert.Equal(t, "0.01", money.FromCents(1).Scale(1, 2).String())
+		assert.Equal(t, "-0.01", money.FromCents(-1).Scale(1, 2).String())
+		assert.Equal(t, "0.00", money.FromCents(1000).Scale(1, 0).String())
+	})
+}
+
+func TestJSON(t *testing.T) {
+	t.Run("Should encode as a number with two decimals", func(t *testing.T) {
+		data, err := json.Marshal(struct {
+			Price money.Money `json:"price"`
+		}{money.FromCents(1050)})
+
+		assert.Nil(t, err)
+		assert.Equal(t, `{"price":10.50}`, string(data))
+	})
+
+	t.Run("Should decode numbers and strings", func(t *testing.T) {
+		var body struct {
+			A money.Money `json:"a"`
+			B money.Money `json:"b"`
+		}
+
+		err := json.Unmarshal([]byte(`{"a": 10.5, "b": "0.07"}`), &body)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1050), body.A.Cents())
+		assert.Equal(t, int64(7), body.B.Cents())
+	})
+
+	t.Run("Should return an error when decoding an invalid amount", func(t *testing.T) {
+		var m money.Money
+
+		err := json.Unmarshal([]byte(`"10.999"`), &m)
+
+		assert.ErrorIs(t, err, money.ErrInvalidAmount)
+	})
+}
+
+func TestScan(t *testing.T) {
+	t.Run("Should scan MySQL decimals", func(t *testing.T) {
+		var m money.Money
+
+		assert.Nil(t, m.Scan([]byte("19.90")))
+		assert.Equal(t, int64(1990), m.Cents())
+	})
+
+	t.Run("Should scan integers as whole units", func(t *testing.T) {
+		var m money.Money
+
+		assert.Nil(t, m.Scan(int64(5)))
+		assert.Equal(t, int64(500), m.Cents())
+	})
+
+	t.Run("Should return an error on unsupported types", func(t *testing.T) {
+		var m money.Money
+
+		assert.NotNil(t, m.Scan(true))
+	})
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Percent is an exact percentage with two decimal places, like the
+// DECIMAL(5,2) columns of the database, kept as an integer number of basis
+// points.
+type Percent struct {
+	basisPoints int64
+}
+
+var ErrInvalidPercent = errors.New("invalid percentage")
+
+func FromBasisPoints(basisPoints int64) Percent {
+	return Percent{basisPoints: basisPoints}
+}
+
+// ParsePercent reads percentages like "10", "12.5", "0.25" with the same
+// rules as Parse.
+func ParsePercent(s string) (Percent, error) {
+	m, err := Parse(s)
+
+	if err != nil {
+		return Percent{}, ErrInvalidPercent
+	}
+
+	return Percent{basisPoints: m.Cents()}, nil
+}
+
+func (p Percent) BasisPoints() int64 {
+	return p.basisPoints
+}
+
+// Discount takes the percentage off the amount, rounding to the nearest cent.
+func (m Money) Discount(p Percent) Money {
+	return m.Scale(10000-p.basisPoints, 10000)
+}
+
+func (p Percent) String() string {
+	return FromCents(p.basisPoints).String()
+}
+
+// MarshalJSON encodes the percentage as a number with exactly two decimals.
+func (p Percent) MarshalJSON() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalJSON accepts both numbers and strings, e.g. 12.5 or "12.50".
+func (p *Percent) UnmarshalJSON(data []byte) error {
+	var s string
+
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	} else {
+		s = string(data)
+	}
+
+	parsed, err := ParsePercent(s)
+
+	if err != nil {
+		return err
+	}
+
+	*p = parsed
+
+	return nil
+}
+
+// Scan reads MySQL DECIMAL values, which the driver delivers as text.
+func (p *Percent) Scan(src interface{}) error {
+	var m Money
+
+	if err := m.Scan(src); err != nil {
+		return fmt.Errorf("can't scan %T into percent", src)
+	}
+
+	*p = Percent{basisPoints: m.Cents()}
+
+	return nil
+}
+
+// Value stores the percentage as a decimal string so MySQL keeps it exact.
+func (p Percent) Value() (driver.Value, error) {
+	return p.String(), nil
+}
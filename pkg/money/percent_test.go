@@ -0,0 +1,52 @@
+package money_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercent(t *testing.T) {
+	t.Run("Should parse percentages exactly", func(t *testing.T) {
+		for input, basisPoints := range map[string]int64{"10": 1000, "12.5": 1250, "0.25": 25, "100": 10000} {
+			p, err := money.ParsePercent(input)
+
+			assert.Nil(t, err, input)
+			assert.Equal(t, basisPoints, p.BasisPoints(), input)
+		}
+	})
+
+	t.Run("Should return ErrInvalidPercent on malformed percentages", func(t *testing.T) {
+		for _, input := range []string{"", "12.345", "abc"} {
+			_, err := money.ParsePercent(input)
+
+			assert.ErrorIs(t, err, money.ErrInvalidPercent, input)
+		}
+	})
+
+	t.Run("Should discount an amount rounding to the nearest cent", func(t *testing.T) {
+		assert.Equal(t, "8.75", money.FromCents(1000).Discount(money.FromBasisPoints(1250)).String())
+		assert.Equal(t, "6.66", money.FromCents(999).Discount(money.FromBasisPoints(3333)).String())
+	})
+
+	t.Run("Should encode and decode JSON", func(t *testing.T) {
+		var p money.Percent
+
+		assert.Nil(t, json.Unmarshal([]byte(`12.5`), &p))
+		assert.Equal(t, int64(1250), p.BasisPoints())
+
+		data, err := json.Marshal(p)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "12.50", string(data))
+	})
+
+	t.Run("Should scan MySQL decimals", func(t *testing.T) {
+		var p money.Percent
+
+		assert.Nil(t, p.Scan([]byte("10.00")))
+		assert.Equal(t, int64(1000), p.BasisPoints())
+	})
+}
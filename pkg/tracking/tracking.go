@@ -0,0 +1,86 @@
+package tracking
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Codes follow the UPU S10 layout used by postal carriers: a two-letter
+// service prefix, an eight-digit serial, a check digit and the country code,
+// as in MF000000014BR.
+const (
+	Prefix  = "MF"
+	Country = "BR"
+
+	MaxSerial = 99999999
+)
+
+var ErrSerialOutOfRange = fmt.Errorf("tracking serial must be between 1 and %d", MaxSerial)
+
+var ErrInvalidCode = errors.New("tracking code must be two letters, eight digits, a check digit and two letters")
+
+var weights = []int{8, 6, 4, 2, 3, 5, 9, 7}
+
+func Generate(serial int) (string, error) {
+	if serial < 1 || serial > MaxSerial {
+		return "", ErrSerialOutOfRange
+	}
+
+	digits := fmt.Sprintf("%08d", serial)
+
+	return Prefix + digits + string(checkDigit(digits)) + Country, nil
+}
+
+// Normalize upper-cases and trims a typed code and checks its layout and
+// check digit, whatever prefix and country it carries.
+func Normalize(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	if len(code) != 13 || !isLetters(code[:2]) || !isDigits(code[2:11]) || !isLetters(code[11:]) {
+		return "", ErrInvalidCode
+	}
+
+	if checkDigit(code[2:10]) != code[10] {
+		return "", ErrInvalidCode
+	}
+
+	return code, nil
+}
+
+func checkDigit(digits string) byte {
+	sum := 0
+
+	for i, w := range weights {
+		sum += int(digits[i]-'0') * w
+	}
+
+	switch d := 11 - sum%11; d {
+	case 10:
+		return '0'
+	case 11:
+		return '5'
+	default:
+		return byte('0' + d)
+	}
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,54 @@
+package tracking_test
+
+import (
+	"testing"
+
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/tracking"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("Should append the S10 check digit to the padded serial", func(t *testing.T) {
+		cases := map[int]string{
+			1:        "MF000000014BR",
+			12345678: "MF123456785BR",
+			99999999: "MF999999995BR",
+		}
+
+		for serial, expected := range cases {
+			result, err := tracking.Generate(serial)
+
+			assert.Nil(t, err)
+			assert.Equal(t, expected, result)
+		}
+	})
+
+	t.Run("Should return ErrSerialOutOfRange outside 1..99999999", func(t *testing.T) {
+		for _, serial := range []int{0, -1, 100000000} {
+			_, err := tracking.Generate(serial)
+
+			assert.ErrorIs(t, err, tracking.ErrSerialOutOfRange)
+		}
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	t.Run("Should accept valid codes regardless of case and spaces", func(t *testing.T) {
+		for _, input := range []string{"MF123456785BR", " mf123456785br ", "EE123456785GB"} {
+			_, err := tracking.Normalize(input)
+
+			assert.Nil(t, err, input)
+		}
+
+		result, _ := tracking.Normalize(" mf000000014br")
+		assert.Equal(t, "MF000000014BR", result)
+	})
+
+	t.Run("Should return ErrInvalidCode on malformed codes or wrong check digits", func(t *testing.T) {
+		for _, input := range []string{"", "123", "MF123456784BR", "MF12345678BR", "1F123456785BR", "MF12345678XBR", "MF123456785B1"} {
+			_, err := tracking.Normalize(input)
+
+			assert.ErrorIs(t, err, tracking.ErrInvalidCode, input)
+		}
+	})
+}
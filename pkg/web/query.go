@@ -0,0 +1,18 @@
+package web
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// QueryBool reads an optional true/false query param, false when absent.
+func QueryBool(ctx *gin.Context, key string) (bool, error) {
+	value := ctx.Query(key)
+
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
@@ -0,0 +1,41 @@
+package web_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/natpapa17/MercadoFresco-ASociedadeGo/pkg/web"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryBool(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	query := func(url string) (bool, error) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("GET", url, nil)
+
+		return web.QueryBool(ctx, "hard")
+	}
+
+	t.Run("Should be false when absent", func(t *testing.T) {
+		value, err := query("/")
+
+		assert.False(t, value)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should parse true", func(t *testing.T) {
+		value, err := query("/?hard=true")
+
+		assert.True(t, value)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should return an error for other values", func(t *testing.T) {
+		_, err := query("/?hard=any")
+
+		assert.Error(t, err)
+	})
+}